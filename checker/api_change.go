@@ -80,7 +80,11 @@ func (c ApiChange) GetPath() string {
 	return c.Path
 }
 
+// GetSource returns the source of the revision spec, or an empty string for changes that were created without a source
 func (c ApiChange) GetSource() string {
+	if c.Source == nil {
+		return ""
+	}
 	return c.Source.String()
 }
 
@@ -112,6 +116,11 @@ func (c ApiChange) GetSourceColumnEnd() int {
 	return c.SourceColumnEnd
 }
 
+// HasSourceLocation returns true if the change was located in the spec, so that its line and column are meaningful
+func (c ApiChange) HasSourceLocation() bool {
+	return c.SourceFile != ""
+}
+
 func (c ApiChange) SingleLineError(l Localizer, colorMode ColorMode) string {
 	const format = "%s %s %s, %s API %s %s %s [%s]. %s"
 
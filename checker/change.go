@@ -18,6 +18,7 @@ type Change interface {
 	GetSourceLineEnd() int
 	GetSourceColumn() int
 	GetSourceColumnEnd() int
+	HasSourceLocation() bool
 	MatchIgnore(ignorePath, ignoreLine string, l Localizer) bool
 	SingleLineError(l Localizer, colorMode ColorMode) string
 	MultiLineError(l Localizer, colorMode ColorMode) string
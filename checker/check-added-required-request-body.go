@@ -32,7 +32,7 @@ func AddedRequiredRequestBodyCheck(diffReport *diff.Diff, operationsSources *dif
 					OperationId: operationItem.Revision.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, operationItem.Revision))
 			}
 		}
 	}
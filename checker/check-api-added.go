@@ -24,7 +24,7 @@ func APIAddedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSour
 			OperationId: opConfig.OperationID,
 			Path:        path,
 			Source:      load.NewSource((*operationsSources)[opConfig]),
-		})
+		}.withLocation(config, opConfig))
 	}
 
 	for _, path := range diffReport.PathsDiff.Added {
@@ -43,7 +43,7 @@ func APIDeprecationCheck(diffReport *diff.Diff, operationsSources *diff.Operatio
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, op))
 				continue
 			}
 
@@ -57,7 +57,7 @@ func APIDeprecationCheck(diffReport *diff.Diff, operationsSources *diff.Operatio
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, op))
 				continue
 			}
 
@@ -73,7 +73,7 @@ func APIDeprecationCheck(diffReport *diff.Diff, operationsSources *diff.Operatio
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, op))
 				continue
 			}
 
@@ -88,7 +88,7 @@ func APIDeprecationCheck(diffReport *diff.Diff, operationsSources *diff.Operatio
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, op))
 				continue
 			}
 
@@ -100,7 +100,7 @@ func APIDeprecationCheck(diffReport *diff.Diff, operationsSources *diff.Operatio
 				OperationId: op.OperationID,
 				Path:        path,
 				Source:      load.NewSource(source),
-			})
+			}.withLocation(config, op))
 		}
 	}
 
@@ -45,7 +45,7 @@ func APIOperationIdUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.O
 				OperationId: op.OperationID,
 				Path:        path,
 				Source:      load.NewSource(source),
-			})
+			}.withLocation(config, op))
 		}
 	}
 	return result
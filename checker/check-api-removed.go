@@ -37,7 +37,7 @@ func APIRemovedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSo
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, op))
 				continue
 			}
 			rawDate, date, err := getSunsetDate(op.Extensions)
@@ -51,7 +51,7 @@ func APIRemovedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSo
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, op))
 				continue
 			}
 			if !civil.DateOf(time.Now()).After(date) {
@@ -64,7 +64,7 @@ func APIRemovedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSo
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, op))
 			}
 		}
 	}
@@ -84,7 +84,7 @@ func APIRemovedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSo
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, op))
 				continue
 			}
 			rawDate, date, err := getSunsetDate(op.Extensions)
@@ -98,7 +98,7 @@ func APIRemovedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSo
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, op))
 				continue
 			}
 			if !civil.DateOf(time.Now()).After(date) {
@@ -111,7 +111,7 @@ func APIRemovedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSo
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, op))
 			}
 		}
 	}
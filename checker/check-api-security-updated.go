@@ -27,7 +27,7 @@ func checkGlobalSecurity(diffReport *diff.Diff, operationsSources *diff.Operatio
 			Id:    APIGlobalSecurityAddedCheckId,
 			Level: INFO,
 			Args:  []any{addedSecurity},
-		})
+		}.withLocation(config, load.SectionKey("security")))
 	}
 
 	for _, removedSecurity := range diffReport.SecurityDiff.Deleted {
@@ -35,7 +35,7 @@ func checkGlobalSecurity(diffReport *diff.Diff, operationsSources *diff.Operatio
 			Id:    APIGlobalSecurityRemovedCheckId,
 			Level: INFO,
 			Args:  []any{removedSecurity},
-		})
+		}.withLocation(config, load.SectionKey("security")))
 	}
 
 	for _, updatedSecurity := range diffReport.SecurityDiff.Modified {
@@ -45,14 +45,14 @@ func checkGlobalSecurity(diffReport *diff.Diff, operationsSources *diff.Operatio
 					Id:    APIGlobalSecurityScopeAddedId,
 					Level: INFO,
 					Args:  []any{addedScope, securitySchemeName},
-				})
+				}.withLocation(config, load.SectionKey("security")))
 			}
 			for _, deletedScope := range updatedSecuritySchemeScopes.Deleted {
 				result = append(result, SecurityChange{
 					Id:    APIGlobalSecurityScopeRemovedId,
 					Level: INFO,
 					Args:  []any{deletedScope, securitySchemeName},
-				})
+				}.withLocation(config, load.SectionKey("security")))
 			}
 		}
 	}
@@ -94,7 +94,7 @@ func APISecurityUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.Oper
 					OperationId: operationItem.Revision.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, operationItem.Revision))
 			}
 
 			for _, deletedSecurity := range operationItem.SecurityDiff.Deleted {
@@ -109,7 +109,7 @@ func APISecurityUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.Oper
 					OperationId: operationItem.Revision.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, operationItem.Revision))
 			}
 
 			for _, updatedSecurity := range operationItem.SecurityDiff.Modified {
@@ -126,7 +126,7 @@ func APISecurityUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.Oper
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      load.NewSource(source),
-						})
+						}.withLocation(config, operationItem.Revision))
 					}
 					for _, deletedScope := range updatedSecuritySchemeScopes.Deleted {
 						result = append(result, ApiChange{
@@ -137,7 +137,7 @@ func APISecurityUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.Oper
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      load.NewSource(source),
-						})
+						}.withLocation(config, operationItem.Revision))
 					}
 				}
 			}
@@ -0,0 +1,213 @@
+package checker
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	APIServerRemovedId                           = "api-server-removed"
+	APIServerAddedId                             = "api-server-added"
+	APIServerURLChangedId                        = "api-server-url-changed"
+	APIServerVariableEnumValueRemovedId          = "api-server-variable-enum-value-removed"
+	APIServerVariableDefaultChangedId            = "api-server-variable-default-changed"
+	APIPathServerRemovedId                       = "api-path-server-removed"
+	APIPathServerAddedId                         = "api-path-server-added"
+	APIPathServerURLChangedId                    = "api-path-server-url-changed"
+	APIPathServerVariableEnumValueRemovedId      = "api-path-server-variable-enum-value-removed"
+	APIPathServerVariableDefaultChangedId        = "api-path-server-variable-default-changed"
+	APIOperationServerRemovedId                  = "api-operation-server-removed"
+	APIOperationServerAddedId                    = "api-operation-server-added"
+	APIOperationServerURLChangedId               = "api-operation-server-url-changed"
+	APIOperationServerVariableEnumValueRemovedId = "api-operation-server-variable-enum-value-removed"
+	APIOperationServerVariableDefaultChangedId   = "api-operation-server-variable-default-changed"
+)
+
+// serverIds are the ids of the changes to the servers of a spec, a path or an operation
+type serverIds struct {
+	removed                  string
+	added                    string
+	urlChanged               string
+	variableEnumValueRemoved string
+	variableDefaultChanged   string
+}
+
+// serverChange creates a change in a list of servers
+type serverChange func(id string, level Level, args []any, element any) Change
+
+// APIServersUpdatedCheck detects changes to the servers of the spec, of paths and of operations
+// Clients that hardcode the base URL, or build it from the server variables, break when a server URL or a variable value they use is removed
+// Changes to the servers of paths and operations are reported for each operation, by comparing the servers that apply to it before and after
+func APIServersUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+
+	if diffReport.ServersDiff != nil {
+		ids := serverIds{
+			removed:                  APIServerRemovedId,
+			added:                    APIServerAddedId,
+			urlChanged:               APIServerURLChangedId,
+			variableEnumValueRemoved: APIServerVariableEnumValueRemovedId,
+			variableDefaultChanged:   APIServerVariableDefaultChangedId,
+		}
+		newChange := func(id string, level Level, args []any, element any) Change {
+			return ServerChange{
+				Id:    id,
+				Level: level,
+				Args:  args,
+			}.withLocation(config, element, load.SectionKey("servers"))
+		}
+		result = append(result, serversUpdated(diffReport.ServersDiff, ids, newChange)...)
+	}
+
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+
+	pathIds := serverIds{
+		removed:                  APIPathServerRemovedId,
+		added:                    APIPathServerAddedId,
+		urlChanged:               APIPathServerURLChangedId,
+		variableEnumValueRemoved: APIPathServerVariableEnumValueRemovedId,
+		variableDefaultChanged:   APIPathServerVariableDefaultChangedId,
+	}
+	operationIds := serverIds{
+		removed:                  APIOperationServerRemovedId,
+		added:                    APIOperationServerAddedId,
+		urlChanged:               APIOperationServerURLChangedId,
+		variableEnumValueRemoved: APIOperationServerVariableEnumValueRemovedId,
+		variableDefaultChanged:   APIOperationServerVariableDefaultChangedId,
+	}
+
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.Base == nil || pathItem.Revision == nil {
+			continue
+		}
+
+		for operation, operationItem := range pathItem.Revision.Operations() {
+			baseOperation := pathItem.Base.GetOperation(operation)
+			if baseOperation == nil {
+				// new operations have no clients yet
+				continue
+			}
+
+			baseServers, baseLevel := getEffectiveServers(baseOperation, pathItem.Base, diffReport.PathsDiff.BaseServers)
+			revisionServers, revisionLevel := getEffectiveServers(operationItem, pathItem.Revision, diffReport.PathsDiff.RevisionServers)
+			if baseLevel == serversLevelSpec && revisionLevel == serversLevelSpec {
+				// changes to the servers of the spec are reported once, above
+				continue
+			}
+
+			serversDiff := diff.GetServersDiff(diff.NewConfig(), baseServers, revisionServers)
+			if serversDiff == nil {
+				continue
+			}
+
+			ids := pathIds
+			if baseLevel == serversLevelOperation || revisionLevel == serversLevelOperation {
+				ids = operationIds
+			}
+
+			source := (*operationsSources)[operationItem]
+			newChange := func(id string, level Level, args []any, element any) Change {
+				return ApiChange{
+					Id:          id,
+					Level:       level,
+					Args:        args,
+					Operation:   operation,
+					OperationId: operationItem.OperationID,
+					Path:        path,
+					Source:      load.NewSource(source),
+				}.withLocation(config, element, operationItem)
+			}
+			result = append(result, serversUpdated(serversDiff, ids, newChange)...)
+		}
+	}
+
+	return result
+}
+
+// serversLevel is the level of the spec that defines the servers of an operation
+type serversLevel int
+
+const (
+	serversLevelSpec serversLevel = iota
+	serversLevelPath
+	serversLevelOperation
+)
+
+// getEffectiveServers returns the servers that apply to an operation: its own servers, or else the servers of its path, or else the servers of the spec
+func getEffectiveServers(operation *openapi3.Operation, pathItem *openapi3.PathItem, specServers openapi3.Servers) (openapi3.Servers, serversLevel) {
+	if operation.Servers != nil && len(*operation.Servers) > 0 {
+		return *operation.Servers, serversLevelOperation
+	}
+	if len(pathItem.Servers) > 0 {
+		return pathItem.Servers, serversLevelPath
+	}
+	return specServers, serversLevelSpec
+}
+
+// serversUpdated detects changes to a list of servers
+// Servers are identified by URL, so a server that was replaced by a server with a different URL at the same position is reported as a URL change
+func serversUpdated(serversDiff *diff.ServersDiff, ids serverIds, newChange serverChange) Changes {
+	result := make(Changes, 0)
+
+	replaced := map[string]bool{}
+	for _, url := range serversDiff.Deleted {
+		baseIndex := slices.IndexFunc(serversDiff.Base, func(server *openapi3.Server) bool { return server.URL == url })
+		if baseIndex >= 0 && baseIndex < len(serversDiff.Revision) {
+			revisionServer := serversDiff.Revision[baseIndex]
+			if slices.Contains(serversDiff.Added, revisionServer.URL) && !replaced[revisionServer.URL] {
+				replaced[revisionServer.URL] = true
+				result = append(result, newChange(ids.urlChanged, ERR, []any{url, revisionServer.URL}, revisionServer))
+				continue
+			}
+		}
+		result = append(result, newChange(ids.removed, ERR, []any{url}, nil))
+	}
+
+	for _, url := range serversDiff.Added {
+		if replaced[url] {
+			continue
+		}
+		result = append(result, newChange(ids.added, INFO, []any{url}, findServerByURL(serversDiff.Revision, url)))
+	}
+
+	for url, serverDiff := range serversDiff.Modified {
+		if serverDiff.VariablesDiff == nil {
+			continue
+		}
+		revisionServer := findServerByURL(serversDiff.Revision, url)
+		baseServer := findServerByURL(serversDiff.Base, url)
+
+		for variableName, variableDiff := range serverDiff.VariablesDiff.Modified {
+			if variableDiff.EnumDiff != nil {
+				for _, value := range variableDiff.EnumDiff.Deleted {
+					result = append(result, newChange(ids.variableEnumValueRemoved, ERR, []any{url, variableName, value}, revisionServer))
+				}
+			}
+
+			if variableDiff.DefaultDiff != nil {
+				// clients that use the former default value break only if it is no longer allowed
+				formerDefaultRemoved := false
+				if baseServer != nil && revisionServer != nil && baseServer.Variables[variableName] != nil && revisionServer.Variables[variableName] != nil {
+					enum := revisionServer.Variables[variableName].Enum
+					formerDefaultRemoved = len(enum) > 0 && !slices.Contains(enum, baseServer.Variables[variableName].Default)
+				}
+				result = append(result, newChange(ids.variableDefaultChanged, conditionalError(formerDefaultRemoved, WARN), []any{url, variableName, variableDiff.DefaultDiff.From, variableDiff.DefaultDiff.To}, revisionServer))
+			}
+		}
+	}
+
+	return result
+}
+
+func findServerByURL(servers openapi3.Servers, url string) *openapi3.Server {
+	for _, server := range servers {
+		if server.URL == url {
+			return server
+		}
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const serversBase = "../data/checker/servers_base.yaml"
+
+// BC: removing a server is breaking
+func TestAPIServerRemoved(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, serversBase, singleCheckConfig(checker.APIServersUpdatedCheck), func(s *load.SpecInfo) {
+		s.Spec.Servers = s.Spec.Servers[:1]
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ServerChange{
+		Id:    checker.APIServerRemovedId,
+		Args:  []any{"https://sandbox.example.com/v1"},
+		Level: checker.ERR,
+	}, errs[0])
+	require.Equal(t, "removed the server 'https://sandbox.example.com/v1'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: adding a server is not breaking
+func TestAPIServerAdded(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, serversBase, singleCheckConfig(checker.APIServersUpdatedCheck), func(s *load.SpecInfo) {
+		s.Spec.Servers = append(s.Spec.Servers, &openapi3.Server{URL: "https://staging.example.com/v1"})
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.APIServerAddedId, errs[0].GetId())
+	require.Equal(t, checker.INFO, errs[0].GetLevel())
+}
+
+// BC: changing the base path of a server URL is breaking
+func TestAPIServerURLChanged(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, serversBase, singleCheckConfig(checker.APIServersUpdatedCheck), func(s *load.SpecInfo) {
+		s.Spec.Servers[1].URL = "https://sandbox.example.com/v2"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ServerChange{
+		Id:    checker.APIServerURLChangedId,
+		Args:  []any{"https://sandbox.example.com/v1", "https://sandbox.example.com/v2"},
+		Level: checker.ERR,
+	}, errs[0])
+	require.Equal(t, "the server URL was changed from 'https://sandbox.example.com/v1' to 'https://sandbox.example.com/v2'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: removing an enum value of a server variable is breaking
+func TestAPIServerVariableEnumValueRemoved(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, serversBase, singleCheckConfig(checker.APIServersUpdatedCheck), func(s *load.SpecInfo) {
+		s.Spec.Servers[0].Variables["region"].Enum = []string{"eu"}
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.APIServerVariableEnumValueRemovedId, errs[0].GetId())
+	require.Equal(t, checker.ERR, errs[0].GetLevel())
+	require.Equal(t, "the server 'https://{region}.api.example.com/v1' variable 'region' enum value 'us' was removed", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: changing the default value of a server variable to another allowed value is not breaking but is reported as a warning
+func TestAPIServerVariableDefaultChanged(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, serversBase, singleCheckConfig(checker.APIServersUpdatedCheck), func(s *load.SpecInfo) {
+		s.Spec.Servers[0].Variables["region"].Default = "us"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.APIServerVariableDefaultChangedId, errs[0].GetId())
+	require.Equal(t, checker.WARN, errs[0].GetLevel())
+	require.Equal(t, "the server 'https://{region}.api.example.com/v1' variable 'region' default value was changed from 'eu' to 'us'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: changing the default value of a server variable and removing the former default value is breaking
+func TestAPIServerVariableDefaultChangedAndRemoved(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, serversBase, singleCheckConfig(checker.APIServersUpdatedCheck), func(s *load.SpecInfo) {
+		s.Spec.Servers[0].Variables["region"].Default = "us"
+		s.Spec.Servers[0].Variables["region"].Enum = []string{"us"}
+	})
+	require.Len(t, errs, 2)
+	for _, err := range errs {
+		require.Equal(t, checker.ERR, err.GetLevel())
+	}
+}
+
+// BC: changing the URL of a path server is breaking for the operations that don't override it
+func TestAPIPathServerURLChanged(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, serversBase, singleCheckConfig(checker.APIServersUpdatedCheck), func(s *load.SpecInfo) {
+		s.Spec.Paths.Value("/api/v1.0/groups").Servers = openapi3.Servers{&openapi3.Server{URL: "https://groups.example.com/v2"}}
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.APIPathServerURLChangedId,
+		Args:        []any{"https://groups.example.com/v1", "https://groups.example.com/v2"},
+		Level:       checker.ERR,
+		Operation:   "GET",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(serversBase),
+		OperationId: "listGroups",
+	}, errs[0])
+}
+
+// BC: changing the URL of an operation server is breaking
+func TestAPIOperationServerURLChanged(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, serversBase, singleCheckConfig(checker.APIServersUpdatedCheck), func(s *load.SpecInfo) {
+		servers := s.Spec.Paths.Value("/api/v1.0/groups").Post.Servers
+		*servers = append(*servers, &openapi3.Server{URL: "https://upload2.example.com/v1"})
+		*servers = (*servers)[1:]
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.APIOperationServerURLChangedId, errs[0].GetId())
+	require.Equal(t, "POST", errs[0].GetOperation())
+	require.Equal(t, "the operation server URL was changed from 'https://upload.example.com/v1' to 'https://upload2.example.com/v1'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: removing one of the operation servers is breaking
+func TestAPIOperationServerRemoved(t *testing.T) {
+	s1, err := open(serversBase)
+	require.NoError(t, err)
+	s2, err := open(serversBase)
+	require.NoError(t, err)
+
+	servers := s1.Spec.Paths.Value("/api/v1.0/groups").Post.Servers
+	*servers = append(*servers, &openapi3.Server{URL: "https://upload2.example.com/v1"})
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.APIServersUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.APIOperationServerRemovedId, errs[0].GetId())
+	require.Equal(t, checker.ERR, errs[0].GetLevel())
+	require.Equal(t, "removed the operation server 'https://upload2.example.com/v1'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: removing the servers of an operation is breaking when the servers of its path or of the spec don't serve it at the same URL
+func TestAPIOperationServersRemoved(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, serversBase, singleCheckConfig(checker.APIServersUpdatedCheck), func(s *load.SpecInfo) {
+		s.Spec.Paths.Value("/api/v1.0/groups").Post.Servers = nil
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.APIOperationServerURLChangedId,
+		Args:        []any{"https://upload.example.com/v1", "https://groups.example.com/v1"},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(serversBase),
+		OperationId: "createGroup",
+	}, errs[0])
+}
+
+// BC: removing the servers of a path is breaking for the operations that don't override them
+func TestAPIPathServersRemoved(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, serversBase, singleCheckConfig(checker.APIServersUpdatedCheck), func(s *load.SpecInfo) {
+		s.Spec.Paths.Value("/api/v1.0/groups").Servers = nil
+	})
+	require.Len(t, errs, 2)
+	require.Equal(t, checker.APIPathServerURLChangedId, errs[0].GetId())
+	require.Equal(t, "GET", errs[0].GetOperation())
+	require.Equal(t, "the path server URL was changed from 'https://groups.example.com/v1' to 'https://{region}.api.example.com/v1'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+	require.Equal(t, checker.APIPathServerAddedId, errs[1].GetId())
+	require.Equal(t, checker.INFO, errs[1].GetLevel())
+}
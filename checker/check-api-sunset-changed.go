@@ -39,7 +39,7 @@ func APISunsetChangedCheck(diffReport *diff.Diff, operationsSources *diff.Operat
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, op))
 			}
 
 			if operationDiff.ExtensionsDiff == nil || operationDiff.ExtensionsDiff.Modified.Empty() {
@@ -58,7 +58,7 @@ func APISunsetChangedCheck(diffReport *diff.Diff, operationsSources *diff.Operat
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, op))
 				continue
 			}
 
@@ -72,7 +72,7 @@ func APISunsetChangedCheck(diffReport *diff.Diff, operationsSources *diff.Operat
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      load.NewSource((*operationsSources)[opBase]),
-				})
+				}.withLocation(config, opBase))
 				continue
 			}
 
@@ -88,7 +88,7 @@ func APISunsetChangedCheck(diffReport *diff.Diff, operationsSources *diff.Operat
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, op))
 				continue
 			}
 
@@ -103,7 +103,7 @@ func APISunsetChangedCheck(diffReport *diff.Diff, operationsSources *diff.Operat
 					OperationId: op.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, op))
 			}
 		}
 	}
@@ -0,0 +1,114 @@
+package checker
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	CallbackRequestRequiredPropertyRemovedId = "callback-request-required-property-removed"
+	CallbackRequestOptionalPropertyRemovedId = "callback-request-optional-property-removed"
+	CallbackRequestNewRequiredPropertyId     = "callback-request-new-required-property"
+	CallbackRequestNewOptionalPropertyId     = "callback-request-new-optional-property"
+	CallbackRequestPropertyTypeChangedId     = "callback-request-property-type-changed"
+)
+
+// sentRequestPropertyIds are the ids of the changes to the request properties of operations that the API sends, like callbacks and webhooks
+type sentRequestPropertyIds struct {
+	requiredPropertyRemoved string
+	optionalPropertyRemoved string
+	newRequiredProperty     string
+	newOptionalProperty     string
+	propertyTypeChanged     string
+}
+
+// sentOperationChange creates a change in an operation that the API sends
+type sentOperationChange func(id string, level Level, args []any, element any) Change
+
+// CallbackRequestPropertyUpdatedCheck detects changes to the properties of callback requests
+// The API sends callback requests, so, like in responses, removing or changing properties breaks the consumers that receive them
+// New required properties are reported as warnings because consumers that validate requests strictly may reject them
+func CallbackRequestPropertyUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+
+	ids := sentRequestPropertyIds{
+		requiredPropertyRemoved: CallbackRequestRequiredPropertyRemovedId,
+		optionalPropertyRemoved: CallbackRequestOptionalPropertyRemovedId,
+		newRequiredProperty:     CallbackRequestNewRequiredPropertyId,
+		newOptionalProperty:     CallbackRequestNewOptionalPropertyId,
+		propertyTypeChanged:     CallbackRequestPropertyTypeChangedId,
+	}
+
+	forEachModifiedCallbackOperation(diffReport, operationsSources, func(path, operation string, operationItem *diff.MethodDiff, source string, callbackName, callbackPath, callbackOperation string, callbackOperationItem *diff.MethodDiff) {
+		newChange := func(id string, level Level, args []any, element any) Change {
+			return ApiChange{
+				Id:          id,
+				Level:       level,
+				Args:        append(args, callbackName, callbackOperation, callbackPath),
+				Operation:   operation,
+				OperationId: operationItem.Revision.OperationID,
+				Path:        path,
+				Source:      load.NewSource(source),
+			}.withLocation(config, element, callbackOperationItem.Revision, operationItem.Revision)
+		}
+
+		result = append(result, sentRequestPropertyUpdated(callbackOperationItem, ids, newChange)...)
+	})
+
+	return result
+}
+
+// sentRequestPropertyUpdated detects changes to the request properties of an operation that the API sends
+func sentRequestPropertyUpdated(operationItem *diff.MethodDiff, ids sentRequestPropertyIds, newChange sentOperationChange) Changes {
+	result := make(Changes, 0)
+
+	if operationItem.RequestBodyDiff == nil ||
+		operationItem.RequestBodyDiff.ContentDiff == nil ||
+		operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified == nil {
+		return result
+	}
+
+	for _, mediaTypeDiff := range operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified {
+		CheckDeletedPropertiesDiff(
+			mediaTypeDiff.SchemaDiff,
+			func(propertyPath string, propertyName string, propertyItem *openapi3.Schema, parent *diff.SchemaDiff) {
+				if propertyItem.WriteOnly {
+					return
+				}
+				propName := propertyFullName(propertyPath, propertyName)
+				if slices.Contains(parent.Base.Required, propertyName) {
+					result = append(result, newChange(ids.requiredPropertyRemoved, ERR, []any{propName}, propertyItem))
+				} else {
+					result = append(result, newChange(ids.optionalPropertyRemoved, WARN, []any{propName}, propertyItem))
+				}
+			})
+
+		CheckAddedPropertiesDiff(
+			mediaTypeDiff.SchemaDiff,
+			func(propertyPath string, propertyName string, propertyItem *openapi3.Schema, parent *diff.SchemaDiff) {
+				if propertyItem.WriteOnly {
+					return
+				}
+				propName := propertyFullName(propertyPath, propertyName)
+				if slices.Contains(parent.Revision.Required, propertyName) {
+					result = append(result, newChange(ids.newRequiredProperty, WARN, []any{propName}, propertyItem))
+				} else {
+					result = append(result, newChange(ids.newOptionalProperty, INFO, []any{propName}, propertyItem))
+				}
+			})
+
+		CheckModifiedPropertiesDiff(
+			mediaTypeDiff.SchemaDiff,
+			func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+				if propertyDiff.TypeDiff.Empty() || propertyDiff.Revision == nil {
+					return
+				}
+				propName := propertyFullName(propertyPath, propertyName)
+				result = append(result, newChange(ids.propertyTypeChanged, ERR, []any{propName, propertyDiff.TypeDiff.From, propertyDiff.TypeDiff.To}, propertyDiff.Revision))
+			})
+	}
+
+	return result
+}
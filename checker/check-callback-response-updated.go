@@ -0,0 +1,128 @@
+package checker
+
+import (
+	"strconv"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	CallbackResponseSuccessStatusRemovedId   = "callback-response-success-status-removed"
+	CallbackResponseSuccessStatusAddedId     = "callback-response-success-status-added"
+	CallbackResponseNewRequiredPropertyId    = "callback-response-new-required-property"
+	CallbackResponsePropertyBecameRequiredId = "callback-response-property-became-required"
+)
+
+// sentResponseIds are the ids of the changes to the responses of operations that the API sends, like callbacks and webhooks
+type sentResponseIds struct {
+	successStatusRemoved   string
+	successStatusAdded     string
+	newRequiredProperty    string
+	propertyBecameRequired string
+}
+
+func isSuccessStatus(responseStatus string) bool {
+	status, err := strconv.Atoi(responseStatus)
+	return err == nil && status >= 200 && status <= 299
+}
+
+// CallbackResponseUpdatedCheck detects tightened requirements on the responses to callback requests
+// The consumers that receive the callback requests send the responses, so, like in requests, new requirements break them
+func CallbackResponseUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+
+	ids := sentResponseIds{
+		successStatusRemoved:   CallbackResponseSuccessStatusRemovedId,
+		successStatusAdded:     CallbackResponseSuccessStatusAddedId,
+		newRequiredProperty:    CallbackResponseNewRequiredPropertyId,
+		propertyBecameRequired: CallbackResponsePropertyBecameRequiredId,
+	}
+
+	forEachModifiedCallbackOperation(diffReport, operationsSources, func(path, operation string, operationItem *diff.MethodDiff, source string, callbackName, callbackPath, callbackOperation string, callbackOperationItem *diff.MethodDiff) {
+		newChange := func(id string, level Level, args []any, element any) Change {
+			return ApiChange{
+				Id:          id,
+				Level:       level,
+				Args:        append(args, callbackName, callbackOperation, callbackPath),
+				Operation:   operation,
+				OperationId: operationItem.Revision.OperationID,
+				Path:        path,
+				Source:      load.NewSource(source),
+			}.withLocation(config, element, callbackOperationItem.Revision, operationItem.Revision)
+		}
+
+		result = append(result, sentResponseUpdated(callbackOperationItem, ids, newChange)...)
+	})
+
+	return result
+}
+
+// sentResponseUpdated detects tightened requirements on the responses of an operation that the API sends
+func sentResponseUpdated(operationItem *diff.MethodDiff, ids sentResponseIds, newChange sentOperationChange) Changes {
+	result := make(Changes, 0)
+
+	if operationItem.ResponsesDiff == nil {
+		return result
+	}
+
+	responsesDiff := operationItem.ResponsesDiff
+
+	for _, responseStatus := range responsesDiff.Deleted {
+		if isSuccessStatus(responseStatus) {
+			result = append(result, newChange(ids.successStatusRemoved, ERR, []any{responseStatus}, operationResponse(operationItem.Base, responseStatus)))
+		}
+	}
+
+	for _, responseStatus := range responsesDiff.Added {
+		if isSuccessStatus(responseStatus) {
+			result = append(result, newChange(ids.successStatusAdded, INFO, []any{responseStatus}, operationResponse(operationItem.Revision, responseStatus)))
+		}
+	}
+
+	for responseStatus, responseDiff := range responsesDiff.Modified {
+		if responseDiff.ContentDiff == nil || responseDiff.ContentDiff.MediaTypeModified == nil {
+			continue
+		}
+		for _, mediaTypeDiff := range responseDiff.ContentDiff.MediaTypeModified {
+			CheckAddedPropertiesDiff(
+				mediaTypeDiff.SchemaDiff,
+				func(propertyPath string, propertyName string, propertyItem *openapi3.Schema, parent *diff.SchemaDiff) {
+					if propertyItem.ReadOnly || !slices.Contains(parent.Revision.Required, propertyName) {
+						return
+					}
+					result = append(result, newChange(ids.newRequiredProperty, ERR, []any{propertyFullName(propertyPath, propertyName), responseStatus}, propertyItem))
+				})
+
+			if mediaTypeDiff.SchemaDiff == nil {
+				continue
+			}
+
+			becameRequired := func(propertyPath string, schemaDiff *diff.SchemaDiff) {
+				if schemaDiff.RequiredDiff == nil || schemaDiff.Revision == nil {
+					return
+				}
+				for _, propertyName := range schemaDiff.RequiredDiff.Added {
+					propertyRef := schemaDiff.Revision.Properties[propertyName]
+					if propertyRef == nil || propertyRef.Value == nil || propertyRef.Value.ReadOnly ||
+						schemaDiff.Base == nil || schemaDiff.Base.Properties[propertyName] == nil {
+						// new properties are processed separately
+						continue
+					}
+					result = append(result, newChange(ids.propertyBecameRequired, ERR, []any{propertyFullName(propertyPath, propertyName), responseStatus}, propertyRef.Value))
+				}
+			}
+
+			becameRequired("", mediaTypeDiff.SchemaDiff)
+			CheckModifiedPropertiesDiff(
+				mediaTypeDiff.SchemaDiff,
+				func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+					becameRequired(propertyFullName(propertyPath, propertyName), propertyDiff)
+				})
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,138 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	CallbackRemovedId          = "callback-removed"
+	CallbackAddedId            = "callback-added"
+	CallbackOperationRemovedId = "callback-operation-removed"
+	CallbackOperationAddedId   = "callback-operation-added"
+)
+
+// CallbackUpdatedCheck detects callbacks, and operations in callbacks, that were added or removed
+// In callbacks the roles are reversed: the API is the client and the API consumers are the servers that receive the requests
+// Removing a callback or a callback operation is breaking because consumers no longer receive the requests they rely on
+func CallbackUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.CallbacksDiff == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+
+			for _, callbackName := range operationItem.CallbacksDiff.Deleted {
+				result = append(result, ApiChange{
+					Id:          CallbackRemovedId,
+					Level:       ERR,
+					Args:        []any{callbackName},
+					Operation:   operation,
+					OperationId: operationItem.Revision.OperationID,
+					Path:        path,
+					Source:      load.NewSource(source),
+				}.withLocation(config, operationItem.Base.Callbacks[callbackName].Value, operationItem.Base))
+			}
+
+			for _, callbackName := range operationItem.CallbacksDiff.Added {
+				result = append(result, ApiChange{
+					Id:          CallbackAddedId,
+					Level:       INFO,
+					Args:        []any{callbackName},
+					Operation:   operation,
+					OperationId: operationItem.Revision.OperationID,
+					Path:        path,
+					Source:      load.NewSource(source),
+				}.withLocation(config, operationItem.Revision.Callbacks[callbackName].Value, operationItem.Revision))
+			}
+
+			for callbackName, callbackPathsDiff := range operationItem.CallbacksDiff.Modified {
+				result = append(result, callbackOperationsUpdated(config, path, operation, operationItem, source, callbackName, callbackPathsDiff)...)
+			}
+		}
+	}
+	return result
+}
+
+func callbackOperationsUpdated(config *Config, path, operation string, operationItem *diff.MethodDiff, source, callbackName string, callbackPathsDiff *diff.PathsDiff) Changes {
+	result := make(Changes, 0)
+
+	newChange := func(id string, level Level, callbackPath, callbackOperation string, element any) Change {
+		return ApiChange{
+			Id:          id,
+			Level:       level,
+			Args:        []any{callbackName, callbackOperation, callbackPath},
+			Operation:   operation,
+			OperationId: operationItem.Revision.OperationID,
+			Path:        path,
+			Source:      load.NewSource(source),
+		}.withLocation(config, element, operationItem.Revision)
+	}
+
+	for _, callbackPath := range callbackPathsDiff.Deleted {
+		for callbackOperation, callbackOperationItem := range callbackPathsDiff.Base.Value(callbackPath).Operations() {
+			result = append(result, newChange(CallbackOperationRemovedId, ERR, callbackPath, callbackOperation, callbackOperationItem))
+		}
+	}
+
+	for _, callbackPath := range callbackPathsDiff.Added {
+		for callbackOperation, callbackOperationItem := range callbackPathsDiff.Revision.Value(callbackPath).Operations() {
+			result = append(result, newChange(CallbackOperationAddedId, INFO, callbackPath, callbackOperation, callbackOperationItem))
+		}
+	}
+
+	for callbackPath, callbackPathItem := range callbackPathsDiff.Modified {
+		if callbackPathItem.OperationsDiff == nil {
+			continue
+		}
+		for _, callbackOperation := range callbackPathItem.OperationsDiff.Deleted {
+			result = append(result, newChange(CallbackOperationRemovedId, ERR, callbackPath, callbackOperation, callbackPathItem.Base.GetOperation(callbackOperation)))
+		}
+		for _, callbackOperation := range callbackPathItem.OperationsDiff.Added {
+			result = append(result, newChange(CallbackOperationAddedId, INFO, callbackPath, callbackOperation, callbackPathItem.Revision.GetOperation(callbackOperation)))
+		}
+	}
+
+	return result
+}
+
+// callbackOperationVisitor is called for each operation that was modified in a callback of a modified operation
+type callbackOperationVisitor func(path, operation string, operationItem *diff.MethodDiff, source string, callbackName, callbackPath, callbackOperation string, callbackOperationItem *diff.MethodDiff)
+
+func forEachModifiedCallbackOperation(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, visitor callbackOperationVisitor) {
+	if diffReport.PathsDiff == nil {
+		return
+	}
+
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.CallbacksDiff == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+
+			for callbackName, callbackPathsDiff := range operationItem.CallbacksDiff.Modified {
+				for callbackPath, callbackPathItem := range callbackPathsDiff.Modified {
+					if callbackPathItem.OperationsDiff == nil {
+						continue
+					}
+					for callbackOperation, callbackOperationItem := range callbackPathItem.OperationsDiff.Modified {
+						visitor(path, operation, operationItem, source, callbackName, callbackPath, callbackOperation, callbackOperationItem)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,278 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	callbackBase = "../data/checker/callback_base.yaml"
+	callbackPath = "{$request.body#/callbackUrl}"
+)
+
+func getCallbackOperation(t *testing.T, s *load.SpecInfo) *openapi3.Operation {
+	t.Helper()
+	return s.Spec.Paths.Value("/subscribe").Post.Callbacks["onEvent"].Value.Value(callbackPath).Post
+}
+
+// BC: removing a callback is breaking
+func TestCallbackRemoved(t *testing.T) {
+	s1, err := open(callbackBase)
+	require.NoError(t, err)
+	s2, err := open(callbackBase)
+	require.NoError(t, err)
+
+	s2.Spec.Paths.Value("/subscribe").Post.Callbacks = openapi3.Callbacks{}
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.CallbackUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.CallbackRemovedId,
+		Args:        []any{"onEvent"},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/subscribe",
+		Source:      load.NewSource(callbackBase),
+		OperationId: "subscribe",
+	}, errs[0])
+	require.Equal(t, "removed the callback 'onEvent'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// CL: adding a callback
+func TestCallbackAdded(t *testing.T) {
+	s1, err := open(callbackBase)
+	require.NoError(t, err)
+	s2, err := open(callbackBase)
+	require.NoError(t, err)
+
+	s2.Spec.Paths.Value("/subscribe").Post.Callbacks["onFailure"] = s2.Spec.Paths.Value("/subscribe").Post.Callbacks["onEvent"]
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.CallbackUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.CallbackAddedId,
+		Args:        []any{"onFailure"},
+		Level:       checker.INFO,
+		Operation:   "POST",
+		Path:        "/subscribe",
+		Source:      load.NewSource(callbackBase),
+		OperationId: "subscribe",
+	}, errs[0])
+}
+
+// BC: removing an operation from a callback is breaking
+func TestCallbackOperationRemoved(t *testing.T) {
+	s1, err := open(callbackBase)
+	require.NoError(t, err)
+	s2, err := open(callbackBase)
+	require.NoError(t, err)
+
+	callbackPathItem := s2.Spec.Paths.Value("/subscribe").Post.Callbacks["onEvent"].Value.Value(callbackPath)
+	callbackPathItem.Put = callbackPathItem.Post
+	callbackPathItem.Post = nil
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.CallbackUpdatedCheck), d, osm, checker.INFO)
+	require.ElementsMatch(t, []checker.ApiChange{
+		{
+			Id:          checker.CallbackOperationRemovedId,
+			Args:        []any{"onEvent", "POST", callbackPath},
+			Level:       checker.ERR,
+			Operation:   "POST",
+			Path:        "/subscribe",
+			Source:      load.NewSource(callbackBase),
+			OperationId: "subscribe",
+		},
+		{
+			Id:          checker.CallbackOperationAddedId,
+			Args:        []any{"onEvent", "PUT", callbackPath},
+			Level:       checker.INFO,
+			Operation:   "POST",
+			Path:        "/subscribe",
+			Source:      load.NewSource(callbackBase),
+			OperationId: "subscribe",
+		},
+	}, errs)
+	require.Equal(t, "removed the callback 'onEvent' operation 'POST' '{$request.body#/callbackUrl}'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: removing a property from a callback request is breaking
+func TestCallbackRequestPropertyRemoved(t *testing.T) {
+	s1, err := open(callbackBase)
+	require.NoError(t, err)
+	s2, err := open(callbackBase)
+	require.NoError(t, err)
+
+	schema := getCallbackOperation(t, s2).RequestBody.Value.Content["application/json"].Schema.Value
+	delete(schema.Properties, "id")
+	delete(schema.Properties, "name")
+	schema.Required = []string{}
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.CallbackRequestPropertyUpdatedCheck), d, osm, checker.INFO)
+	require.ElementsMatch(t, []checker.ApiChange{
+		{
+			Id:          checker.CallbackRequestRequiredPropertyRemovedId,
+			Args:        []any{"id", "onEvent", "POST", callbackPath},
+			Level:       checker.ERR,
+			Operation:   "POST",
+			Path:        "/subscribe",
+			Source:      load.NewSource(callbackBase),
+			OperationId: "subscribe",
+		},
+		{
+			Id:          checker.CallbackRequestOptionalPropertyRemovedId,
+			Args:        []any{"name", "onEvent", "POST", callbackPath},
+			Level:       checker.WARN,
+			Operation:   "POST",
+			Path:        "/subscribe",
+			Source:      load.NewSource(callbackBase),
+			OperationId: "subscribe",
+		},
+	}, errs)
+}
+
+// BC: adding a required property to a callback request is breaking
+func TestCallbackRequestPropertyAdded(t *testing.T) {
+	s1, err := open(callbackBase)
+	require.NoError(t, err)
+	s2, err := open(callbackBase)
+	require.NoError(t, err)
+
+	schema := getCallbackOperation(t, s2).RequestBody.Value.Content["application/json"].Schema.Value
+	schema.Properties["type"] = openapi3.NewSchemaRef("", openapi3.NewStringSchema())
+	schema.Properties["extra"] = openapi3.NewSchemaRef("", openapi3.NewStringSchema())
+	schema.Required = []string{"id", "type"}
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.CallbackRequestPropertyUpdatedCheck), d, osm, checker.INFO)
+	require.ElementsMatch(t, []checker.ApiChange{
+		{
+			Id:          checker.CallbackRequestNewRequiredPropertyId,
+			Args:        []any{"type", "onEvent", "POST", callbackPath},
+			Level:       checker.WARN,
+			Operation:   "POST",
+			Path:        "/subscribe",
+			Source:      load.NewSource(callbackBase),
+			OperationId: "subscribe",
+		},
+		{
+			Id:          checker.CallbackRequestNewOptionalPropertyId,
+			Args:        []any{"extra", "onEvent", "POST", callbackPath},
+			Level:       checker.INFO,
+			Operation:   "POST",
+			Path:        "/subscribe",
+			Source:      load.NewSource(callbackBase),
+			OperationId: "subscribe",
+		},
+	}, errs)
+}
+
+// BC: changing the type of a callback request property is breaking
+func TestCallbackRequestPropertyTypeChanged(t *testing.T) {
+	s1, err := open(callbackBase)
+	require.NoError(t, err)
+	s2, err := open(callbackBase)
+	require.NoError(t, err)
+
+	schema := getCallbackOperation(t, s2).RequestBody.Value.Content["application/json"].Schema.Value
+	schema.Properties["name"] = openapi3.NewSchemaRef("", openapi3.NewIntegerSchema())
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.CallbackRequestPropertyUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.CallbackRequestPropertyTypeChangedId,
+		Args:        []any{"name", "string", "integer", "onEvent", "POST", callbackPath},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/subscribe",
+		Source:      load.NewSource(callbackBase),
+		OperationId: "subscribe",
+	}, errs[0])
+}
+
+// BC: removing a success status from a callback response is breaking
+func TestCallbackResponseSuccessStatusRemoved(t *testing.T) {
+	s1, err := open(callbackBase)
+	require.NoError(t, err)
+	s2, err := open(callbackBase)
+	require.NoError(t, err)
+
+	responses := getCallbackOperation(t, s2).Responses
+	responses.Set("204", responses.Value("200"))
+	delete(responses.Map(), "200")
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.CallbackResponseUpdatedCheck), d, osm, checker.INFO)
+	require.ElementsMatch(t, []checker.ApiChange{
+		{
+			Id:          checker.CallbackResponseSuccessStatusRemovedId,
+			Args:        []any{"200", "onEvent", "POST", callbackPath},
+			Level:       checker.ERR,
+			Operation:   "POST",
+			Path:        "/subscribe",
+			Source:      load.NewSource(callbackBase),
+			OperationId: "subscribe",
+		},
+		{
+			Id:          checker.CallbackResponseSuccessStatusAddedId,
+			Args:        []any{"204", "onEvent", "POST", callbackPath},
+			Level:       checker.INFO,
+			Operation:   "POST",
+			Path:        "/subscribe",
+			Source:      load.NewSource(callbackBase),
+			OperationId: "subscribe",
+		},
+	}, errs)
+}
+
+// BC: adding a required property to a callback response is breaking
+func TestCallbackResponseNewRequiredProperty(t *testing.T) {
+	s1, err := open(callbackBase)
+	require.NoError(t, err)
+	s2, err := open(callbackBase)
+	require.NoError(t, err)
+
+	schema := getCallbackOperation(t, s2).Responses.Value("200").Value.Content["application/json"].Schema.Value
+	schema.Properties["id"] = openapi3.NewSchemaRef("", openapi3.NewStringSchema())
+	schema.Required = []string{"id", "status"}
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.CallbackResponseUpdatedCheck), d, osm, checker.INFO)
+	require.ElementsMatch(t, []checker.ApiChange{
+		{
+			Id:          checker.CallbackResponseNewRequiredPropertyId,
+			Args:        []any{"id", "200", "onEvent", "POST", callbackPath},
+			Level:       checker.ERR,
+			Operation:   "POST",
+			Path:        "/subscribe",
+			Source:      load.NewSource(callbackBase),
+			OperationId: "subscribe",
+		},
+		{
+			Id:          checker.CallbackResponsePropertyBecameRequiredId,
+			Args:        []any{"status", "200", "onEvent", "POST", callbackPath},
+			Level:       checker.ERR,
+			Operation:   "POST",
+			Path:        "/subscribe",
+			Source:      load.NewSource(callbackBase),
+			OperationId: "subscribe",
+		},
+	}, errs)
+}
@@ -0,0 +1,49 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	APIComponentsExampleRemovedId      = "api-example-component-removed"
+	APIComponentsExampleAddedId        = "api-example-component-added"
+	APIComponentsExampleValueChangedId = "api-example-component-value-changed"
+)
+
+const ComponentExamples = "examples"
+
+// APIComponentsExamplesUpdatedCheck detects changes to the examples in components/examples
+// Examples don't affect clients, so these changes are informational
+func APIComponentsExamplesUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	examplesDiff := diffReport.ComponentsDiff.ExamplesDiff
+	if examplesDiff == nil {
+		return result
+	}
+
+	newChange := func(id string, exampleName string) Change {
+		return ComponentChange{
+			Id:        id,
+			Level:     INFO,
+			Args:      []any{exampleName},
+			Component: ComponentExamples,
+		}.withLocation(config, load.ComponentKey{Section: ComponentExamples, Name: exampleName})
+	}
+
+	for _, exampleName := range examplesDiff.Deleted {
+		result = append(result, newChange(APIComponentsExampleRemovedId, exampleName))
+	}
+
+	for _, exampleName := range examplesDiff.Added {
+		result = append(result, newChange(APIComponentsExampleAddedId, exampleName))
+	}
+
+	for exampleName, exampleDiff := range examplesDiff.Modified {
+		if exampleDiff.ValueDiff != nil || exampleDiff.ExternalValueDiff != nil {
+			result = append(result, newChange(APIComponentsExampleValueChangedId, exampleName))
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,40 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/load"
+)
+
+// CL: adding an example component
+func TestComponentExampleAdded(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, componentLinksBase, singleCheckConfig(checker.APIComponentsExamplesUpdatedCheck), func(s *load.SpecInfo) {
+		s.Spec.Components.Examples["EmptyGroup"] = &openapi3.ExampleRef{Value: openapi3.NewExample(map[string]any{})}
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ComponentChange{
+		Id:        checker.APIComponentsExampleAddedId,
+		Args:      []any{"EmptyGroup"},
+		Level:     checker.INFO,
+		Component: checker.ComponentExamples,
+	}, errs[0])
+	require.Equal(t, "the component example 'EmptyGroup' was added", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// CL: changing the value of an example component
+func TestComponentExampleValueChanged(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, componentLinksBase, singleCheckConfig(checker.APIComponentsExamplesUpdatedCheck), func(s *load.SpecInfo) {
+		s.Spec.Components.Examples["Group"].Value.Value = map[string]any{"id": "2"}
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ComponentChange{
+		Id:        checker.APIComponentsExampleValueChangedId,
+		Args:      []any{"Group"},
+		Level:     checker.INFO,
+		Component: checker.ComponentExamples,
+	}, errs[0])
+	require.Equal(t, "the value of the component example 'Group' was changed", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
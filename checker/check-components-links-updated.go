@@ -0,0 +1,68 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	APIComponentsLinkRemovedId          = "api-link-component-removed"
+	APIComponentsLinkAddedId            = "api-link-component-added"
+	APIComponentsLinkTargetChangedId    = "api-link-component-target-changed"
+	APIComponentsLinkParameterRemovedId = "api-link-component-parameter-removed"
+	APIComponentsLinkParameterAddedId   = "api-link-component-parameter-added"
+	APIComponentsLinkParameterChangedId = "api-link-component-parameter-changed"
+)
+
+const ComponentLinks = "links"
+
+// APIComponentsLinksUpdatedCheck detects changes to the links in components/links
+// Responses that refer to these links are reported by ResponseLinksUpdatedCheck, so these changes are informational
+func APIComponentsLinksUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	linksDiff := diffReport.ComponentsDiff.LinksDiff
+	if linksDiff == nil {
+		return result
+	}
+
+	newChange := func(id string, args []any, linkName string) Change {
+		return ComponentChange{
+			Id:        id,
+			Level:     INFO,
+			Args:      args,
+			Component: ComponentLinks,
+		}.withLocation(config, load.ComponentKey{Section: ComponentLinks, Name: linkName})
+	}
+
+	for _, linkName := range linksDiff.Deleted {
+		result = append(result, newChange(APIComponentsLinkRemovedId, []any{linkName}, linkName))
+	}
+
+	for _, linkName := range linksDiff.Added {
+		result = append(result, newChange(APIComponentsLinkAddedId, []any{linkName}, linkName))
+	}
+
+	for linkName, linkDiff := range linksDiff.Modified {
+		if baseTarget, revisionTarget := getLinkTarget(getLink(linksDiff.Base, linkName)), getLinkTarget(getLink(linksDiff.Revision, linkName)); baseTarget != revisionTarget {
+			result = append(result, newChange(APIComponentsLinkTargetChangedId, []any{linkName, baseTarget, revisionTarget}, linkName))
+		}
+
+		if linkDiff.ParametersDiff == nil {
+			continue
+		}
+
+		for _, paramName := range linkDiff.ParametersDiff.Deleted {
+			result = append(result, newChange(APIComponentsLinkParameterRemovedId, []any{paramName, linkName}, linkName))
+		}
+
+		for _, paramName := range linkDiff.ParametersDiff.Added {
+			result = append(result, newChange(APIComponentsLinkParameterAddedId, []any{paramName, linkName}, linkName))
+		}
+
+		for paramName, paramDiff := range linkDiff.ParametersDiff.Modified {
+			result = append(result, newChange(APIComponentsLinkParameterChangedId, []any{paramName, linkName, paramDiff.From, paramDiff.To}, linkName))
+		}
+	}
+
+	return result
+}
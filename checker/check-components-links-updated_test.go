@@ -0,0 +1,92 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/load"
+)
+
+const componentLinksBase = "../data/checker/component_links_base.yaml"
+
+// CL: removing a link component
+func TestComponentLinkRemoved(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, componentLinksBase, singleCheckConfig(checker.APIComponentsLinksUpdatedCheck), func(s *load.SpecInfo) {
+		delete(s.Spec.Components.Links, "GetGroup")
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ComponentChange{
+		Id:        checker.APIComponentsLinkRemovedId,
+		Args:      []any{"GetGroup"},
+		Level:     checker.INFO,
+		Component: checker.ComponentLinks,
+	}, errs[0])
+	require.Equal(t, "the component link 'GetGroup' was removed", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// CL: adding a link component
+func TestComponentLinkAdded(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, componentLinksBase, singleCheckConfig(checker.APIComponentsLinksUpdatedCheck), func(s *load.SpecInfo) {
+		s.Spec.Components.Links["UnusedLink"] = &openapi3.LinkRef{Value: &openapi3.Link{OperationID: "getGroup"}}
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ComponentChange{
+		Id:        checker.APIComponentsLinkAddedId,
+		Args:      []any{"UnusedLink"},
+		Level:     checker.INFO,
+		Component: checker.ComponentLinks,
+	}, errs[0])
+	require.Equal(t, "the component link 'UnusedLink' was added", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// CL: changing the target of a link component
+func TestComponentLinkTargetChanged(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, componentLinksBase, singleCheckConfig(checker.APIComponentsLinksUpdatedCheck), func(s *load.SpecInfo) {
+		link := s.Spec.Components.Links["GetGroup"].Value
+		link.OperationID = ""
+		link.OperationRef = "#/paths/~1api~1v1.0~1groups~1{groupId}/get"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ComponentChange{
+		Id:        checker.APIComponentsLinkTargetChangedId,
+		Args:      []any{"GetGroup", "getGroup", "#/paths/~1api~1v1.0~1groups~1{groupId}/get"},
+		Level:     checker.INFO,
+		Component: checker.ComponentLinks,
+	}, errs[0])
+	require.Equal(t, "the target of the component link 'GetGroup' was changed from 'getGroup' to '#/paths/~1api~1v1.0~1groups~1{groupId}/get'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// CL: changing the parameters of a link component
+func TestComponentLinkParametersUpdated(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, componentLinksBase, singleCheckConfig(checker.APIComponentsLinksUpdatedCheck), func(s *load.SpecInfo) {
+		s.Spec.Components.Links["GetGroup"].Value.Parameters = map[string]any{
+			"groupId": "$response.body#/groupId",
+			"expand":  "true",
+		}
+	})
+	require.ElementsMatch(t, []checker.Change{
+		checker.ComponentChange{
+			Id:        checker.APIComponentsLinkParameterAddedId,
+			Args:      []any{"expand", "GetGroup"},
+			Level:     checker.INFO,
+			Component: checker.ComponentLinks,
+		},
+		checker.ComponentChange{
+			Id:        checker.APIComponentsLinkParameterChangedId,
+			Args:      []any{"groupId", "GetGroup", "$response.body#/id", "$response.body#/groupId"},
+			Level:     checker.INFO,
+			Component: checker.ComponentLinks,
+		},
+	}, errs)
+}
+
+// CL: changing a link component is also reported for the responses that refer to it
+func TestComponentLinkReferencedByResponse(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, componentLinksBase, singleCheckConfig(checker.ResponseLinksUpdatedCheck), func(s *load.SpecInfo) {
+		s.Spec.Components.Links["GetGroup"].Value.OperationID = "getGroups"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ResponseLinkTargetChangedId, errs[0].GetId())
+}
@@ -2,6 +2,7 @@ package checker
 
 import (
 	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
 )
 
 const (
@@ -34,7 +35,7 @@ func checkOAuthUpdates(updatedSecurity *diff.SecuritySchemeDiff, config *Config,
 			Level:     INFO,
 			Args:      []any{updatedSecurityName, urlDiff.From, urlDiff.To},
 			Component: ComponentSecuritySchemes,
-		})
+		}.withLocation(config, load.ComponentKey{Section: ComponentSecuritySchemes, Name: updatedSecurityName}))
 	}
 
 	if tokenDiff := updatedSecurity.OAuthFlowsDiff.ImplicitDiff.TokenURLDiff; tokenDiff != nil {
@@ -43,7 +44,7 @@ func checkOAuthUpdates(updatedSecurity *diff.SecuritySchemeDiff, config *Config,
 			Level:     INFO,
 			Args:      []any{updatedSecurityName, tokenDiff.From, tokenDiff.To},
 			Component: ComponentSecuritySchemes,
-		})
+		}.withLocation(config, load.ComponentKey{Section: ComponentSecuritySchemes, Name: updatedSecurityName}))
 	}
 
 	if scopesDiff := updatedSecurity.OAuthFlowsDiff.ImplicitDiff.ScopesDiff; scopesDiff != nil {
@@ -53,7 +54,7 @@ func checkOAuthUpdates(updatedSecurity *diff.SecuritySchemeDiff, config *Config,
 				Level:     INFO,
 				Args:      []any{updatedSecurityName, addedScope},
 				Component: ComponentSecuritySchemes,
-			})
+			}.withLocation(config, load.ComponentKey{Section: ComponentSecuritySchemes, Name: updatedSecurityName}))
 		}
 
 		for _, removedScope := range scopesDiff.Deleted {
@@ -62,7 +63,7 @@ func checkOAuthUpdates(updatedSecurity *diff.SecuritySchemeDiff, config *Config,
 				Level:     INFO,
 				Args:      []any{updatedSecurityName, removedScope},
 				Component: ComponentSecuritySchemes,
-			})
+			}.withLocation(config, load.ComponentKey{Section: ComponentSecuritySchemes, Name: updatedSecurityName}))
 		}
 
 		for name, modifiedScope := range scopesDiff.Modified {
@@ -71,7 +72,7 @@ func checkOAuthUpdates(updatedSecurity *diff.SecuritySchemeDiff, config *Config,
 				Level:     INFO,
 				Args:      []any{updatedSecurityName, name, modifiedScope.From, modifiedScope.To},
 				Component: ComponentSecuritySchemes,
-			})
+			}.withLocation(config, load.ComponentKey{Section: ComponentSecuritySchemes, Name: updatedSecurityName}))
 		}
 
 	}
@@ -91,7 +92,7 @@ func APIComponentsSecurityUpdatedCheck(diffReport *diff.Diff, operationsSources
 			Level:     INFO,
 			Args:      []any{updatedSecurity},
 			Component: ComponentSecuritySchemes,
-		})
+		}.withLocation(config, load.ComponentKey{Section: ComponentSecuritySchemes, Name: updatedSecurity}))
 	}
 
 	for _, updatedSecurity := range diffReport.ComponentsDiff.SecuritySchemesDiff.Deleted {
@@ -100,7 +101,7 @@ func APIComponentsSecurityUpdatedCheck(diffReport *diff.Diff, operationsSources
 			Level:     INFO,
 			Args:      []any{updatedSecurity},
 			Component: ComponentSecuritySchemes,
-		})
+		}.withLocation(config, load.ComponentKey{Section: ComponentSecuritySchemes, Name: updatedSecurity}))
 	}
 
 	for updatedSecurityName, updatedSecurity := range diffReport.ComponentsDiff.SecuritySchemesDiff.Modified {
@@ -112,7 +113,7 @@ func APIComponentsSecurityUpdatedCheck(diffReport *diff.Diff, operationsSources
 				Level:     INFO,
 				Args:      []any{updatedSecurityName, updatedSecurity.TypeDiff.From, updatedSecurity.TypeDiff.To},
 				Component: ComponentSecuritySchemes,
-			})
+			}.withLocation(config, load.ComponentKey{Section: ComponentSecuritySchemes, Name: updatedSecurityName}))
 		}
 	}
 
@@ -0,0 +1,153 @@
+package checker
+
+import (
+	"errors"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	RequestParameterExampleInvalidId = "request-parameter-example-invalid"
+	RequestBodyExampleInvalidId      = "request-body-example-invalid"
+	ResponseBodyExampleInvalidId     = "response-body-example-invalid"
+)
+
+// singleExampleName is the name used for the example field, as opposed to the named examples
+const singleExampleName = "example"
+
+// getExampleValues returns the values of the example field and of the named examples, excluding external examples
+func getExampleValues(example any, examples openapi3.Examples) map[string]any {
+	result := map[string]any{}
+	if example != nil {
+		result[singleExampleName] = example
+	}
+	for name, exampleRef := range examples {
+		if exampleRef == nil || exampleRef.Value == nil || exampleRef.Value.Value == nil {
+			continue
+		}
+		result[name] = exampleRef.Value.Value
+	}
+	return result
+}
+
+// validateExample returns the reason why the example doesn't conform to the schema, or an empty string if it does
+func validateExample(schemaRef *openapi3.SchemaRef, value any, opts ...openapi3.SchemaValidationOption) string {
+	if schemaRef == nil || schemaRef.Value == nil {
+		return ""
+	}
+	err := schemaRef.Value.VisitJSON(value, opts...)
+	if err == nil {
+		return ""
+	}
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err, &schemaErr) {
+		return schemaErr.Reason
+	}
+	return err.Error()
+}
+
+// getInvalidExamples returns the examples in the revision that don't conform to the revision schema, mapped to the reasons
+// Examples that already didn't conform to the base schema are excluded, so that only examples that became invalid are reported
+func getInvalidExamples(baseSchema, revisionSchema *openapi3.SchemaRef, baseExamples, revisionExamples map[string]any, opts ...openapi3.SchemaValidationOption) map[string]string {
+	result := map[string]string{}
+	for name, value := range revisionExamples {
+		reason := validateExample(revisionSchema, value, opts...)
+		if reason == "" {
+			continue
+		}
+		if baseValue, ok := baseExamples[name]; ok && validateExample(baseSchema, baseValue, opts...) != "" {
+			continue
+		}
+		result[name] = reason
+	}
+	return result
+}
+
+func getMediaTypeExamples(mediaType *openapi3.MediaType) map[string]any {
+	if mediaType == nil {
+		return nil
+	}
+	return getExampleValues(mediaType.Example, mediaType.Examples)
+}
+
+func getMediaTypeSchema(mediaType *openapi3.MediaType) *openapi3.SchemaRef {
+	if mediaType == nil {
+		return nil
+	}
+	return mediaType.Schema
+}
+
+// ExamplesInvalidCheck detects examples of parameters, request bodies and responses that no longer conform to their schemas
+// An example becomes invalid when either the example or the schema changes, so all the examples of modified operations are validated
+func ExamplesInvalidCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			source := (*operationsSources)[operationItem.Revision]
+
+			newChange := func(id string, args []any, element any) Change {
+				return ApiChange{
+					Id:          id,
+					Level:       config.getLogLevel(id, INFO),
+					Args:        args,
+					Operation:   operation,
+					OperationId: operationItem.Revision.OperationID,
+					Path:        path,
+					Source:      load.NewSource(source),
+				}.withLocation(config, element, operationItem.Revision)
+			}
+
+			if operationItem.ParametersDiff != nil {
+				for _, paramRef := range operationItem.Revision.Parameters {
+					if paramRef == nil || paramRef.Value == nil {
+						continue
+					}
+					param := paramRef.Value
+					baseSchema, baseExamples := (*openapi3.SchemaRef)(nil), map[string]any(nil)
+					if baseParam := operationItem.Base.Parameters.GetByInAndName(param.In, param.Name); baseParam != nil {
+						baseSchema, baseExamples = baseParam.Schema, getExampleValues(baseParam.Example, baseParam.Examples)
+					}
+					for name, reason := range getInvalidExamples(baseSchema, param.Schema, baseExamples, getExampleValues(param.Example, param.Examples), openapi3.VisitAsRequest()) {
+						result = append(result, newChange(RequestParameterExampleInvalidId, []any{param.In, param.Name, name, reason}, param))
+					}
+				}
+			}
+
+			if operationItem.RequestBodyDiff != nil {
+				for mediaTypeName, mediaType := range requestBodyContent(operationItem.Revision) {
+					baseMediaType := requestBodyContent(operationItem.Base)[mediaTypeName]
+					for name, reason := range getInvalidExamples(getMediaTypeSchema(baseMediaType), mediaType.Schema, getMediaTypeExamples(baseMediaType), getMediaTypeExamples(mediaType), openapi3.VisitAsRequest()) {
+						result = append(result, newChange(RequestBodyExampleInvalidId, []any{mediaTypeName, name, reason}, mediaType))
+					}
+				}
+			}
+
+			if operationItem.ResponsesDiff != nil && operationItem.Revision.Responses != nil {
+				for responseStatus, responseRef := range operationItem.Revision.Responses.Map() {
+					if responseRef == nil || responseRef.Value == nil {
+						continue
+					}
+					baseResponse := operationResponse(operationItem.Base, responseStatus)
+					for mediaTypeName, mediaType := range responseRef.Value.Content {
+						var baseMediaType *openapi3.MediaType
+						if baseResponse != nil {
+							baseMediaType = baseResponse.Content[mediaTypeName]
+						}
+						for name, reason := range getInvalidExamples(getMediaTypeSchema(baseMediaType), mediaType.Schema, getMediaTypeExamples(baseMediaType), getMediaTypeExamples(mediaType), openapi3.VisitAsResponse()) {
+							result = append(result, newChange(ResponseBodyExampleInvalidId, []any{mediaTypeName, name, reason, responseStatus}, mediaType))
+						}
+					}
+				}
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,83 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+	"github.com/tufin/oasdiff/utils"
+)
+
+const examplesBase = "../data/checker/examples_base.yaml"
+
+func getExamplesOperation(s *load.SpecInfo) *openapi3.Operation {
+	return s.Spec.Paths.Value("/api/v1.0/groups").Post
+}
+
+// CL: restricting a request body schema so that an example no longer conforms to it
+func TestRequestBodyExampleInvalid(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, examplesBase, singleCheckConfig(checker.ExamplesInvalidCheck), func(s *load.SpecInfo) {
+		getExamplesOperation(s).RequestBody.Value.Content["application/json"].Schema.Value.Properties["name"].Value.MaxLength = openapi3.Uint64Ptr(10)
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestBodyExampleInvalidId,
+		Args:        []any{"application/json", "long", "maximum string length is 10"},
+		Level:       checker.INFO,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(examplesBase),
+		OperationId: "createGroup",
+	}, errs[0])
+	require.Equal(t, "the request body media type 'application/json' example 'long' doesn't conform to its schema ('maximum string length is 10')", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: changing a request parameter example so that it no longer conforms to the schema is breaking when the check is included
+func TestRequestParameterExampleInvalidIncluded(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, examplesBase, checker.GetChecks(utils.StringList{checker.RequestParameterExampleInvalidId}), func(s *load.SpecInfo) {
+		getExamplesOperation(s).Parameters.GetByInAndName(openapi3.ParameterInQuery, "limit").Example = 500
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestParameterExampleInvalidId, errs[0].GetId())
+	require.Equal(t, checker.ERR, errs[0].GetLevel())
+	require.Equal(t, []any{"query", "limit", "example", "number must be at most 100"}, errs[0].(checker.ApiChange).Args)
+}
+
+// CL: changing a response example so that it no longer conforms to the schema
+func TestResponseBodyExampleInvalid(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, examplesBase, singleCheckConfig(checker.ExamplesInvalidCheck), func(s *load.SpecInfo) {
+		getExamplesOperation(s).Responses.Value("200").Value.Content["application/json"].Example = map[string]any{"id": "123", "size": "five"}
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ResponseBodyExampleInvalidId, errs[0].GetId())
+	require.Equal(t, []any{"application/json", "example", "value must be an integer", "200"}, errs[0].(checker.ApiChange).Args)
+}
+
+// CL: examples that didn't conform to the schema before the change aren't reported
+func TestExampleAlreadyInvalid(t *testing.T) {
+	s1, err := open(examplesBase)
+	require.NoError(t, err)
+	s2, err := open(examplesBase)
+	require.NoError(t, err)
+
+	for _, s := range []*load.SpecInfo{s1, s2} {
+		getExamplesOperation(s).Parameters.GetByInAndName(openapi3.ParameterInQuery, "limit").Example = 500
+	}
+	getExamplesOperation(s2).Parameters.GetByInAndName(openapi3.ParameterInQuery, "limit").Description = "page size"
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.ExamplesInvalidCheck), d, osm, checker.INFO)
+	require.Empty(t, errs)
+}
+
+// CL: examples that still conform to the schema aren't reported
+func TestExampleStillValid(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, examplesBase, singleCheckConfig(checker.ExamplesInvalidCheck), func(s *load.SpecInfo) {
+		getExamplesOperation(s).RequestBody.Value.Content["application/json"].Schema.Value.Properties["name"].Value.MaxLength = openapi3.Uint64Ptr(30)
+	})
+	require.Empty(t, errs)
+}
@@ -10,6 +10,7 @@ const (
 	NewOptionalRequestDefaultParameterToExistingPathId = "new-optional-request-default-parameter-to-existing-path"
 )
 
+// NewRequestNonPathDefaultParameterCheck detects new query, header and cookie parameters that were added at the path level, and so to all the operations of the path
 func NewRequestNonPathDefaultParameterCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
 	result := make(Changes, 0)
 	if diffReport.PathsDiff == nil || len(diffReport.PathsDiff.Modified) == 0 {
@@ -26,7 +27,7 @@ func NewRequestNonPathDefaultParameterCheck(diffReport *diff.Diff, operationsSou
 			}
 
 			for _, param := range pathItem.Revision.Parameters {
-				if !paramNameList.Contains(param.Value.Name) {
+				if param.Value.In != paramLoc || !paramNameList.Contains(param.Value.Name) {
 					continue
 				}
 				id := NewRequiredRequestDefaultParameterToExistingPathId
@@ -48,7 +49,7 @@ func NewRequestNonPathDefaultParameterCheck(diffReport *diff.Diff, operationsSou
 						OperationId: operationItem.OperationID,
 						Path:        path,
 						Source:      load.NewSource((*operationsSources)[operationItem]),
-					})
+					}.withLocation(config, param.Value, operationItem))
 				}
 			}
 		}
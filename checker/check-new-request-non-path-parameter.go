@@ -10,6 +10,8 @@ const (
 	NewOptionalRequestParameterId = "new-optional-request-parameter"
 )
 
+// NewRequestNonPathParameterCheck detects new query, header and cookie parameters
+// Parameters are identified by location and name, since parameters in different locations may have the same name
 func NewRequestNonPathParameterCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
 	result := make(Changes, 0)
 	if diffReport.PathsDiff == nil {
@@ -31,7 +33,7 @@ func NewRequestNonPathParameterCheck(diffReport *diff.Diff, operationsSources *d
 
 				for _, paramName := range paramItems {
 					for _, param := range operationItem.Revision.Parameters {
-						if param.Value.Name == paramName {
+						if param.Value.Name == paramName && param.Value.In == paramLocation {
 							id := NewRequiredRequestParameterId
 							level := ERR
 							if !param.Value.Required {
@@ -47,7 +49,7 @@ func NewRequestNonPathParameterCheck(diffReport *diff.Diff, operationsSources *d
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, param.Value, operationItem.Revision))
 
 							break
 						}
@@ -9,8 +9,15 @@ import (
 
 const (
 	NewRequiredRequestHeaderPropertyId = "new-required-request-header-property"
+	NewRequiredRequestCookiePropertyId = "new-required-request-cookie-property"
 )
 
+var newRequiredRequestPropertyIds = map[string]string{
+	openapi3.ParameterInHeader: NewRequiredRequestHeaderPropertyId,
+	openapi3.ParameterInCookie: NewRequiredRequestCookiePropertyId,
+}
+
+// NewRequiredRequestHeaderPropertyCheck detects new required properties in object header and cookie parameters
 func NewRequiredRequestHeaderPropertyCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
 	result := make(Changes, 0)
 	if diffReport.PathsDiff == nil {
@@ -29,7 +36,8 @@ func NewRequiredRequestHeaderPropertyCheck(diffReport *diff.Diff, operationsSour
 
 			for paramLocation, paramDiffs := range operationItem.ParametersDiff.Modified {
 
-				if paramLocation != "header" {
+				id, ok := newRequiredRequestPropertyIds[paramLocation]
+				if !ok {
 					continue
 				}
 
@@ -45,14 +53,14 @@ func NewRequiredRequestHeaderPropertyCheck(diffReport *diff.Diff, operationsSour
 							}
 
 							result = append(result, ApiChange{
-								Id:          NewRequiredRequestHeaderPropertyId,
+								Id:          id,
 								Level:       ERR,
 								Args:        []any{paramName, propertyFullName(propertyPath, newPropertyName)},
 								Operation:   operation,
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, newProperty, paramDiff.Revision, operationItem.Revision))
 						})
 				}
 			}
@@ -44,7 +44,7 @@ func RequestBodyBecameEnumCheck(diffReport *diff.Diff, operationsSources *diff.O
 					OperationId: operationItem.Revision.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, operationItem.Revision))
 			}
 		}
 	}
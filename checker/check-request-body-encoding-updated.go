@@ -0,0 +1,281 @@
+package checker
+
+import (
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+	"github.com/tufin/oasdiff/utils"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	RequestBodyEncodingContentTypeChangedId   = "request-body-encoding-content-type-changed"
+	RequestBodyEncodingNewRequiredHeaderId    = "request-body-encoding-new-required-header"
+	RequestBodyEncodingHeaderBecameRequiredId = "request-body-encoding-header-became-required"
+	RequestBodyEncodingStyleChangedId         = "request-body-encoding-style-changed"
+	RequestBodyEncodingExplodeChangedId       = "request-body-encoding-explode-changed"
+	RequestBodyEncodingAllowReservedRemovedId = "request-body-encoding-allow-reserved-removed"
+	RequestBodyEncodingAllowReservedAddedId   = "request-body-encoding-allow-reserved-added"
+)
+
+const (
+	formUrlEncodedMediaType             = "application/x-www-form-urlencoded"
+	multipartMediaTypePrefix            = "multipart/"
+	contentTypeHeader                   = "Content-Type"
+	defaultBinaryEncodingContentType    = "application/octet-stream"
+	defaultPrimitiveEncodingContentType = "text/plain"
+	defaultObjectEncodingContentType    = "application/json"
+	encodingContentTypeSeparator        = ","
+)
+
+func isMultipartMediaType(mediaType string) bool {
+	return strings.HasPrefix(strings.ToLower(mediaType), multipartMediaTypePrefix)
+}
+
+func isFormUrlEncodedMediaType(mediaType string) bool {
+	return strings.HasPrefix(strings.ToLower(mediaType), formUrlEncodedMediaType)
+}
+
+// getEncodingContentType returns the content type of a part, or the default content type for its schema: https://spec.openapis.org/oas/v3.0.3#encoding-object
+func getEncodingContentType(encoding *openapi3.Encoding, schemaRef *openapi3.SchemaRef) string {
+	if encoding != nil && encoding.ContentType != "" {
+		return encoding.ContentType
+	}
+	if schemaRef == nil || schemaRef.Value == nil {
+		return defaultBinaryEncodingContentType
+	}
+	schema := schemaRef.Value
+	switch schema.Type {
+	case openapi3.TypeArray:
+		// arrays are sent as multiple parts, each one with the content type of the items
+		return getEncodingContentType(nil, schema.Items)
+	case openapi3.TypeObject:
+		return defaultObjectEncodingContentType
+	case openapi3.TypeString:
+		if schema.Format == "binary" {
+			return defaultBinaryEncodingContentType
+		}
+		return defaultPrimitiveEncodingContentType
+	case "":
+		return defaultBinaryEncodingContentType
+	default:
+		return defaultPrimitiveEncodingContentType
+	}
+}
+
+// isContentTypeBroadened returns true if all the content types that were accepted before are still accepted
+// The content type of an encoding may be a comma-separated list of media types
+func isContentTypeBroadened(baseContentType, revisionContentType string) bool {
+	revisionContentTypes := splitContentTypes(revisionContentType)
+	for _, contentType := range splitContentTypes(baseContentType) {
+		if !slices.Contains(revisionContentTypes, contentType) {
+			return false
+		}
+	}
+	return true
+}
+
+func splitContentTypes(contentType string) []string {
+	result := []string{}
+	for _, item := range strings.Split(contentType, encodingContentTypeSeparator) {
+		result = append(result, strings.ToLower(strings.TrimSpace(item)))
+	}
+	return result
+}
+
+// getEncodingStyle returns the style of a part, which defaults to form
+func getEncodingStyle(encoding *openapi3.Encoding) string {
+	if encoding != nil && encoding.Style != "" {
+		return encoding.Style
+	}
+	return openapi3.SerializationForm
+}
+
+// getEncodingExplode returns the explode of a part, which defaults to true only for the form style
+func getEncodingExplode(encoding *openapi3.Encoding) bool {
+	if encoding != nil && encoding.Explode != nil {
+		return *encoding.Explode
+	}
+	return getEncodingStyle(encoding) == openapi3.SerializationForm
+}
+
+func isEncodingAllowReserved(encoding *openapi3.Encoding) bool {
+	return encoding != nil && encoding.AllowReserved
+}
+
+// isPartSerializationSensitive returns true if the serialization of the part values depends on the style and explode
+func isPartSerializationSensitive(schemaRef *openapi3.SchemaRef) bool {
+	if schemaRef == nil || schemaRef.Value == nil {
+		return true
+	}
+	switch schemaRef.Value.Type {
+	case openapi3.TypeArray, openapi3.TypeObject, "":
+		return true
+	default:
+		return false
+	}
+}
+
+func getMediaTypeEncoding(mediaType *openapi3.MediaType, partName string) *openapi3.Encoding {
+	if mediaType == nil {
+		return nil
+	}
+	return mediaType.Encoding[partName]
+}
+
+func getMediaTypeProperty(mediaType *openapi3.MediaType, partName string) *openapi3.SchemaRef {
+	if mediaType == nil || mediaType.Schema == nil || mediaType.Schema.Value == nil {
+		return nil
+	}
+	return mediaType.Schema.Value.Properties[partName]
+}
+
+// RequestBodyEncodingUpdatedCheck detects changes to the encoding of the parts of multipart and form request bodies
+// Clients that upload files or forms must send each part with the content type, headers and serialization that the API expects
+func RequestBodyEncodingUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.RequestBodyDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+
+			for mediaType, mediaTypeDiff := range operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified {
+				if mediaTypeDiff.EncodingsDiff == nil {
+					continue
+				}
+				isMultipart := isMultipartMediaType(mediaType)
+				isFormUrlEncoded := isFormUrlEncodedMediaType(mediaType)
+				if !isMultipart && !isFormUrlEncoded {
+					// encodings only apply to multipart and form request bodies
+					continue
+				}
+
+				baseMediaType := requestBodyContent(operationItem.Base)[mediaType]
+				revisionMediaType := requestBodyContent(operationItem.Revision)[mediaTypeDiff.RevisionName(mediaType)]
+				if baseMediaType == nil || revisionMediaType == nil {
+					continue
+				}
+
+				partNames := utils.StringList{}
+				partNames = append(partNames, mediaTypeDiff.EncodingsDiff.Added...)
+				partNames = append(partNames, mediaTypeDiff.EncodingsDiff.Deleted...)
+				for partName := range mediaTypeDiff.EncodingsDiff.Modified {
+					partNames = append(partNames, partName)
+				}
+
+				for _, partName := range partNames {
+					baseEncoding := getMediaTypeEncoding(baseMediaType, partName)
+					revisionEncoding := getMediaTypeEncoding(revisionMediaType, partName)
+					baseProperty := getMediaTypeProperty(baseMediaType, partName)
+					revisionProperty := getMediaTypeProperty(revisionMediaType, partName)
+
+					var element any = revisionMediaType
+					if revisionEncoding != nil {
+						element = revisionEncoding
+					}
+
+					newChange := func(id string, level Level, args ...any) Change {
+						return ApiChange{
+							Id:          id,
+							Level:       level,
+							Args:        append(append([]any{partName}, args...), mediaType),
+							Operation:   operation,
+							OperationId: operationItem.Revision.OperationID,
+							Path:        path,
+							Source:      load.NewSource(source),
+						}.withLocation(config, element, operationItem.Revision)
+					}
+
+					if isMultipart {
+						// adding or removing an encoding that only sets the default content type doesn't change anything
+						baseContentType := getEncodingContentType(baseEncoding, baseProperty)
+						revisionContentType := getEncodingContentType(revisionEncoding, revisionProperty)
+						if baseContentType != revisionContentType {
+							result = append(result, newChange(RequestBodyEncodingContentTypeChangedId, conditionalError(!isContentTypeBroadened(baseContentType, revisionContentType), INFO), baseContentType, revisionContentType))
+						}
+
+						result = append(result, checkEncodingHeaders(baseEncoding, revisionEncoding, mediaTypeDiff.EncodingsDiff.Modified[partName], newChange)...)
+					}
+
+					if isFormUrlEncoded {
+						sensitive := isPartSerializationSensitive(baseProperty) || isPartSerializationSensitive(revisionProperty)
+						baseStyle, revisionStyle := getEncodingStyle(baseEncoding), getEncodingStyle(revisionEncoding)
+						baseExplode, revisionExplode := getEncodingExplode(baseEncoding), getEncodingExplode(revisionEncoding)
+
+						if baseStyle != revisionStyle {
+							result = append(result, newChange(RequestBodyEncodingStyleChangedId, conditionalError(sensitive, INFO), baseStyle, revisionStyle))
+						}
+
+						if baseExplode != revisionExplode {
+							result = append(result, newChange(RequestBodyEncodingExplodeChangedId, conditionalError(sensitive, INFO), baseExplode, revisionExplode))
+						}
+
+						if isEncodingAllowReserved(baseEncoding) && !isEncodingAllowReserved(revisionEncoding) {
+							result = append(result, newChange(RequestBodyEncodingAllowReservedRemovedId, ERR))
+						} else if !isEncodingAllowReserved(baseEncoding) && isEncodingAllowReserved(revisionEncoding) {
+							result = append(result, newChange(RequestBodyEncodingAllowReservedAddedId, INFO))
+						}
+					}
+				}
+			}
+		}
+	}
+	return result
+}
+
+// checkEncodingHeaders detects required headers that clients must now send with a part
+func checkEncodingHeaders(baseEncoding, revisionEncoding *openapi3.Encoding, encodingDiff *diff.EncodingDiff, newChange func(id string, level Level, args ...any) Change) Changes {
+	result := make(Changes, 0)
+	if revisionEncoding == nil {
+		return result
+	}
+
+	isRequired := func(headerName string) bool {
+		// the Content-Type header of a part is described by the content type of the encoding
+		if strings.EqualFold(headerName, contentTypeHeader) {
+			return false
+		}
+		headerRef := revisionEncoding.Headers[headerName]
+		return headerRef != nil && headerRef.Value != nil && headerRef.Value.Required
+	}
+
+	if baseEncoding == nil {
+		// all the headers of a new encoding are new
+		for headerName := range revisionEncoding.Headers {
+			if isRequired(headerName) {
+				result = append(result, newChange(RequestBodyEncodingNewRequiredHeaderId, ERR, headerName))
+			}
+		}
+		return result
+	}
+
+	if encodingDiff == nil || encodingDiff.HeadersDiff == nil {
+		return result
+	}
+
+	for _, headerName := range encodingDiff.HeadersDiff.Added {
+		if isRequired(headerName) {
+			result = append(result, newChange(RequestBodyEncodingNewRequiredHeaderId, ERR, headerName))
+		}
+	}
+
+	for headerName, headerDiff := range encodingDiff.HeadersDiff.Modified {
+		if isRequired(headerName) && isSetValue(headerDiff.RequiredDiff) {
+			result = append(result, newChange(RequestBodyEncodingHeaderBecameRequiredId, ERR, headerName))
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,170 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/load"
+)
+
+const requestBodyEncodingBase = "../data/checker/request_body_encoding_base.yaml"
+
+func getRequestBodyMediaType(s *load.SpecInfo, mediaType string) *openapi3.MediaType {
+	return s.Spec.Paths.Value("/api/v1.0/groups").Post.RequestBody.Value.Content[mediaType]
+}
+
+// BC: changing the content type of a multipart request body part is breaking
+func TestRequestBodyEncodingContentTypeChanged(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, requestBodyEncodingBase, singleCheckConfig(checker.RequestBodyEncodingUpdatedCheck), func(s *load.SpecInfo) {
+		getRequestBodyMediaType(s, "multipart/form-data").Encoding["avatar"].ContentType = "image/jpeg"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestBodyEncodingContentTypeChangedId,
+		Args:        []any{"avatar", "image/png", "image/jpeg", "multipart/form-data"},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(requestBodyEncodingBase),
+		OperationId: "createGroup",
+	}, errs[0])
+	require.Equal(t, "the content type of the request body part 'avatar' was changed from 'image/png' to 'image/jpeg' in the media type 'multipart/form-data'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: adding content types to a multipart request body part is not breaking
+func TestRequestBodyEncodingContentTypeBroadened(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, requestBodyEncodingBase, singleCheckConfig(checker.RequestBodyEncodingUpdatedCheck), func(s *load.SpecInfo) {
+		getRequestBodyMediaType(s, "multipart/form-data").Encoding["avatar"].ContentType = "image/png, image/jpeg"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestBodyEncodingContentTypeChangedId, errs[0].GetId())
+	require.Equal(t, checker.INFO, errs[0].GetLevel())
+}
+
+// BC: adding an encoding that changes the default content type of a multipart request body part is breaking
+func TestRequestBodyEncodingContentTypeDefault(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, requestBodyEncodingBase, singleCheckConfig(checker.RequestBodyEncodingUpdatedCheck), func(s *load.SpecInfo) {
+		getRequestBodyMediaType(s, "multipart/form-data").Encoding["metadata"] = &openapi3.Encoding{ContentType: "application/xml"}
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestBodyEncodingContentTypeChangedId, errs[0].GetId())
+	require.Equal(t, []any{"metadata", "application/json", "application/xml", "multipart/form-data"}, errs[0].(checker.ApiChange).Args)
+	require.Equal(t, checker.ERR, errs[0].GetLevel())
+}
+
+// BC: adding an encoding with the default content type of a multipart request body part is not breaking
+func TestRequestBodyEncodingContentTypeDefaultUnchanged(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, requestBodyEncodingBase, singleCheckConfig(checker.RequestBodyEncodingUpdatedCheck), func(s *load.SpecInfo) {
+		getRequestBodyMediaType(s, "multipart/form-data").Encoding["name"] = &openapi3.Encoding{ContentType: "text/plain"}
+	})
+	require.Empty(t, errs)
+}
+
+// BC: adding a required header to a multipart request body part is breaking
+func TestRequestBodyEncodingNewRequiredHeader(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, requestBodyEncodingBase, singleCheckConfig(checker.RequestBodyEncodingUpdatedCheck), func(s *load.SpecInfo) {
+		header := &openapi3.Header{Parameter: openapi3.Parameter{Required: true, Schema: openapi3.NewStringSchema().NewRef()}}
+		getRequestBodyMediaType(s, "multipart/form-data").Encoding["avatar"].Headers["X-Signature"] = &openapi3.HeaderRef{Value: header}
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestBodyEncodingNewRequiredHeaderId, errs[0].GetId())
+	require.Equal(t, checker.ERR, errs[0].GetLevel())
+	require.Equal(t, "the request body part 'avatar' has the new required header 'X-Signature' in the media type 'multipart/form-data'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: adding a required Content-Type header to a multipart request body part is not breaking
+func TestRequestBodyEncodingNewRequiredContentTypeHeader(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, requestBodyEncodingBase, singleCheckConfig(checker.RequestBodyEncodingUpdatedCheck), func(s *load.SpecInfo) {
+		header := &openapi3.Header{Parameter: openapi3.Parameter{Required: true, Schema: openapi3.NewStringSchema().NewRef()}}
+		getRequestBodyMediaType(s, "multipart/form-data").Encoding["avatar"].Headers["Content-Type"] = &openapi3.HeaderRef{Value: header}
+	})
+	require.Empty(t, errs)
+}
+
+// BC: making a header of a multipart request body part required is breaking
+func TestRequestBodyEncodingHeaderBecameRequired(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, requestBodyEncodingBase, singleCheckConfig(checker.RequestBodyEncodingUpdatedCheck), func(s *load.SpecInfo) {
+		getRequestBodyMediaType(s, "multipart/form-data").Encoding["avatar"].Headers["X-Checksum"].Value.Required = true
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestBodyEncodingHeaderBecameRequiredId, errs[0].GetId())
+	require.Equal(t, checker.ERR, errs[0].GetLevel())
+	require.Equal(t, "the request body part 'avatar' header 'X-Checksum' became required in the media type 'multipart/form-data'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: changing the style of an array part in a form request body is breaking
+func TestRequestBodyEncodingStyleChanged(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, requestBodyEncodingBase, singleCheckConfig(checker.RequestBodyEncodingUpdatedCheck), func(s *load.SpecInfo) {
+		getRequestBodyMediaType(s, "application/x-www-form-urlencoded").Encoding["tags"].Style = openapi3.SerializationPipeDelimited
+	})
+	require.Len(t, errs, 2)
+	require.ElementsMatch(t, []string{checker.RequestBodyEncodingStyleChangedId, checker.RequestBodyEncodingExplodeChangedId}, []string{errs[0].GetId(), errs[1].GetId()})
+	require.Equal(t, checker.ERR, errs[0].GetLevel())
+	require.Equal(t, checker.ERR, errs[1].GetLevel())
+}
+
+// BC: changing explode of an array part in a form request body is breaking
+func TestRequestBodyEncodingExplodeChanged(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, requestBodyEncodingBase, singleCheckConfig(checker.RequestBodyEncodingUpdatedCheck), func(s *load.SpecInfo) {
+		getRequestBodyMediaType(s, "application/x-www-form-urlencoded").Encoding["tags"].Explode = openapi3.BoolPtr(false)
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestBodyEncodingExplodeChangedId,
+		Args:        []any{"tags", true, false, "application/x-www-form-urlencoded"},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(requestBodyEncodingBase),
+		OperationId: "createGroup",
+	}, errs[0])
+}
+
+// BC: changing the style of a primitive part in a form request body is not breaking
+func TestRequestBodyEncodingStyleChangedPrimitive(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, requestBodyEncodingBase, singleCheckConfig(checker.RequestBodyEncodingUpdatedCheck), func(s *load.SpecInfo) {
+		getRequestBodyMediaType(s, "application/x-www-form-urlencoded").Encoding["name"] = &openapi3.Encoding{Style: openapi3.SerializationSpaceDelimited, Explode: openapi3.BoolPtr(true)}
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestBodyEncodingStyleChangedId, errs[0].GetId())
+	require.Equal(t, checker.INFO, errs[0].GetLevel())
+}
+
+// BC: disallowing reserved characters in a part of a form request body is breaking
+func TestRequestBodyEncodingAllowReservedRemoved(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, requestBodyEncodingBase, singleCheckConfig(checker.RequestBodyEncodingUpdatedCheck), func(s *load.SpecInfo) {
+		getRequestBodyMediaType(s, "application/x-www-form-urlencoded").Encoding["tags"].AllowReserved = false
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestBodyEncodingAllowReservedRemovedId, errs[0].GetId())
+	require.Equal(t, checker.ERR, errs[0].GetLevel())
+	require.Equal(t, "reserved characters are no longer allowed without percent-encoding in the request body part 'tags' in the media type 'application/x-www-form-urlencoded'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: removing an encoding that allowed reserved characters in a part of a form request body is breaking
+func TestRequestBodyEncodingDeleted(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, requestBodyEncodingBase, singleCheckConfig(checker.RequestBodyEncodingUpdatedCheck), func(s *load.SpecInfo) {
+		delete(getRequestBodyMediaType(s, "application/x-www-form-urlencoded").Encoding, "tags")
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestBodyEncodingAllowReservedRemovedId, errs[0].GetId())
+}
+
+// BC: changing the content type of a part of a multipart request body whose media type was replaced by a related media type is breaking
+func TestRequestBodyEncodingContentTypeChangedInRenamedMediaType(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, requestBodyEncodingBase, checker.GetDefaultChecks(), func(s *load.SpecInfo) {
+		getRequestBodyMediaType(s, "multipart/form-data").Encoding["avatar"].ContentType = "image/jpeg"
+		renameMediaType(s.Spec.Paths.Value("/api/v1.0/groups").Post.RequestBody.Value.Content, "multipart/form-data", "multipart/form-data; charset=utf-8")
+	})
+	require.Len(t, errs, 2)
+	ids := []string{errs[0].GetId(), errs[1].GetId()}
+	require.ElementsMatch(t, []string{checker.RequestBodyMediaTypeNarrowedId, checker.RequestBodyEncodingContentTypeChangedId}, ids)
+	for _, err := range errs {
+		if err.GetId() == checker.RequestBodyEncodingContentTypeChangedId {
+			require.Equal(t, []any{"avatar", "image/png", "image/jpeg", "multipart/form-data"}, err.(checker.ApiChange).Args)
+			require.Equal(t, checker.ERR, err.GetLevel())
+		}
+	}
+}
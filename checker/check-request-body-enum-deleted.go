@@ -50,7 +50,7 @@ func RequestBodyEnumValueRemovedCheck(diffReport *diff.Diff, operationsSources *
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      load.NewSource(source),
-					})
+					}.withLocation(config, operationItem.Revision))
 				}
 			}
 		}
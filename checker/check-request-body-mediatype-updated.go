@@ -6,10 +6,14 @@ import (
 )
 
 const (
-	RequestBodyMediaTypeAddedId   = "request-body-media-type-added"
-	RequestBodyMediaTypeRemovedId = "request-body-media-type-removed"
+	RequestBodyMediaTypeAddedId     = "request-body-media-type-added"
+	RequestBodyMediaTypeRemovedId   = "request-body-media-type-removed"
+	RequestBodyMediaTypeBroadenedId = "request-body-media-type-broadened"
+	RequestBodyMediaTypeNarrowedId  = "request-body-media-type-narrowed"
+	RequestBodyMediaTypeChangedId   = "request-body-media-type-changed"
 )
 
+// RequestBodyMediaTypeChangedCheck detects media types that were added to or removed from request bodies, or replaced by related media types
 func RequestBodyMediaTypeChangedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
 	result := make(Changes, 0)
 	if diffReport.PathsDiff == nil {
@@ -37,7 +41,7 @@ func RequestBodyMediaTypeChangedCheck(diffReport *diff.Diff, operationsSources *
 					OperationId: operationItem.Revision.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, requestBodyContent(operationItem.Revision).Get(mediaType), operationItem.Revision))
 			}
 
 			removedMediaTypes := operationItem.RequestBodyDiff.ContentDiff.MediaTypeDeleted
@@ -50,9 +54,62 @@ func RequestBodyMediaTypeChangedCheck(diffReport *diff.Diff, operationsSources *
 					OperationId: operationItem.Revision.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, requestBodyContent(operationItem.Base).Get(mediaType), operationItem.Base))
+			}
+
+			for mediaType, mediaTypeDiff := range operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified {
+				if mediaTypeDiff.NameDiff == nil {
+					continue
+				}
+				revisionMediaType := mediaTypeDiff.RevisionName(mediaType)
+
+				// clients keep sending the base media type, so the revision must accept it
+				id, level := getMediaTypeChange(mediaType, revisionMediaType, mediaTypeChangeIds{
+					broadened: RequestBodyMediaTypeBroadenedId,
+					narrowed:  RequestBodyMediaTypeNarrowedId,
+					changed:   RequestBodyMediaTypeChangedId,
+				}, INFO, ERR)
+
+				result = append(result, ApiChange{
+					Id:          id,
+					Level:       level,
+					Args:        []any{mediaType, revisionMediaType},
+					Operation:   operation,
+					OperationId: operationItem.Revision.OperationID,
+					Path:        path,
+					Source:      load.NewSource(source),
+				}.withLocation(config, requestBodyContent(operationItem.Revision).Get(revisionMediaType), operationItem.Revision))
 			}
 		}
 	}
 	return result
 }
+
+// mediaTypeChangeIds are the ids of the changes to a media type that was replaced by a related media type
+type mediaTypeChangeIds struct {
+	broadened string
+	narrowed  string
+	changed   string
+}
+
+// getMediaTypeChange classifies the replacement of a media type by a related one, like a wildcard, a vendor type or the same type with other parameters
+// The levels of broadening and narrowing depend on whether the media type is sent or received by the client
+func getMediaTypeChange(baseMediaType, revisionMediaType string, ids mediaTypeChangeIds, broadenedLevel, narrowedLevel Level) (string, Level) {
+	base, err := diff.ParseMediaType(baseMediaType)
+	if err != nil {
+		return ids.changed, ERR
+	}
+	revision, err := diff.ParseMediaType(revisionMediaType)
+	if err != nil {
+		return ids.changed, ERR
+	}
+
+	switch {
+	case revision.Covers(base):
+		return ids.broadened, broadenedLevel
+	case base.Covers(revision):
+		return ids.narrowed, narrowedLevel
+	default:
+		return ids.changed, ERR
+	}
+}
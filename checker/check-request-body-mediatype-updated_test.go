@@ -3,6 +3,7 @@ package checker_test
 import (
 	"testing"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/stretchr/testify/require"
 	"github.com/tufin/oasdiff/checker"
 	"github.com/tufin/oasdiff/diff"
@@ -52,3 +53,93 @@ func TestRequestBodyMediaTypeRemoved(t *testing.T) {
 		OperationId: "createOneGroup",
 	}, errs[0])
 }
+
+const mediaTypeMatchBase = "../data/checker/media_type_match_base.yaml"
+
+// renameMediaType replaces a media type in a content map, keeping the media type object
+func renameMediaType(content openapi3.Content, from, to string) {
+	content[to] = content[from]
+	delete(content, from)
+}
+
+// CL: replacing a request body media type with a wildcard
+func TestRequestBodyMediaTypeBroadened(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, mediaTypeMatchBase, singleCheckConfig(checker.RequestBodyMediaTypeChangedCheck), func(s *load.SpecInfo) {
+		renameMediaType(s.Spec.Paths.Value("/orders").Post.RequestBody.Value.Content, "application/json", "application/*")
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestBodyMediaTypeBroadenedId,
+		Args:        []any{"application/json", "application/*"},
+		Level:       checker.INFO,
+		Operation:   "POST",
+		Path:        "/orders",
+		Source:      load.NewSource(mediaTypeMatchBase),
+		OperationId: "createOrder",
+	}, errs[0])
+	require.Equal(t, "the request body media type 'application/json' was broadened to 'application/*'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: adding a parameter to a request body media type is breaking
+func TestRequestBodyMediaTypeParameterAdded(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, mediaTypeMatchBase, singleCheckConfig(checker.RequestBodyMediaTypeChangedCheck), func(s *load.SpecInfo) {
+		renameMediaType(s.Spec.Paths.Value("/orders").Post.RequestBody.Value.Content, "application/json", "application/json; charset=utf-8")
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestBodyMediaTypeNarrowedId, errs[0].GetId())
+	require.Equal(t, checker.ERR, errs[0].GetLevel())
+}
+
+// BC: replacing a request body media type with a narrower media type is breaking
+func TestRequestBodyMediaTypeNarrowed(t *testing.T) {
+	s1, err := open(mediaTypeMatchBase)
+	require.NoError(t, err)
+	s2, err := open(mediaTypeMatchBase)
+	require.NoError(t, err)
+
+	renameMediaType(s1.Spec.Paths.Value("/orders").Post.RequestBody.Value.Content, "application/json", "*/*")
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestBodyMediaTypeChangedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestBodyMediaTypeNarrowedId, errs[0].GetId())
+	require.Equal(t, checker.ERR, errs[0].GetLevel())
+	require.Equal(t, []any{"*/*", "application/json"}, errs[0].(checker.ApiChange).Args)
+}
+
+// BC: replacing a request body media type with a vendor media type is breaking
+func TestRequestBodyMediaTypeChanged(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, mediaTypeMatchBase, singleCheckConfig(checker.RequestBodyMediaTypeChangedCheck), func(s *load.SpecInfo) {
+		renameMediaType(s.Spec.Paths.Value("/orders").Post.RequestBody.Value.Content, "application/json", "application/vnd.acme.v2+json")
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestBodyMediaTypeChangedId, errs[0].GetId())
+	require.Equal(t, checker.ERR, errs[0].GetLevel())
+}
+
+// BC: replacing a request body media type with an unrelated media type is breaking
+func TestRequestBodyMediaTypeUnrelated(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, mediaTypeMatchBase, singleCheckConfig(checker.RequestBodyMediaTypeChangedCheck), func(s *load.SpecInfo) {
+		renameMediaType(s.Spec.Paths.Value("/orders").Post.RequestBody.Value.Content, "application/json", "text/plain")
+	})
+	require.Len(t, errs, 2)
+	require.ElementsMatch(t, []string{checker.RequestBodyMediaTypeAddedId, checker.RequestBodyMediaTypeRemovedId}, []string{errs[0].GetId(), errs[1].GetId()})
+}
+
+// BC: decreasing the max of a request property is breaking even if the media type was replaced by a related media type
+func TestRequestBodyMediaTypeMatchedSchemaCompared(t *testing.T) {
+	s1, err := open(mediaTypeMatchBase)
+	require.NoError(t, err)
+	s2, err := open(mediaTypeMatchBase)
+	require.NoError(t, err)
+
+	renameMediaType(s2.Spec.Paths.Value("/orders").Post.RequestBody.Value.Content, "application/json", "application/vnd.acme.v2+json")
+	s2.Spec.Components.Schemas["Order"].Value.Properties["quantity"].Value.Max = openapi3.Float64Ptr(50)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestPropertyMaxDecreasedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestPropertyMaxDecreasedId, errs[0].GetId())
+}
@@ -44,7 +44,7 @@ func RequestBodyRequiredUpdatedCheck(diffReport *diff.Diff, operationsSources *d
 				OperationId: operationItem.Revision.OperationID,
 				Path:        path,
 				Source:      load.NewSource(source),
-			})
+			}.withLocation(config, operationItem.Revision))
 		}
 	}
 	return result
@@ -1,14 +1,22 @@
 package checker
 
 import (
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/tufin/oasdiff/diff"
 	"github.com/tufin/oasdiff/load"
 )
 
 const (
 	RequestHeaderPropertyBecameEnumId = "request-header-property-became-enum"
+	RequestCookiePropertyBecameEnumId = "request-cookie-property-became-enum"
 )
 
+var requestPropertyBecameEnumIds = map[string]string{
+	openapi3.ParameterInHeader: RequestHeaderPropertyBecameEnumId,
+	openapi3.ParameterInCookie: RequestCookiePropertyBecameEnumId,
+}
+
+// RequestHeaderPropertyBecameEnumCheck detects header parameters, and properties of object header and cookie parameters, that were restricted to a list of enum values
 func RequestHeaderPropertyBecameEnumCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
 	result := make(Changes, 0)
 	if diffReport.PathsDiff == nil {
@@ -27,7 +35,8 @@ func RequestHeaderPropertyBecameEnumCheck(diffReport *diff.Diff, operationsSourc
 
 			for paramLocation, paramDiffs := range operationItem.ParametersDiff.Modified {
 
-				if paramLocation != "header" {
+				id, ok := requestPropertyBecameEnumIds[paramLocation]
+				if !ok {
 					continue
 				}
 
@@ -36,7 +45,7 @@ func RequestHeaderPropertyBecameEnumCheck(diffReport *diff.Diff, operationsSourc
 						continue
 					}
 
-					if paramDiff.SchemaDiff.EnumDiff != nil && paramDiff.SchemaDiff.EnumDiff.EnumAdded {
+					if paramLocation == openapi3.ParameterInHeader && paramDiff.SchemaDiff.EnumDiff != nil && paramDiff.SchemaDiff.EnumDiff.EnumAdded {
 						result = append(result, ApiChange{
 							Id:          RequestHeaderPropertyBecameEnumId,
 							Level:       ERR,
@@ -45,7 +54,7 @@ func RequestHeaderPropertyBecameEnumCheck(diffReport *diff.Diff, operationsSourc
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      load.NewSource(source),
-						})
+						}.withLocation(config, paramDiff.Revision, operationItem.Revision))
 					}
 
 					CheckModifiedPropertiesDiff(
@@ -57,14 +66,14 @@ func RequestHeaderPropertyBecameEnumCheck(diffReport *diff.Diff, operationsSourc
 							}
 
 							result = append(result, ApiChange{
-								Id:          RequestHeaderPropertyBecameEnumId,
+								Id:          id,
 								Level:       ERR,
 								Args:        []any{paramName, propertyFullName(propertyPath, propertyName)},
 								Operation:   operation,
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, propertyDiff.Revision, paramDiff.Revision, operationItem.Revision))
 						})
 				}
 			}
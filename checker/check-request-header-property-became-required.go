@@ -1,14 +1,22 @@
 package checker
 
 import (
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/tufin/oasdiff/diff"
 	"github.com/tufin/oasdiff/load"
 )
 
 const (
 	RequestHeaderPropertyBecameRequiredId = "request-header-property-became-required"
+	RequestCookiePropertyBecameRequiredId = "request-cookie-property-became-required"
 )
 
+var requestPropertyBecameRequiredIds = map[string]string{
+	openapi3.ParameterInHeader: RequestHeaderPropertyBecameRequiredId,
+	openapi3.ParameterInCookie: RequestCookiePropertyBecameRequiredId,
+}
+
+// RequestHeaderPropertyBecameRequiredCheck detects properties of object header and cookie parameters that became required
 func RequestHeaderPropertyBecameRequiredCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
 	result := make(Changes, 0)
 	if diffReport.PathsDiff == nil {
@@ -27,7 +35,8 @@ func RequestHeaderPropertyBecameRequiredCheck(diffReport *diff.Diff, operationsS
 
 			for paramLocation, paramDiffs := range operationItem.ParametersDiff.Modified {
 
-				if paramLocation != "header" {
+				id, ok := requestPropertyBecameRequiredIds[paramLocation]
+				if !ok {
 					continue
 				}
 
@@ -46,19 +55,19 @@ func RequestHeaderPropertyBecameRequiredCheck(diffReport *diff.Diff, operationsS
 							}
 
 							if paramDiff.SchemaDiff.Base.Properties[changedRequiredPropertyName] == nil {
-								// new added required properties processed via the new-required-request-header-property check
+								// new added required properties processed via the new-required-request-header-property and new-required-request-cookie-property checks
 								continue
 							}
 
 							result = append(result, ApiChange{
-								Id:          RequestHeaderPropertyBecameRequiredId,
+								Id:          id,
 								Level:       ERR,
 								Args:        []any{paramName, changedRequiredPropertyName},
 								Operation:   operation,
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, paramDiff.Revision, operationItem.Revision))
 						}
 					}
 
@@ -77,14 +86,14 @@ func RequestHeaderPropertyBecameRequiredCheck(diffReport *diff.Diff, operationsS
 									continue
 								}
 								result = append(result, ApiChange{
-									Id:          RequestHeaderPropertyBecameRequiredId,
+									Id:          id,
 									Level:       ERR,
 									Args:        []any{paramName, propertyFullName(propertyPath, propertyFullName(propertyName, changedRequiredPropertyName))},
 									Operation:   operation,
 									OperationId: operationItem.Revision.OperationID,
 									Path:        path,
 									Source:      load.NewSource(source),
-								})
+								}.withLocation(config, propertyDiff.Revision, paramDiff.Revision, operationItem.Revision))
 							}
 						})
 				}
@@ -44,7 +44,7 @@ func RequestParameterBecameEnumCheck(diffReport *diff.Diff, operationsSources *d
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      load.NewSource(source),
-					})
+					}.withLocation(config, paramItem.Revision, operationItem.Revision))
 				}
 			}
 		}
@@ -31,3 +31,22 @@ func TestRequestParameterBecameEnum(t *testing.T) {
 	}, errs[0])
 	require.Equal(t, "the 'path' request parameter 'groupId' was restricted to a list of enum values", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
 }
+
+// CL: changing request parameter type to enum is reported at the location of the parameter in the revision spec
+func TestRequestParameterBecameEnum_Location(t *testing.T) {
+	s1, err := open("../data/checker/request_parameter_became_enum_base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/checker/request_parameter_became_enum_revision.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	config := singleCheckConfig(checker.RequestParameterBecameEnumCheck)
+	config.Locations = load.GetLocations(s1, s2)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(config, d, osm, checker.ERR)
+	require.Len(t, errs, 1)
+	require.Equal(t, "../data/checker/request_parameter_became_enum_revision.yaml", errs[0].GetSourceFile())
+	require.Equal(t, 18, errs[0].GetSourceLine())
+	require.Equal(t, 25, errs[0].GetSourceLineEnd())
+	require.Equal(t, 8, errs[0].GetSourceColumn())
+}
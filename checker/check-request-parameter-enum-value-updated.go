@@ -45,7 +45,7 @@ func RequestParameterEnumValueUpdatedCheck(diffReport *diff.Diff, operationsSour
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      load.NewSource(source),
-						})
+						}.withLocation(config, paramItem.Revision, operationItem.Revision))
 					}
 					for _, enumVal := range enumDiff.Added {
 						result = append(result, ApiChange{
@@ -56,7 +56,7 @@ func RequestParameterEnumValueUpdatedCheck(diffReport *diff.Diff, operationsSour
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      load.NewSource(source),
-						})
+						}.withLocation(config, paramItem.Revision, operationItem.Revision))
 					}
 				}
 			}
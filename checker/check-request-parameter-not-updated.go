@@ -0,0 +1,54 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	RequestParameterNotSchemaAddedId     = "request-parameter-not-schema-added"
+	RequestParameterNotSchemaBroadenedId = "request-parameter-not-schema-broadened"
+)
+
+// RequestParameterNotUpdatedCheck detects 'not' schemas in request parameters that reject values that were valid before
+func RequestParameterNotUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.ParametersDiff == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+
+			for paramLocation, paramItems := range operationItem.ParametersDiff.Modified {
+				for paramName, paramItem := range paramItems {
+					id := ""
+					if added, broadened := getNotSchemaRestrictions(paramItem.SchemaDiff); added {
+						id = RequestParameterNotSchemaAddedId
+					} else if broadened {
+						id = RequestParameterNotSchemaBroadenedId
+					} else {
+						continue
+					}
+
+					result = append(result, ApiChange{
+						Id:          id,
+						Level:       ERR,
+						Args:        []any{paramLocation, paramName},
+						Operation:   operation,
+						OperationId: operationItem.Revision.OperationID,
+						Path:        path,
+						Source:      load.NewSource(source),
+					}.withLocation(config, paramItem.Revision, operationItem.Revision))
+				}
+			}
+		}
+	}
+	return result
+}
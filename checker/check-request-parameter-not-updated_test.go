@@ -0,0 +1,80 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+func getQueryParameterSchema(s *load.SpecInfo, name string) *openapi3.Schema {
+	return s.Spec.Paths.Value("/api/v1.0/groups").Post.Parameters.GetByInAndName(openapi3.ParameterInQuery, name).Schema.Value
+}
+
+// BC: adding a 'not' schema to a request parameter is breaking
+func TestRequestParameterNotSchemaAdded(t *testing.T) {
+	s1, err := open(notSchemaBase)
+	require.NoError(t, err)
+	s2, err := open(notSchemaBase)
+	require.NoError(t, err)
+
+	getQueryParameterSchema(s2, "limit").Not = openapi3.NewSchemaRef("", &openapi3.Schema{Enum: []any{0}})
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestParameterNotUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestParameterNotSchemaAddedId,
+		Args:        []any{"query", "limit"},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(notSchemaBase),
+		OperationId: "createOneGroup",
+	}, errs[0])
+	require.Equal(t, "added a 'not' schema to the 'query' request parameter 'limit'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: broadening the 'not' schema of a request parameter is breaking
+func TestRequestParameterNotSchemaBroadened(t *testing.T) {
+	s1, err := open(notSchemaBase)
+	require.NoError(t, err)
+	s2, err := open(notSchemaBase)
+	require.NoError(t, err)
+
+	// without the enum, the 'not' schema rejects all values
+	getQueryParameterSchema(s2, "color").Not.Value.Enum = nil
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestParameterNotUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestParameterNotSchemaBroadenedId,
+		Args:        []any{"query", "color"},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(notSchemaBase),
+		OperationId: "createOneGroup",
+	}, errs[0])
+}
+
+// BC: removing the 'not' schema of a request parameter is not breaking
+func TestRequestParameterNotSchemaRemoved(t *testing.T) {
+	s1, err := open(notSchemaBase)
+	require.NoError(t, err)
+	s2, err := open(notSchemaBase)
+	require.NoError(t, err)
+
+	getQueryParameterSchema(s2, "color").Not = nil
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestParameterNotUpdatedCheck), d, osm, checker.INFO)
+	require.Empty(t, errs)
+}
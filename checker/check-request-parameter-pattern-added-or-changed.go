@@ -3,15 +3,56 @@ package checker
 import (
 	"github.com/tufin/oasdiff/diff"
 	"github.com/tufin/oasdiff/load"
+	"github.com/tufin/oasdiff/regex"
 )
 
 const (
-	RequestParameterPatternAddedId   = "request-parameter-pattern-added"
-	RequestParameterPatternRemovedId = "request-parameter-pattern-removed"
-	RequestParameterPatternChangedId = "request-parameter-pattern-changed"
-	PatternChangedCommentId          = "pattern-changed-warn-comment"
+	RequestParameterPatternAddedId        = "request-parameter-pattern-added"
+	RequestParameterPatternRemovedId      = "request-parameter-pattern-removed"
+	RequestParameterPatternChangedId      = "request-parameter-pattern-changed"
+	RequestParameterPatternBroadenedId    = "request-parameter-pattern-broadened"
+	RequestParameterPatternNarrowedId     = "request-parameter-pattern-narrowed"
+	RequestParameterPatternIncomparableId = "request-parameter-pattern-incomparable"
+	PatternChangedCommentId               = "pattern-changed-warn-comment"
+	PatternIncomparableCommentId          = "pattern-incomparable-comment"
 )
 
+// patternChangeIds are the ids of the changes to a pattern, depending on how the values that it matches changed
+type patternChangeIds struct {
+	broadened    string
+	narrowed     string
+	changed      string
+	incomparable string
+}
+
+// getPatternChange classifies a changed pattern by comparing the values that the base and the revision patterns match
+// The levels of broadening and narrowing depend on whether the value is sent or received by the client, and patterns that match different values get the more severe of the two
+// It returns an empty id if the patterns can't be compared, for example if they use lookarounds or backreferences
+func getPatternChange(from, to any, ids patternChangeIds, broadenedLevel, narrowedLevel Level) (string, Level, string) {
+	basePattern, ok := from.(string)
+	if !ok {
+		return "", INFO, ""
+	}
+	revisionPattern, ok := to.(string)
+	if !ok {
+		return "", INFO, ""
+	}
+
+	switch regex.Compare(basePattern, revisionPattern) {
+	case regex.RelationEqual:
+		return ids.changed, INFO, ""
+	case regex.RelationSuperset:
+		return ids.broadened, broadenedLevel, ""
+	case regex.RelationSubset:
+		return ids.narrowed, narrowedLevel, ""
+	case regex.RelationIncomparable:
+		return ids.incomparable, max(broadenedLevel, narrowedLevel), PatternIncomparableCommentId
+	}
+	return "", INFO, ""
+}
+
+// RequestParameterPatternAddedOrChangedCheck detects patterns that were added to, removed from or changed in request parameters
+// Broadening a pattern is safe, but narrowing it breaks clients that send values that the base pattern matched
 func RequestParameterPatternAddedOrChangedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
 	result := make(Changes, 0)
 	if diffReport.PathsDiff == nil {
@@ -49,7 +90,7 @@ func RequestParameterPatternAddedOrChangedCheck(diffReport *diff.Diff, operation
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      load.NewSource(source),
-						})
+						}.withLocation(config, paramItem.Revision, operationItem.Revision))
 					} else if patternDiff.To == "" {
 						result = append(result, ApiChange{
 							Id:          RequestParameterPatternRemovedId,
@@ -59,16 +100,19 @@ func RequestParameterPatternAddedOrChangedCheck(diffReport *diff.Diff, operation
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      load.NewSource(source),
-						})
+						}.withLocation(config, paramItem.Revision, operationItem.Revision))
 					} else {
-						level := WARN
-						comment := PatternChangedCommentId
-						if patternDiff.To == ".*" {
-							level = INFO
-							comment = ""
+						id, level, comment := getPatternChange(patternDiff.From, patternDiff.To, patternChangeIds{
+							broadened:    RequestParameterPatternBroadenedId,
+							narrowed:     RequestParameterPatternNarrowedId,
+							changed:      RequestParameterPatternChangedId,
+							incomparable: RequestParameterPatternIncomparableId,
+						}, INFO, ERR)
+						if id == "" {
+							id, level, comment = RequestParameterPatternChangedId, WARN, PatternChangedCommentId
 						}
 						result = append(result, ApiChange{
-							Id:          RequestParameterPatternChangedId,
+							Id:          id,
 							Level:       level,
 							Args:        []any{paramLocation, paramName, patternDiff.From, patternDiff.To},
 							Comment:     comment,
@@ -76,7 +120,7 @@ func RequestParameterPatternAddedOrChangedCheck(diffReport *diff.Diff, operation
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      load.NewSource(source),
-						})
+						}.withLocation(config, paramItem.Revision, operationItem.Revision))
 					}
 				}
 			}
@@ -16,14 +16,14 @@ func TestRequestParameterPatternChanged(t *testing.T) {
 	s2, err := open("../data/checker/request_parameter_pattern_added_or_changed_base.yaml")
 	require.NoError(t, err)
 
-	s2.Spec.Paths.Value("/test").Post.Parameters[0].Value.Schema.Value.Pattern = "^[\\w\\s]+$"
+	s2.Spec.Paths.Value("/test").Post.Parameters[0].Value.Schema.Value.Pattern = "^(?!_)[\\w\\s]+$"
 	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
 	require.NoError(t, err)
 	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestParameterPatternAddedOrChangedCheck), d, osm, checker.WARN)
 	require.Len(t, errs, 1)
 	require.Equal(t, checker.ApiChange{
 		Id:        checker.RequestParameterPatternChangedId,
-		Args:      []any{"query", "category", "^\\w+$", "^[\\w\\s]+$"},
+		Args:      []any{"query", "category", "^\\w+$", "^(?!_)[\\w\\s]+$"},
 		Comment:   checker.PatternChangedCommentId,
 		Level:     checker.WARN,
 		Operation: "POST",
@@ -33,6 +33,36 @@ func TestRequestParameterPatternChanged(t *testing.T) {
 	require.Equal(t, "This is a warning because it is difficult to automatically analyze if the new pattern is a superset of the previous pattern (e.g. changed from '[0-9]+' to '[0-9]*')", errs[0].GetComment(checker.NewDefaultLocalizer()))
 }
 
+// CL: broadening and narrowing pattern of request parameters
+func TestRequestParameterPatternBroadenedAndNarrowed(t *testing.T) {
+	for _, test := range []struct {
+		pattern string
+		id      string
+		level   checker.Level
+		text    string
+	}{
+		{"^[\\w\\s]+$", checker.RequestParameterPatternBroadenedId, checker.INFO, "broadened the pattern of the 'query' request parameter 'category' from '^\\w+$' to '^[\\w\\s]+$'"},
+		{"^\\w{1,10}$", checker.RequestParameterPatternNarrowedId, checker.ERR, "narrowed the pattern of the 'query' request parameter 'category' from '^\\w+$' to '^\\w{1,10}$'"},
+		{"^[\\w-]{2,}$", checker.RequestParameterPatternIncomparableId, checker.ERR, "changed the pattern of the 'query' request parameter 'category' from '^\\w+$' to '^[\\w-]{2,}$'"},
+	} {
+		t.Run(test.pattern, func(t *testing.T) {
+			s1, err := open("../data/checker/request_parameter_pattern_added_or_changed_base.yaml")
+			require.NoError(t, err)
+			s2, err := open("../data/checker/request_parameter_pattern_added_or_changed_base.yaml")
+			require.NoError(t, err)
+
+			s2.Spec.Paths.Value("/test").Post.Parameters[0].Value.Schema.Value.Pattern = test.pattern
+			d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+			require.NoError(t, err)
+			errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestParameterPatternAddedOrChangedCheck), d, osm, checker.INFO)
+			require.Len(t, errs, 1)
+			require.Equal(t, test.id, errs[0].GetId())
+			require.Equal(t, test.level, errs[0].GetLevel())
+			require.Equal(t, test.text, errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+		})
+	}
+}
+
 // CL: adding pattern to request parameters
 func TestRequestParameterPatternAdded(t *testing.T) {
 	s1, err := open("../data/checker/request_parameter_pattern_added_or_changed_revision.yaml")
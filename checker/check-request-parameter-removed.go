@@ -33,7 +33,7 @@ func RequestParameterRemovedCheck(diffReport *diff.Diff, operationsSources *diff
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      load.NewSource(source),
-					})
+					}.withLocation(config, operationItem.Base.Parameters.GetByInAndName(paramLocation, paramName), operationItem.Base))
 				}
 			}
 		}
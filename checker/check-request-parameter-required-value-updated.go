@@ -50,7 +50,7 @@ func RequestParameterRequiredValueUpdatedCheck(diffReport *diff.Diff, operations
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      load.NewSource(source),
-					})
+					}.withLocation(config, paramItem.Revision, operationItem.Revision))
 				}
 			}
 		}
@@ -0,0 +1,126 @@
+package checker
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	RequestParameterStyleChangedId           = "request-parameter-style-changed"
+	RequestParameterExplodeChangedId         = "request-parameter-explode-changed"
+	RequestParameterAllowReservedRemovedId   = "request-parameter-allow-reserved-removed"
+	RequestParameterAllowReservedAddedId     = "request-parameter-allow-reserved-added"
+	RequestParameterAllowEmptyValueRemovedId = "request-parameter-allow-empty-value-removed"
+	RequestParameterAllowEmptyValueAddedId   = "request-parameter-allow-empty-value-added"
+)
+
+// getSerializationStyle returns the style of a parameter, or the default style for its location: https://spec.openapis.org/oas/v3.0.3#parameter-object
+func getSerializationStyle(param *openapi3.Parameter) string {
+	if param.Style != "" {
+		return param.Style
+	}
+	switch param.In {
+	case openapi3.ParameterInQuery, openapi3.ParameterInCookie:
+		return openapi3.SerializationForm
+	default:
+		return openapi3.SerializationSimple
+	}
+}
+
+// getSerializationExplode returns the explode of a parameter, which defaults to true only for the form style
+func getSerializationExplode(param *openapi3.Parameter) bool {
+	if param.Explode != nil {
+		return *param.Explode
+	}
+	return getSerializationStyle(param) == openapi3.SerializationForm
+}
+
+// isSerializationSensitive returns true if the serialization of the parameter values depends on the style and explode
+// Primitive values are serialized the same way in all styles except matrix and label, and explode only applies to arrays and objects
+func isSerializationSensitive(param *openapi3.Parameter) bool {
+	if param.Schema == nil || param.Schema.Value == nil {
+		// parameters with content are serialized by their media type
+		return false
+	}
+	switch param.Schema.Value.Type {
+	case openapi3.TypeArray, openapi3.TypeObject, "":
+		return true
+	default:
+		return false
+	}
+}
+
+func isPrefixedStyle(style string) bool {
+	return style == openapi3.SerializationMatrix || style == openapi3.SerializationLabel
+}
+
+// RequestParameterSerializationUpdatedCheck detects changes to the serialization of request parameters
+// Changes to style, explode, allowReserved and allowEmptyValue are errors only if the values that clients send are no longer serialized the same way
+func RequestParameterSerializationUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.ParametersDiff == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+
+			for paramLocation, paramItems := range operationItem.ParametersDiff.Modified {
+				for paramName, paramItem := range paramItems {
+					if paramItem.Base == nil || paramItem.Revision == nil {
+						continue
+					}
+
+					newChange := func(id string, level Level, args ...any) Change {
+						return ApiChange{
+							Id:          id,
+							Level:       level,
+							Args:        append([]any{paramLocation, paramName}, args...),
+							Operation:   operation,
+							OperationId: operationItem.Revision.OperationID,
+							Path:        path,
+							Source:      load.NewSource(source),
+						}.withLocation(config, paramItem.Revision, operationItem.Revision)
+					}
+
+					sensitive := isSerializationSensitive(paramItem.Base) || isSerializationSensitive(paramItem.Revision)
+					baseStyle, revisionStyle := getSerializationStyle(paramItem.Base), getSerializationStyle(paramItem.Revision)
+					baseExplode, revisionExplode := getSerializationExplode(paramItem.Base), getSerializationExplode(paramItem.Revision)
+
+					// setting a style or explode explicitly to its default value doesn't change anything
+					if paramItem.StyleDiff != nil && baseStyle != revisionStyle {
+						styleChanged := sensitive || isPrefixedStyle(baseStyle) || isPrefixedStyle(revisionStyle)
+						result = append(result, newChange(RequestParameterStyleChangedId, conditionalError(styleChanged, INFO), baseStyle, revisionStyle))
+					}
+
+					if paramItem.ExplodeDiff != nil && baseExplode != revisionExplode {
+						result = append(result, newChange(RequestParameterExplodeChangedId, conditionalError(sensitive, INFO), baseExplode, revisionExplode))
+					}
+
+					// allowReserved and allowEmptyValue only apply to query parameters
+					isQuery := paramLocation == openapi3.ParameterInQuery
+
+					if isUnsetValue(paramItem.AllowReservedDiff) {
+						result = append(result, newChange(RequestParameterAllowReservedRemovedId, conditionalError(isQuery, INFO)))
+					} else if isSetValue(paramItem.AllowReservedDiff) {
+						result = append(result, newChange(RequestParameterAllowReservedAddedId, INFO))
+					}
+
+					if isUnsetValue(paramItem.AllowEmptyValueDiff) {
+						result = append(result, newChange(RequestParameterAllowEmptyValueRemovedId, conditionalError(isQuery, INFO)))
+					} else if isSetValue(paramItem.AllowEmptyValueDiff) {
+						result = append(result, newChange(RequestParameterAllowEmptyValueAddedId, INFO))
+					}
+				}
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,111 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/load"
+)
+
+const parameterSerializationBase = "../data/checker/request_parameter_serialization_base.yaml"
+
+func getSerializationParameter(s *load.SpecInfo, in, name string) *openapi3.Parameter {
+	return s.Spec.Paths.Value("/api/v1.0/groups/{groupId}").Get.Parameters.GetByInAndName(in, name)
+}
+
+// BC: changing explode of an array query parameter is breaking
+func TestRequestParameterExplodeChanged(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, parameterSerializationBase, singleCheckConfig(checker.RequestParameterSerializationUpdatedCheck), func(s *load.SpecInfo) {
+		getSerializationParameter(s, openapi3.ParameterInQuery, "tags").Explode = openapi3.BoolPtr(false)
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestParameterExplodeChangedId,
+		Args:        []any{"query", "tags", true, false},
+		Level:       checker.ERR,
+		Operation:   "GET",
+		Path:        "/api/v1.0/groups/{groupId}",
+		Source:      load.NewSource(parameterSerializationBase),
+		OperationId: "getGroup",
+	}, errs[0])
+	require.Equal(t, "for the 'query' request parameter 'tags', the explode was changed from 'true' to 'false'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: changing explode of an array cookie parameter is breaking
+func TestRequestParameterExplodeChangedCookie(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, parameterSerializationBase, singleCheckConfig(checker.RequestParameterSerializationUpdatedCheck), func(s *load.SpecInfo) {
+		getSerializationParameter(s, openapi3.ParameterInCookie, "prefs").Explode = openapi3.BoolPtr(false)
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestParameterExplodeChangedId, errs[0].GetId())
+	require.Equal(t, checker.ERR, errs[0].GetLevel())
+}
+
+// BC: setting explode or style explicitly to the default value of the parameter location is not breaking
+func TestRequestParameterSerializationDefaults(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, parameterSerializationBase, singleCheckConfig(checker.RequestParameterSerializationUpdatedCheck), func(s *load.SpecInfo) {
+		getSerializationParameter(s, openapi3.ParameterInQuery, "tags").Explode = openapi3.BoolPtr(true)
+		getSerializationParameter(s, openapi3.ParameterInQuery, "tags").Style = openapi3.SerializationForm
+		getSerializationParameter(s, openapi3.ParameterInHeader, "X-Ids").Explode = openapi3.BoolPtr(false)
+		getSerializationParameter(s, openapi3.ParameterInHeader, "X-Ids").Style = openapi3.SerializationSimple
+	})
+	require.Empty(t, errs)
+}
+
+// BC: changing the style of a primitive query parameter is not breaking
+func TestRequestParameterStyleChangedPrimitive(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, parameterSerializationBase, singleCheckConfig(checker.RequestParameterSerializationUpdatedCheck), func(s *load.SpecInfo) {
+		getSerializationParameter(s, openapi3.ParameterInQuery, "q").Style = openapi3.SerializationSpaceDelimited
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestParameterStyleChangedId, errs[0].GetId())
+	require.Equal(t, checker.INFO, errs[0].GetLevel())
+}
+
+// BC: changing the style of a path parameter to label is breaking
+func TestRequestParameterStyleChangedLabel(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, parameterSerializationBase, singleCheckConfig(checker.RequestParameterSerializationUpdatedCheck), func(s *load.SpecInfo) {
+		getSerializationParameter(s, openapi3.ParameterInPath, "groupId").Style = openapi3.SerializationLabel
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestParameterStyleChangedId,
+		Args:        []any{"path", "groupId", "simple", "label"},
+		Level:       checker.ERR,
+		Operation:   "GET",
+		Path:        "/api/v1.0/groups/{groupId}",
+		Source:      load.NewSource(parameterSerializationBase),
+		OperationId: "getGroup",
+	}, errs[0])
+	require.Equal(t, "for the 'path' request parameter 'groupId', the style was changed from 'simple' to 'label'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: disallowing reserved characters in a query parameter is breaking
+func TestRequestParameterAllowReservedRemoved(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, parameterSerializationBase, singleCheckConfig(checker.RequestParameterSerializationUpdatedCheck), func(s *load.SpecInfo) {
+		getSerializationParameter(s, openapi3.ParameterInQuery, "tags").AllowReserved = false
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestParameterAllowReservedRemovedId,
+		Args:        []any{"query", "tags"},
+		Level:       checker.ERR,
+		Operation:   "GET",
+		Path:        "/api/v1.0/groups/{groupId}",
+		Source:      load.NewSource(parameterSerializationBase),
+		OperationId: "getGroup",
+	}, errs[0])
+	require.Equal(t, "for the 'query' request parameter 'tags', reserved characters are no longer allowed without percent-encoding", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: allowing empty values in a query parameter is not breaking
+func TestRequestParameterAllowEmptyValueAdded(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, parameterSerializationBase, singleCheckConfig(checker.RequestParameterSerializationUpdatedCheck), func(s *load.SpecInfo) {
+		getSerializationParameter(s, openapi3.ParameterInQuery, "q").AllowEmptyValue = true
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestParameterAllowEmptyValueAddedId, errs[0].GetId())
+	require.Equal(t, checker.INFO, errs[0].GetLevel())
+}
@@ -63,7 +63,7 @@ func RequestParameterXExtensibleEnumValueRemovedCheck(diffReport *diff.Diff, ope
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      load.NewSource(source),
-						})
+						}.withLocation(config, paramItem.Revision, operationItem.Revision))
 						continue
 					}
 					var toSlice []string
@@ -76,7 +76,7 @@ func RequestParameterXExtensibleEnumValueRemovedCheck(diffReport *diff.Diff, ope
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      load.NewSource(source),
-						})
+						}.withLocation(config, paramItem.Revision, operationItem.Revision))
 						continue
 					}
 
@@ -96,7 +96,7 @@ func RequestParameterXExtensibleEnumValueRemovedCheck(diffReport *diff.Diff, ope
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      load.NewSource(source),
-						})
+						}.withLocation(config, paramItem.Revision, operationItem.Revision))
 					}
 				}
 			}
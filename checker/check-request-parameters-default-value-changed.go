@@ -34,7 +34,7 @@ func RequestParameterDefaultValueChangedCheck(diffReport *diff.Diff, operationsS
 					OperationId: operationItem.Revision.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, operationItem.Revision))
 			}
 			for paramLocation, paramDiffs := range operationItem.ParametersDiff.Modified {
 				for paramName, paramDiff := range paramDiffs {
@@ -0,0 +1,58 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	RequestParameterExclusiveMinSetId = "request-parameter-exclusive-min-set"
+	RequestParameterExclusiveMaxSetId = "request-parameter-exclusive-max-set"
+)
+
+// RequestParameterExclusiveBoundsUpdatedCheck detects request parameters whose min or max became exclusive
+func RequestParameterExclusiveBoundsUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.ParametersDiff == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+
+			for paramLocation, paramDiffs := range operationItem.ParametersDiff.Modified {
+				for paramName, paramDiff := range paramDiffs {
+					if paramDiff.SchemaDiff == nil {
+						continue
+					}
+
+					newChange := func(id string, bound float64) Change {
+						return ApiChange{
+							Id:          id,
+							Level:       ERR,
+							Args:        []any{paramLocation, paramName, bound},
+							Operation:   operation,
+							OperationId: operationItem.Revision.OperationID,
+							Path:        path,
+							Source:      load.NewSource(source),
+						}.withLocation(config, paramDiff.Revision, operationItem.Revision)
+					}
+
+					if isExclusiveMinSet(paramDiff.SchemaDiff) {
+						result = append(result, newChange(RequestParameterExclusiveMinSetId, *paramDiff.SchemaDiff.Revision.Min))
+					}
+					if isExclusiveMaxSet(paramDiff.SchemaDiff) {
+						result = append(result, newChange(RequestParameterExclusiveMaxSetId, *paramDiff.SchemaDiff.Revision.Max))
+					}
+				}
+			}
+		}
+	}
+	return result
+}
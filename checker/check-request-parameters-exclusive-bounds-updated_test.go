@@ -0,0 +1,35 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+// BC: making the max of a request parameter exclusive is breaking
+func TestRequestParameterExclusiveMaxSet(t *testing.T) {
+	s1, err := open(schemaConstraintsBase)
+	require.NoError(t, err)
+	s2, err := open(schemaConstraintsBase)
+	require.NoError(t, err)
+
+	getQueryParameterSchema(s2, "count").ExclusiveMax = true
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestParameterExclusiveBoundsUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestParameterExclusiveMaxSetId,
+		Args:        []any{"query", "count", float64(100)},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(schemaConstraintsBase),
+		OperationId: "createOneGroup",
+	}, errs[0])
+	require.Equal(t, "for the 'query' request parameter 'count', the max '100.00' became exclusive", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
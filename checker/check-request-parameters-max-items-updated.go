@@ -54,7 +54,7 @@ func RequestParameterMaxItemsUpdatedCheck(diffReport *diff.Diff, operationsSourc
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      load.NewSource(source),
-					})
+					}.withLocation(config, paramDiff.Revision, operationItem.Revision))
 				}
 			}
 		}
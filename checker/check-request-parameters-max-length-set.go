@@ -47,7 +47,7 @@ func RequestParameterMaxLengthSetCheck(diffReport *diff.Diff, operationsSources
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      load.NewSource(source),
-					})
+					}.withLocation(config, paramDiff.Revision, operationItem.Revision))
 				}
 			}
 		}
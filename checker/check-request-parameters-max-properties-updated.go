@@ -0,0 +1,66 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	RequestParameterMaxPropertiesSetId       = "request-parameter-max-properties-set"
+	RequestParameterMaxPropertiesDecreasedId = "request-parameter-max-properties-decreased"
+	RequestParameterMaxPropertiesIncreasedId = "request-parameter-max-properties-increased"
+)
+
+// RequestParameterMaxPropertiesUpdatedCheck detects changes to the maxProperties of object request parameters
+func RequestParameterMaxPropertiesUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.ParametersDiff == nil {
+				continue
+			}
+			for paramLocation, paramDiffs := range operationItem.ParametersDiff.Modified {
+				for paramName, paramDiff := range paramDiffs {
+					if paramDiff.SchemaDiff == nil {
+						continue
+					}
+					maxPropsDiff := paramDiff.SchemaDiff.MaxPropsDiff
+					if maxPropsDiff == nil || maxPropsDiff.To == nil {
+						continue
+					}
+
+					source := (*operationsSources)[operationItem.Revision]
+
+					change := ApiChange{
+						Id:          RequestParameterMaxPropertiesDecreasedId,
+						Level:       ERR,
+						Args:        []any{paramLocation, paramName, maxPropsDiff.From, maxPropsDiff.To},
+						Operation:   operation,
+						OperationId: operationItem.Revision.OperationID,
+						Path:        path,
+						Source:      load.NewSource(source),
+					}
+
+					if maxPropsDiff.From == nil {
+						change.Id = RequestParameterMaxPropertiesSetId
+						change.Level = WARN
+						change.Args = []any{paramLocation, paramName, maxPropsDiff.To}
+						change.Comment = commentId(RequestParameterMaxPropertiesSetId)
+					} else if IsIncreasedValue(maxPropsDiff) {
+						change.Id = RequestParameterMaxPropertiesIncreasedId
+						change.Level = INFO
+					}
+
+					result = append(result, change.withLocation(config, paramDiff.Revision, operationItem.Revision))
+				}
+			}
+		}
+	}
+	return result
+}
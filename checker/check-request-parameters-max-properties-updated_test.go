@@ -0,0 +1,36 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+// BC: decreasing maxProperties of a request parameter is breaking
+func TestRequestParameterMaxPropertiesDecreased(t *testing.T) {
+	s1, err := open(schemaConstraintsBase)
+	require.NoError(t, err)
+	s2, err := open(schemaConstraintsBase)
+	require.NoError(t, err)
+
+	getQueryParameterSchema(s2, "filter").MaxProps = openapi3.Uint64Ptr(3)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestParameterMaxPropertiesUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestParameterMaxPropertiesDecreasedId,
+		Args:        []any{"query", "filter", uint64(5), uint64(3)},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(schemaConstraintsBase),
+		OperationId: "createOneGroup",
+	}, errs[0])
+	require.Equal(t, "for the 'query' request parameter 'filter', the maxProperties was decreased from '5' to '3'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
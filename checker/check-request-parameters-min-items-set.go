@@ -46,7 +46,7 @@ func RequestParameterMinItemsSetCheck(diffReport *diff.Diff, operationsSources *
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      load.NewSource(source),
-					})
+					}.withLocation(config, paramDiff.Revision, operationItem.Revision))
 				}
 			}
 		}
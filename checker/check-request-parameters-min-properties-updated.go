@@ -0,0 +1,61 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	RequestParameterMinPropertiesIncreasedId = "request-parameter-min-properties-increased"
+	RequestParameterMinPropertiesDecreasedId = "request-parameter-min-properties-decreased"
+)
+
+// RequestParameterMinPropertiesUpdatedCheck detects changes to the minProperties of object request parameters
+func RequestParameterMinPropertiesUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.ParametersDiff == nil {
+				continue
+			}
+			for paramLocation, paramDiffs := range operationItem.ParametersDiff.Modified {
+				for paramName, paramDiff := range paramDiffs {
+					if paramDiff.SchemaDiff == nil {
+						continue
+					}
+					minPropsDiff := paramDiff.SchemaDiff.MinPropsDiff
+					if minPropsDiff == nil {
+						continue
+					}
+
+					id := RequestParameterMinPropertiesIncreasedId
+					level := ERR
+
+					if !IsIncreasedValue(minPropsDiff) {
+						id = RequestParameterMinPropertiesDecreasedId
+						level = INFO
+					}
+
+					source := (*operationsSources)[operationItem.Revision]
+
+					result = append(result, ApiChange{
+						Id:          id,
+						Level:       level,
+						Args:        []any{paramLocation, paramName, minPropsDiff.From, minPropsDiff.To},
+						Operation:   operation,
+						OperationId: operationItem.Revision.OperationID,
+						Path:        path,
+						Source:      load.NewSource(source),
+					}.withLocation(config, paramDiff.Revision, operationItem.Revision))
+				}
+			}
+		}
+	}
+	return result
+}
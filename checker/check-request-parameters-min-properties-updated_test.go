@@ -0,0 +1,35 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+// BC: increasing minProperties of a request parameter is breaking
+func TestRequestParameterMinPropertiesIncreased(t *testing.T) {
+	s1, err := open(schemaConstraintsBase)
+	require.NoError(t, err)
+	s2, err := open(schemaConstraintsBase)
+	require.NoError(t, err)
+
+	getQueryParameterSchema(s2, "filter").MinProps = 2
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestParameterMinPropertiesUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestParameterMinPropertiesIncreasedId,
+		Args:        []any{"query", "filter", uint64(1), uint64(2)},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(schemaConstraintsBase),
+		OperationId: "createOneGroup",
+	}, errs[0])
+	require.Equal(t, "for the 'query' request parameter 'filter', the minProperties was increased from '1' to '2'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
@@ -47,7 +47,7 @@ func RequestParameterMinSetCheck(diffReport *diff.Diff, operationsSources *diff.
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      load.NewSource(source),
-					})
+					}.withLocation(config, paramDiff.Revision, operationItem.Revision))
 				}
 			}
 		}
@@ -0,0 +1,62 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	RequestParameterMultipleOfSetId     = "request-parameter-multiple-of-set"
+	RequestParameterMultipleOfChangedId = "request-parameter-multiple-of-changed"
+)
+
+// RequestParameterMultipleOfUpdatedCheck detects changes to the multipleOf of request parameters
+func RequestParameterMultipleOfUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.ParametersDiff == nil {
+				continue
+			}
+			for paramLocation, paramDiffs := range operationItem.ParametersDiff.Modified {
+				for paramName, paramDiff := range paramDiffs {
+					if paramDiff.SchemaDiff == nil {
+						continue
+					}
+					multipleOfDiff := paramDiff.SchemaDiff.MultipleOfDiff
+					if multipleOfDiff == nil || multipleOfDiff.To == nil {
+						continue
+					}
+
+					source := (*operationsSources)[operationItem.Revision]
+
+					change := ApiChange{
+						Id:          RequestParameterMultipleOfChangedId,
+						Level:       conditionalError(!isMultipleOf(multipleOfDiff.From, multipleOfDiff.To), INFO),
+						Args:        []any{paramLocation, paramName, multipleOfDiff.From, multipleOfDiff.To},
+						Operation:   operation,
+						OperationId: operationItem.Revision.OperationID,
+						Path:        path,
+						Source:      load.NewSource(source),
+					}
+
+					if multipleOfDiff.From == nil {
+						change.Id = RequestParameterMultipleOfSetId
+						change.Level = WARN
+						change.Args = []any{paramLocation, paramName, multipleOfDiff.To}
+						change.Comment = commentId(RequestParameterMultipleOfSetId)
+					}
+
+					result = append(result, change.withLocation(config, paramDiff.Revision, operationItem.Revision))
+				}
+			}
+		}
+	}
+	return result
+}
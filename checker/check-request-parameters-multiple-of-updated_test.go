@@ -0,0 +1,51 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+// BC: changing multipleOf of a request parameter to a value that isn't a divisor of the previous value is breaking
+func TestRequestParameterMultipleOfChanged(t *testing.T) {
+	s1, err := open(schemaConstraintsBase)
+	require.NoError(t, err)
+	s2, err := open(schemaConstraintsBase)
+	require.NoError(t, err)
+
+	getQueryParameterSchema(s2, "count").MultipleOf = openapi3.Float64Ptr(3)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestParameterMultipleOfUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestParameterMultipleOfChangedId,
+		Args:        []any{"query", "count", float64(2), float64(3)},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(schemaConstraintsBase),
+		OperationId: "createOneGroup",
+	}, errs[0])
+	require.Equal(t, "for the 'query' request parameter 'count', the multipleOf was changed from '2.00' to '3.00'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: removing multipleOf of a request parameter is not breaking
+func TestRequestParameterMultipleOfRemoved(t *testing.T) {
+	s1, err := open(schemaConstraintsBase)
+	require.NoError(t, err)
+	s2, err := open(schemaConstraintsBase)
+	require.NoError(t, err)
+
+	getQueryParameterSchema(s2, "count").MultipleOf = nil
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestParameterMultipleOfUpdatedCheck), d, osm, checker.INFO)
+	require.Empty(t, errs)
+}
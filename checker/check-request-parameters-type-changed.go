@@ -80,7 +80,7 @@ func RequestParameterTypeChangedCheck(diffReport *diff.Diff, operationsSources *
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      load.NewSource(source),
-					})
+					}.withLocation(config, paramDiff.Revision, operationItem.Revision))
 				}
 			}
 		}
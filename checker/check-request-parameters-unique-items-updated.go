@@ -0,0 +1,48 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	RequestParameterUniqueItemsSetId = "request-parameter-unique-items-set"
+)
+
+// RequestParameterUniqueItemsUpdatedCheck detects request parameters that started to require unique items
+func RequestParameterUniqueItemsUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.ParametersDiff == nil {
+				continue
+			}
+			for paramLocation, paramDiffs := range operationItem.ParametersDiff.Modified {
+				for paramName, paramDiff := range paramDiffs {
+					if paramDiff.SchemaDiff == nil || !isSetValue(paramDiff.SchemaDiff.UniqueItemsDiff) {
+						continue
+					}
+
+					source := (*operationsSources)[operationItem.Revision]
+
+					result = append(result, ApiChange{
+						Id:          RequestParameterUniqueItemsSetId,
+						Level:       ERR,
+						Args:        []any{paramLocation, paramName},
+						Operation:   operation,
+						OperationId: operationItem.Revision.OperationID,
+						Path:        path,
+						Source:      load.NewSource(source),
+					}.withLocation(config, paramDiff.Revision, operationItem.Revision))
+				}
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,35 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+// BC: setting uniqueItems of a request parameter is breaking
+func TestRequestParameterUniqueItemsSet(t *testing.T) {
+	s1, err := open(schemaConstraintsBase)
+	require.NoError(t, err)
+	s2, err := open(schemaConstraintsBase)
+	require.NoError(t, err)
+
+	getQueryParameterSchema(s2, "tags").UniqueItems = true
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestParameterUniqueItemsUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestParameterUniqueItemsSetId,
+		Args:        []any{"query", "tags"},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(schemaConstraintsBase),
+		OperationId: "createOneGroup",
+	}, errs[0])
+	require.Equal(t, "for the 'query' request parameter 'tags', the uniqueItems was set", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
@@ -37,7 +37,7 @@ func NewRequestPathParameterCheck(diffReport *diff.Diff, operationsSources *diff
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      load.NewSource(source),
-					})
+					}.withLocation(config, operationItem.Revision.Parameters.GetByInAndName(paramLocation, paramName), operationItem.Revision))
 				}
 			}
 		}
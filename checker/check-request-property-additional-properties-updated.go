@@ -0,0 +1,116 @@
+package checker
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	RequestBodyAdditionalPropertiesDisallowedId     = "request-body-additional-properties-disallowed"
+	RequestBodyAdditionalPropertiesNarrowedId       = "request-body-additional-properties-narrowed"
+	RequestPropertyAdditionalPropertiesDisallowedId = "request-property-additional-properties-disallowed"
+	RequestPropertyAdditionalPropertiesNarrowedId   = "request-property-additional-properties-narrowed"
+)
+
+// additionalPropertiesState describes the additional properties that a schema allows
+type additionalPropertiesState int
+
+const (
+	additionalPropertiesAny    additionalPropertiesState = iota // additionalProperties is omitted, true or an empty schema
+	additionalPropertiesNone                                    // additionalProperties is false
+	additionalPropertiesSchema                                  // additional properties must match a schema
+)
+
+func getAdditionalPropertiesState(schema *openapi3.Schema) additionalPropertiesState {
+	if schema == nil {
+		return additionalPropertiesAny
+	}
+	additionalProperties := schema.AdditionalProperties
+	if additionalProperties.Schema != nil && additionalProperties.Schema.Value != nil && !additionalProperties.Schema.Value.IsEmpty() {
+		return additionalPropertiesSchema
+	}
+	if additionalProperties.Has != nil && !*additionalProperties.Has {
+		return additionalPropertiesNone
+	}
+	return additionalPropertiesAny
+}
+
+// getAdditionalPropertiesStates returns the additional properties allowed by the base and the revision, and whether they changed
+func getAdditionalPropertiesStates(schemaDiff *diff.SchemaDiff) (additionalPropertiesState, additionalPropertiesState, bool) {
+	if schemaDiff.AdditionalPropertiesAllowedDiff == nil && schemaDiff.AdditionalPropertiesDiff == nil {
+		return additionalPropertiesAny, additionalPropertiesAny, false
+	}
+	from := getAdditionalPropertiesState(schemaDiff.Base)
+	to := getAdditionalPropertiesState(schemaDiff.Revision)
+	return from, to, from != to
+}
+
+// RequestPropertyAdditionalPropertiesUpdatedCheck detects requests that no longer accept additional properties, or accept fewer of them
+// Disallowing additional properties breaks clients that send extra fields, and a schema for additional properties that were free-form breaks clients that send values that don't match it
+// Changes inside the schema of additional properties are detected by the property checks, like changes to any other property
+func RequestPropertyAdditionalPropertiesUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			source := (*operationsSources)[operationItem.Revision]
+
+			if operationItem.RequestBodyDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified == nil {
+				continue
+			}
+
+			newChange := func(id string, args []any, schemaDiff *diff.SchemaDiff) Change {
+				return ApiChange{
+					Id:          id,
+					Level:       ERR,
+					Args:        args,
+					Operation:   operation,
+					OperationId: operationItem.Revision.OperationID,
+					Path:        path,
+					Source:      load.NewSource(source),
+				}.withLocation(config, schemaDiff.Revision, operationItem.Revision)
+			}
+
+			modifiedMediaTypes := operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified
+			for _, mediaTypeDiff := range modifiedMediaTypes {
+				if mediaTypeDiff.SchemaDiff == nil {
+					continue
+				}
+
+				if from, to, changed := getAdditionalPropertiesStates(mediaTypeDiff.SchemaDiff); changed {
+					if to == additionalPropertiesNone {
+						result = append(result, newChange(RequestBodyAdditionalPropertiesDisallowedId, nil, mediaTypeDiff.SchemaDiff))
+					} else if from == additionalPropertiesAny && to == additionalPropertiesSchema {
+						result = append(result, newChange(RequestBodyAdditionalPropertiesNarrowedId, nil, mediaTypeDiff.SchemaDiff))
+					}
+				}
+
+				CheckModifiedPropertiesDiff(
+					mediaTypeDiff.SchemaDiff,
+					func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+						from, to, changed := getAdditionalPropertiesStates(propertyDiff)
+						if !changed {
+							return
+						}
+
+						propName := propertyFullName(propertyPath, propertyName)
+
+						if to == additionalPropertiesNone {
+							result = append(result, newChange(RequestPropertyAdditionalPropertiesDisallowedId, []any{propName}, propertyDiff))
+						} else if from == additionalPropertiesAny && to == additionalPropertiesSchema {
+							result = append(result, newChange(RequestPropertyAdditionalPropertiesNarrowedId, []any{propName}, propertyDiff))
+						}
+					})
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,104 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const additionalPropertiesBase = "../data/checker/additional_properties_base.yaml"
+
+func getRequestBodySchema(s *load.SpecInfo) *openapi3.Schema {
+	return s.Spec.Paths.Value("/api/v1.0/groups").Post.RequestBody.Value.Content["application/json"].Schema.Value
+}
+
+// BC: disallowing additional properties in the request body is breaking
+func TestRequestBodyAdditionalPropertiesDisallowed(t *testing.T) {
+	s1, err := open(additionalPropertiesBase)
+	require.NoError(t, err)
+	s2, err := open(additionalPropertiesBase)
+	require.NoError(t, err)
+
+	getRequestBodySchema(s2).AdditionalProperties = openapi3.AdditionalProperties{Has: openapi3.BoolPtr(false)}
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestPropertyAdditionalPropertiesUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestBodyAdditionalPropertiesDisallowedId,
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(additionalPropertiesBase),
+		OperationId: "createOneGroup",
+	}, errs[0])
+	require.Equal(t, "the request's body no longer allows additional properties", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: disallowing additional properties in a request property is breaking
+func TestRequestPropertyAdditionalPropertiesDisallowed(t *testing.T) {
+	s1, err := open(additionalPropertiesBase)
+	require.NoError(t, err)
+	s2, err := open(additionalPropertiesBase)
+	require.NoError(t, err)
+
+	getRequestBodySchema(s2).Properties["metadata"].Value.AdditionalProperties = openapi3.AdditionalProperties{Has: openapi3.BoolPtr(false)}
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestPropertyAdditionalPropertiesUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestPropertyAdditionalPropertiesDisallowedId,
+		Args:        []any{"metadata"},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(additionalPropertiesBase),
+		OperationId: "createOneGroup",
+	}, errs[0])
+}
+
+// BC: restricting free-form additional properties in a request property with a schema is breaking
+func TestRequestPropertyAdditionalPropertiesNarrowed(t *testing.T) {
+	s1, err := open(additionalPropertiesBase)
+	require.NoError(t, err)
+	s2, err := open(additionalPropertiesBase)
+	require.NoError(t, err)
+
+	getRequestBodySchema(s2).Properties["metadata"].Value.AdditionalProperties = openapi3.AdditionalProperties{Schema: openapi3.NewSchemaRef("", openapi3.NewStringSchema())}
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestPropertyAdditionalPropertiesUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestPropertyAdditionalPropertiesNarrowedId,
+		Args:        []any{"metadata"},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(additionalPropertiesBase),
+		OperationId: "createOneGroup",
+	}, errs[0])
+}
+
+// BC: allowing additional properties in the request body is not breaking
+func TestRequestBodyAdditionalPropertiesAllowed(t *testing.T) {
+	s1, err := open(additionalPropertiesBase)
+	require.NoError(t, err)
+	s2, err := open(additionalPropertiesBase)
+	require.NoError(t, err)
+
+	getRequestBodySchema(s1).AdditionalProperties = openapi3.AdditionalProperties{Has: openapi3.BoolPtr(false)}
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestPropertyAdditionalPropertiesUpdatedCheck), d, osm, checker.INFO)
+	require.Empty(t, errs)
+}
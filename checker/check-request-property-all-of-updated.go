@@ -46,7 +46,7 @@ func RequestPropertyAllOfUpdatedCheck(diffReport *diff.Diff, operationsSources *
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      load.NewSource(source),
-					})
+					}.withLocation(config, operationItem.Revision))
 				}
 
 				if mediaTypeDiff.SchemaDiff.AllOfDiff != nil && len(mediaTypeDiff.SchemaDiff.AllOfDiff.Deleted) > 0 {
@@ -58,7 +58,7 @@ func RequestPropertyAllOfUpdatedCheck(diffReport *diff.Diff, operationsSources *
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      load.NewSource(source),
-					})
+					}.withLocation(config, operationItem.Revision))
 				}
 
 				CheckModifiedPropertiesDiff(
@@ -80,7 +80,7 @@ func RequestPropertyAllOfUpdatedCheck(diffReport *diff.Diff, operationsSources *
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, propertyDiff.Revision, operationItem.Revision))
 						}
 
 						if len(propertyDiff.AllOfDiff.Deleted) > 0 {
@@ -92,7 +92,7 @@ func RequestPropertyAllOfUpdatedCheck(diffReport *diff.Diff, operationsSources *
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, propertyDiff.Revision, operationItem.Revision))
 						}
 					})
 			}
@@ -45,7 +45,7 @@ func RequestPropertyBecameNotNullableCheck(diffReport *diff.Diff, operationsSour
 							Source:    load.NewSource(source),
 
 							OperationId: operationItem.Revision.OperationID,
-						})
+						}.withLocation(config, operationItem.Revision))
 					} else if mediaTypeDiff.SchemaDiff.NullableDiff.To == true {
 						result = append(result, ApiChange{
 							Id:        RequestBodyBecomeNullableId,
@@ -55,7 +55,7 @@ func RequestPropertyBecameNotNullableCheck(diffReport *diff.Diff, operationsSour
 							Source:    load.NewSource(source),
 
 							OperationId: operationItem.Revision.OperationID,
-						})
+						}.withLocation(config, operationItem.Revision))
 					}
 				}
 
@@ -79,7 +79,7 @@ func RequestPropertyBecameNotNullableCheck(diffReport *diff.Diff, operationsSour
 								Source:    load.NewSource(source),
 
 								OperationId: operationItem.Revision.OperationID,
-							})
+							}.withLocation(config, propertyDiff.Revision, operationItem.Revision))
 						} else if nullableDiff.To == true {
 							result = append(result, ApiChange{
 								Id:        RequestPropertyBecomeNullableId,
@@ -90,7 +90,7 @@ func RequestPropertyBecameNotNullableCheck(diffReport *diff.Diff, operationsSour
 								Source:    load.NewSource(source),
 
 								OperationId: operationItem.Revision.OperationID,
-							})
+							}.withLocation(config, propertyDiff.Revision, operationItem.Revision))
 						}
 
 					})
@@ -48,7 +48,7 @@ func RequestPropertyEnumValueUpdatedCheck(diffReport *diff.Diff, operationsSourc
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, propertyDiff.Revision, operationItem.Revision))
 						}
 
 						for _, enumVal := range enumDiff.Added {
@@ -60,7 +60,7 @@ func RequestPropertyEnumValueUpdatedCheck(diffReport *diff.Diff, operationsSourc
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, propertyDiff.Revision, operationItem.Revision))
 						}
 					})
 			}
@@ -0,0 +1,105 @@
+package checker
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	RequestBodyExclusiveMinSetId     = "request-body-exclusive-min-set"
+	RequestBodyExclusiveMaxSetId     = "request-body-exclusive-max-set"
+	RequestPropertyExclusiveMinSetId = "request-property-exclusive-min-set"
+	RequestPropertyExclusiveMaxSetId = "request-property-exclusive-max-set"
+)
+
+// isExclusiveMinSet returns true if the min of a schema became exclusive, which rejects the min value itself
+func isExclusiveMinSet(schemaDiff *diff.SchemaDiff) bool {
+	return isSetValue(schemaDiff.ExclusiveMinDiff) && hasMin(schemaDiff.Revision)
+}
+
+// isExclusiveMaxSet returns true if the max of a schema became exclusive, which rejects the max value itself
+func isExclusiveMaxSet(schemaDiff *diff.SchemaDiff) bool {
+	return isSetValue(schemaDiff.ExclusiveMaxDiff) && hasMax(schemaDiff.Revision)
+}
+
+// isExclusiveMinUnset returns true if the min of a schema is no longer exclusive, which allows the min value itself
+func isExclusiveMinUnset(schemaDiff *diff.SchemaDiff) bool {
+	return isUnsetValue(schemaDiff.ExclusiveMinDiff) && hasMin(schemaDiff.Revision)
+}
+
+// isExclusiveMaxUnset returns true if the max of a schema is no longer exclusive, which allows the max value itself
+func isExclusiveMaxUnset(schemaDiff *diff.SchemaDiff) bool {
+	return isUnsetValue(schemaDiff.ExclusiveMaxDiff) && hasMax(schemaDiff.Revision)
+}
+
+func hasMin(schema *openapi3.Schema) bool {
+	return schema != nil && schema.Min != nil
+}
+
+func hasMax(schema *openapi3.Schema) bool {
+	return schema != nil && schema.Max != nil
+}
+
+// RequestPropertyExclusiveBoundsUpdatedCheck detects request bodies and request properties whose min or max became exclusive
+func RequestPropertyExclusiveBoundsUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.RequestBodyDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+
+			newChange := func(id string, args []any, element any) Change {
+				return ApiChange{
+					Id:          id,
+					Level:       ERR,
+					Args:        args,
+					Operation:   operation,
+					OperationId: operationItem.Revision.OperationID,
+					Path:        path,
+					Source:      load.NewSource(source),
+				}.withLocation(config, element, operationItem.Revision)
+			}
+
+			modifiedMediaTypes := operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified
+			for _, mediaTypeDiff := range modifiedMediaTypes {
+				if mediaTypeDiff.SchemaDiff != nil {
+					if isExclusiveMinSet(mediaTypeDiff.SchemaDiff) {
+						result = append(result, newChange(RequestBodyExclusiveMinSetId, []any{*mediaTypeDiff.SchemaDiff.Revision.Min}, mediaTypeDiff.SchemaDiff.Revision))
+					}
+					if isExclusiveMaxSet(mediaTypeDiff.SchemaDiff) {
+						result = append(result, newChange(RequestBodyExclusiveMaxSetId, []any{*mediaTypeDiff.SchemaDiff.Revision.Max}, mediaTypeDiff.SchemaDiff.Revision))
+					}
+				}
+
+				CheckModifiedPropertiesDiff(
+					mediaTypeDiff.SchemaDiff,
+					func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+						if propertyDiff.Revision.ReadOnly {
+							return
+						}
+
+						propName := propertyFullName(propertyPath, propertyName)
+
+						if isExclusiveMinSet(propertyDiff) {
+							result = append(result, newChange(RequestPropertyExclusiveMinSetId, []any{propName, *propertyDiff.Revision.Min}, propertyDiff.Revision))
+						}
+						if isExclusiveMaxSet(propertyDiff) {
+							result = append(result, newChange(RequestPropertyExclusiveMaxSetId, []any{propName, *propertyDiff.Revision.Max}, propertyDiff.Revision))
+						}
+					})
+			}
+		}
+	}
+	return result
+}
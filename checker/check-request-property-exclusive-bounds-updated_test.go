@@ -0,0 +1,50 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+// BC: making the min of a request property exclusive is breaking
+func TestRequestPropertyExclusiveMinSet(t *testing.T) {
+	s1, err := open(schemaConstraintsBase)
+	require.NoError(t, err)
+	s2, err := open(schemaConstraintsBase)
+	require.NoError(t, err)
+
+	getRequestBodySchema(s2).Properties["amount"].Value.ExclusiveMin = true
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestPropertyExclusiveBoundsUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestPropertyExclusiveMinSetId,
+		Args:        []any{"amount", float64(0)},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(schemaConstraintsBase),
+		OperationId: "createOneGroup",
+	}, errs[0])
+	require.Equal(t, "the 'amount' request property's min '0.00' became exclusive", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: making the max of the request body exclusive without a max is not breaking
+func TestRequestBodyExclusiveMaxSetWithoutMax(t *testing.T) {
+	s1, err := open(schemaConstraintsBase)
+	require.NoError(t, err)
+	s2, err := open(schemaConstraintsBase)
+	require.NoError(t, err)
+
+	getRequestBodySchema(s2).ExclusiveMax = true
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestPropertyExclusiveBoundsUpdatedCheck), d, osm, checker.INFO)
+	require.Empty(t, errs)
+}
@@ -44,7 +44,7 @@ func RequestPropertyMaxLengthUpdatedCheck(diffReport *diff.Diff, operationsSourc
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, operationItem.Revision))
 						} else {
 							result = append(result, ApiChange{
 								Id:          RequestBodyMaxLengthIncreasedId,
@@ -54,7 +54,7 @@ func RequestPropertyMaxLengthUpdatedCheck(diffReport *diff.Diff, operationsSourc
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, operationItem.Revision))
 						}
 					}
 				}
@@ -82,7 +82,7 @@ func RequestPropertyMaxLengthUpdatedCheck(diffReport *diff.Diff, operationsSourc
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, propertyDiff.Revision, operationItem.Revision))
 						} else {
 							result = append(result, ApiChange{
 								Id:          RequestPropertyMaxLengthIncreasedId,
@@ -92,7 +92,7 @@ func RequestPropertyMaxLengthUpdatedCheck(diffReport *diff.Diff, operationsSourc
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, propertyDiff.Revision, operationItem.Revision))
 						}
 
 					})
@@ -0,0 +1,86 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	RequestBodyMaxPropertiesSetId           = "request-body-max-properties-set"
+	RequestBodyMaxPropertiesDecreasedId     = "request-body-max-properties-decreased"
+	RequestBodyMaxPropertiesIncreasedId     = "request-body-max-properties-increased"
+	RequestPropertyMaxPropertiesSetId       = "request-property-max-properties-set"
+	RequestPropertyMaxPropertiesDecreasedId = "request-property-max-properties-decreased"
+	RequestPropertyMaxPropertiesIncreasedId = "request-property-max-properties-increased"
+)
+
+// RequestPropertyMaxPropertiesUpdatedCheck detects changes to the maxProperties of request bodies and request properties
+func RequestPropertyMaxPropertiesUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.RequestBodyDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+
+			newChange := func(id string, level Level, args []any, comment string, element any) Change {
+				return ApiChange{
+					Id:          id,
+					Level:       level,
+					Args:        args,
+					Comment:     comment,
+					Operation:   operation,
+					OperationId: operationItem.Revision.OperationID,
+					Path:        path,
+					Source:      load.NewSource(source),
+				}.withLocation(config, element, operationItem.Revision)
+			}
+
+			modifiedMediaTypes := operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified
+			for _, mediaTypeDiff := range modifiedMediaTypes {
+				if mediaTypeDiff.SchemaDiff != nil && mediaTypeDiff.SchemaDiff.MaxPropsDiff != nil {
+					maxPropsDiff := mediaTypeDiff.SchemaDiff.MaxPropsDiff
+					if maxPropsDiff.From == nil && maxPropsDiff.To != nil {
+						result = append(result, newChange(RequestBodyMaxPropertiesSetId, WARN, []any{maxPropsDiff.To}, commentId(RequestBodyMaxPropertiesSetId), mediaTypeDiff.SchemaDiff.Revision))
+					} else if IsDecreasedValue(maxPropsDiff) {
+						result = append(result, newChange(RequestBodyMaxPropertiesDecreasedId, ERR, []any{maxPropsDiff.From, maxPropsDiff.To}, "", mediaTypeDiff.SchemaDiff.Revision))
+					} else if IsIncreasedValue(maxPropsDiff) {
+						result = append(result, newChange(RequestBodyMaxPropertiesIncreasedId, INFO, []any{maxPropsDiff.From, maxPropsDiff.To}, "", mediaTypeDiff.SchemaDiff.Revision))
+					}
+				}
+
+				CheckModifiedPropertiesDiff(
+					mediaTypeDiff.SchemaDiff,
+					func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+						maxPropsDiff := propertyDiff.MaxPropsDiff
+						if maxPropsDiff == nil {
+							return
+						}
+
+						propName := propertyFullName(propertyPath, propertyName)
+
+						if maxPropsDiff.From == nil && maxPropsDiff.To != nil {
+							if propertyDiff.Revision.ReadOnly {
+								return
+							}
+							result = append(result, newChange(RequestPropertyMaxPropertiesSetId, WARN, []any{propName, maxPropsDiff.To}, commentId(RequestPropertyMaxPropertiesSetId), propertyDiff.Revision))
+						} else if IsDecreasedValue(maxPropsDiff) {
+							result = append(result, newChange(RequestPropertyMaxPropertiesDecreasedId, conditionalError(!propertyDiff.Revision.ReadOnly, INFO), []any{propName, maxPropsDiff.From, maxPropsDiff.To}, "", propertyDiff.Revision))
+						} else if IsIncreasedValue(maxPropsDiff) {
+							result = append(result, newChange(RequestPropertyMaxPropertiesIncreasedId, INFO, []any{propName, maxPropsDiff.From, maxPropsDiff.To}, "", propertyDiff.Revision))
+						}
+					})
+			}
+		}
+	}
+	return result
+}
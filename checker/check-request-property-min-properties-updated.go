@@ -0,0 +1,76 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	RequestBodyMinPropertiesIncreasedId     = "request-body-min-properties-increased"
+	RequestBodyMinPropertiesDecreasedId     = "request-body-min-properties-decreased"
+	RequestPropertyMinPropertiesIncreasedId = "request-property-min-properties-increased"
+	RequestPropertyMinPropertiesDecreasedId = "request-property-min-properties-decreased"
+)
+
+// RequestPropertyMinPropertiesUpdatedCheck detects changes to the minProperties of request bodies and request properties
+func RequestPropertyMinPropertiesUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.RequestBodyDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+
+			newChange := func(id string, level Level, args []any, element any) Change {
+				return ApiChange{
+					Id:          id,
+					Level:       level,
+					Args:        args,
+					Operation:   operation,
+					OperationId: operationItem.Revision.OperationID,
+					Path:        path,
+					Source:      load.NewSource(source),
+				}.withLocation(config, element, operationItem.Revision)
+			}
+
+			modifiedMediaTypes := operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified
+			for _, mediaTypeDiff := range modifiedMediaTypes {
+				if mediaTypeDiff.SchemaDiff != nil && mediaTypeDiff.SchemaDiff.MinPropsDiff != nil {
+					minPropsDiff := mediaTypeDiff.SchemaDiff.MinPropsDiff
+					if IsIncreasedValue(minPropsDiff) {
+						result = append(result, newChange(RequestBodyMinPropertiesIncreasedId, ERR, []any{minPropsDiff.From, minPropsDiff.To}, mediaTypeDiff.SchemaDiff.Revision))
+					} else {
+						result = append(result, newChange(RequestBodyMinPropertiesDecreasedId, INFO, []any{minPropsDiff.From, minPropsDiff.To}, mediaTypeDiff.SchemaDiff.Revision))
+					}
+				}
+
+				CheckModifiedPropertiesDiff(
+					mediaTypeDiff.SchemaDiff,
+					func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+						minPropsDiff := propertyDiff.MinPropsDiff
+						if minPropsDiff == nil {
+							return
+						}
+
+						propName := propertyFullName(propertyPath, propertyName)
+
+						if IsIncreasedValue(minPropsDiff) {
+							result = append(result, newChange(RequestPropertyMinPropertiesIncreasedId, conditionalError(!propertyDiff.Revision.ReadOnly, INFO), []any{propName, minPropsDiff.From, minPropsDiff.To}, propertyDiff.Revision))
+						} else {
+							result = append(result, newChange(RequestPropertyMinPropertiesDecreasedId, INFO, []any{propName, minPropsDiff.From, minPropsDiff.To}, propertyDiff.Revision))
+						}
+					})
+			}
+		}
+	}
+	return result
+}
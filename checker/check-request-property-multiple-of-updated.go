@@ -0,0 +1,95 @@
+package checker
+
+import (
+	"math"
+
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	RequestBodyMultipleOfSetId         = "request-body-multiple-of-set"
+	RequestBodyMultipleOfChangedId     = "request-body-multiple-of-changed"
+	RequestPropertyMultipleOfSetId     = "request-property-multiple-of-set"
+	RequestPropertyMultipleOfChangedId = "request-property-multiple-of-changed"
+)
+
+// isMultipleOf returns true if value is an integer multiple of divisor
+func isMultipleOf(value, divisor any) bool {
+	valueFloat64, ok := value.(float64)
+	divisorFloat64, okDivisor := divisor.(float64)
+	if !ok || !okDivisor || divisorFloat64 == 0 {
+		return false
+	}
+	quotient := valueFloat64 / divisorFloat64
+	return math.Abs(quotient-math.Round(quotient)) < 1e-9
+}
+
+// RequestPropertyMultipleOfUpdatedCheck detects changes to the multipleOf of request bodies and request properties
+// A new multipleOf rejects valid values unless every multiple of the previous multipleOf is also a multiple of the new one
+func RequestPropertyMultipleOfUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.RequestBodyDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+
+			newChange := func(id string, level Level, args []any, comment string, element any) Change {
+				return ApiChange{
+					Id:          id,
+					Level:       level,
+					Args:        args,
+					Comment:     comment,
+					Operation:   operation,
+					OperationId: operationItem.Revision.OperationID,
+					Path:        path,
+					Source:      load.NewSource(source),
+				}.withLocation(config, element, operationItem.Revision)
+			}
+
+			modifiedMediaTypes := operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified
+			for _, mediaTypeDiff := range modifiedMediaTypes {
+				if mediaTypeDiff.SchemaDiff != nil && mediaTypeDiff.SchemaDiff.MultipleOfDiff != nil {
+					multipleOfDiff := mediaTypeDiff.SchemaDiff.MultipleOfDiff
+					if multipleOfDiff.From == nil && multipleOfDiff.To != nil {
+						result = append(result, newChange(RequestBodyMultipleOfSetId, WARN, []any{multipleOfDiff.To}, commentId(RequestBodyMultipleOfSetId), mediaTypeDiff.SchemaDiff.Revision))
+					} else if multipleOfDiff.From != nil && multipleOfDiff.To != nil {
+						result = append(result, newChange(RequestBodyMultipleOfChangedId, conditionalError(!isMultipleOf(multipleOfDiff.From, multipleOfDiff.To), INFO), []any{multipleOfDiff.From, multipleOfDiff.To}, "", mediaTypeDiff.SchemaDiff.Revision))
+					}
+				}
+
+				CheckModifiedPropertiesDiff(
+					mediaTypeDiff.SchemaDiff,
+					func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+						multipleOfDiff := propertyDiff.MultipleOfDiff
+						if multipleOfDiff == nil || multipleOfDiff.To == nil {
+							return
+						}
+						if propertyDiff.Revision.ReadOnly {
+							return
+						}
+
+						propName := propertyFullName(propertyPath, propertyName)
+
+						if multipleOfDiff.From == nil {
+							result = append(result, newChange(RequestPropertyMultipleOfSetId, WARN, []any{propName, multipleOfDiff.To}, commentId(RequestPropertyMultipleOfSetId), propertyDiff.Revision))
+							return
+						}
+
+						result = append(result, newChange(RequestPropertyMultipleOfChangedId, conditionalError(!isMultipleOf(multipleOfDiff.From, multipleOfDiff.To), INFO), []any{propName, multipleOfDiff.From, multipleOfDiff.To}, "", propertyDiff.Revision))
+					})
+			}
+		}
+	}
+	return result
+}
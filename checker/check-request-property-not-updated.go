@@ -0,0 +1,161 @@
+package checker
+
+import (
+	"math"
+
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	RequestBodyNotSchemaAddedId         = "request-body-not-schema-added"
+	RequestBodyNotSchemaBroadenedId     = "request-body-not-schema-broadened"
+	RequestPropertyNotSchemaAddedId     = "request-property-not-schema-added"
+	RequestPropertyNotSchemaBroadenedId = "request-property-not-schema-broadened"
+)
+
+// isSchemaRelaxed returns true if the revision schema matches values that the base schema doesn't match, based on the changes to its main constraints
+// It doesn't look into subschemas, so a schema may be relaxed even if it returns false
+func isSchemaRelaxed(schemaDiff *diff.SchemaDiff) bool {
+	if schemaDiff == nil {
+		return false
+	}
+
+	removed := func(valueDiff *diff.ValueDiff) bool {
+		return valueDiff != nil && (valueDiff.To == nil || valueDiff.To == "")
+	}
+
+	if removed(schemaDiff.TypeDiff) || removed(schemaDiff.FormatDiff) || removed(schemaDiff.PatternDiff) ||
+		removed(schemaDiff.MinDiff) || removed(schemaDiff.MaxDiff) || removed(schemaDiff.MaxLengthDiff) || removed(schemaDiff.MaxItemsDiff) || removed(schemaDiff.MaxPropsDiff) {
+		return true
+	}
+
+	if schemaDiff.EnumDiff != nil && (schemaDiff.EnumDiff.EnumDeleted || !schemaDiff.EnumDiff.EnumAdded && len(schemaDiff.EnumDiff.Added) > 0) {
+		return true
+	}
+
+	if schemaDiff.RequiredDiff != nil && len(schemaDiff.RequiredDiff.Deleted) > 0 {
+		return true
+	}
+
+	for _, valueDiff := range []*diff.ValueDiff{schemaDiff.ExclusiveMinDiff, schemaDiff.ExclusiveMaxDiff} {
+		if valueDiff != nil && valueDiff.To == false {
+			return true
+		}
+	}
+
+	if isMultipleOfRelaxed(schemaDiff.MultipleOfDiff) {
+		return true
+	}
+
+	for _, valueDiff := range []*diff.ValueDiff{schemaDiff.MinDiff, schemaDiff.MinLengthDiff, schemaDiff.MinItemsDiff, schemaDiff.MinPropsDiff} {
+		if valueDiff != nil && IsDecreasedValue(valueDiff) {
+			return true
+		}
+	}
+
+	for _, valueDiff := range []*diff.ValueDiff{schemaDiff.MaxDiff, schemaDiff.MaxLengthDiff, schemaDiff.MaxItemsDiff, schemaDiff.MaxPropsDiff} {
+		if valueDiff != nil && IsIncreasedValue(valueDiff) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isMultipleOfRelaxed returns true if the revision multipleOf accepts values that the base multipleOf doesn't, that is, if it was removed or if it isn't a multiple of the base
+func isMultipleOfRelaxed(valueDiff *diff.ValueDiff) bool {
+	if valueDiff == nil {
+		return false
+	}
+	from, ok := valueDiff.From.(float64)
+	if !ok {
+		return false
+	}
+	to, ok := valueDiff.To.(float64)
+	if !ok {
+		return true
+	}
+	return math.Mod(to, from) != 0
+}
+
+// getNotSchemaRestrictions returns whether a 'not' schema was added, or broadened so that it rejects values that it didn't reject before
+func getNotSchemaRestrictions(schemaDiff *diff.SchemaDiff) (bool, bool) {
+	if schemaDiff == nil || schemaDiff.NotDiff == nil {
+		return false, false
+	}
+	notDiff := schemaDiff.NotDiff
+	if notDiff.SchemaAdded {
+		return true, false
+	}
+	return false, !notDiff.SchemaDeleted && isSchemaRelaxed(notDiff)
+}
+
+// RequestPropertyNotUpdatedCheck detects 'not' schemas in request bodies that reject values that were valid before
+// A 'not' schema rejects the values that match it, so relaxing the constraints of the 'not' schema rejects more values
+// For the same reason, relaxing a property under a 'not' schema is reported as broadening that 'not' schema
+func RequestPropertyNotUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			source := (*operationsSources)[operationItem.Revision]
+
+			if operationItem.RequestBodyDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified == nil {
+				continue
+			}
+
+			newChange := func(id string, args []any, schemaDiff *diff.SchemaDiff) Change {
+				return ApiChange{
+					Id:          id,
+					Level:       ERR,
+					Args:        args,
+					Operation:   operation,
+					OperationId: operationItem.Revision.OperationID,
+					Path:        path,
+					Source:      load.NewSource(source),
+				}.withLocation(config, schemaDiff.Revision, operationItem.Revision)
+			}
+
+			modifiedMediaTypes := operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified
+			for _, mediaTypeDiff := range modifiedMediaTypes {
+				if mediaTypeDiff.SchemaDiff == nil {
+					continue
+				}
+
+				if added, broadened := getNotSchemaRestrictions(mediaTypeDiff.SchemaDiff); added {
+					result = append(result, newChange(RequestBodyNotSchemaAddedId, nil, mediaTypeDiff.SchemaDiff))
+				} else if broadened {
+					result = append(result, newChange(RequestBodyNotSchemaBroadenedId, nil, mediaTypeDiff.SchemaDiff))
+				}
+
+				checkNegatedModifiedPropertiesDiff(
+					mediaTypeDiff.SchemaDiff,
+					func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff, negated bool) {
+						propName := propertyFullName(propertyPath, propertyName)
+
+						if negated {
+							if propertyName != "" && isSchemaRelaxed(propertyDiff) {
+								result = append(result, newChange(RequestPropertyNotSchemaBroadenedId, []any{propName}, propertyDiff))
+							}
+							return
+						}
+
+						if added, broadened := getNotSchemaRestrictions(propertyDiff); added {
+							result = append(result, newChange(RequestPropertyNotSchemaAddedId, []any{propName}, propertyDiff))
+						} else if broadened {
+							result = append(result, newChange(RequestPropertyNotSchemaBroadenedId, []any{propName}, propertyDiff))
+						}
+					})
+			}
+		}
+	}
+	return result
+}
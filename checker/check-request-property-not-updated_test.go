@@ -0,0 +1,212 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const notSchemaBase = "../data/checker/not_schema_base.yaml"
+
+// BC: adding a 'not' schema to the request body is breaking
+func TestRequestBodyNotSchemaAdded(t *testing.T) {
+	s1, err := open(notSchemaBase)
+	require.NoError(t, err)
+	s2, err := open(notSchemaBase)
+	require.NoError(t, err)
+
+	getRequestBodySchema(s2).Not = openapi3.NewSchemaRef("", &openapi3.Schema{Required: []string{"code"}})
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestPropertyNotUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestBodyNotSchemaAddedId,
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(notSchemaBase),
+		OperationId: "createOneGroup",
+	}, errs[0])
+	require.Equal(t, "added a 'not' schema to the request's body", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: adding a 'not' schema to a request property is breaking
+func TestRequestPropertyNotSchemaAdded(t *testing.T) {
+	s1, err := open(notSchemaBase)
+	require.NoError(t, err)
+	s2, err := open(notSchemaBase)
+	require.NoError(t, err)
+
+	getRequestBodySchema(s2).Properties["code"].Value.Not = openapi3.NewSchemaRef("", &openapi3.Schema{Pattern: "^[0-9]+$"})
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestPropertyNotUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestPropertyNotSchemaAddedId,
+		Args:        []any{"code"},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(notSchemaBase),
+		OperationId: "createOneGroup",
+	}, errs[0])
+	require.Equal(t, "added a 'not' schema to the request property 'code'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: broadening the 'not' schema of a request property is breaking
+func TestRequestPropertyNotSchemaBroadened(t *testing.T) {
+	s1, err := open(notSchemaBase)
+	require.NoError(t, err)
+	s2, err := open(notSchemaBase)
+	require.NoError(t, err)
+
+	not := getRequestBodySchema(s2).Properties["name"].Value.Not.Value
+	not.Enum = append(not.Enum, "guest")
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestPropertyNotUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestPropertyNotSchemaBroadenedId,
+		Args:        []any{"name"},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(notSchemaBase),
+		OperationId: "createOneGroup",
+	}, errs[0])
+	require.Equal(t, "broadened the 'not' schema of the request property 'name'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: narrowing or removing the 'not' schema of a request property is not breaking
+func TestRequestPropertyNotSchemaNarrowedOrRemoved(t *testing.T) {
+	s1, err := open(notSchemaBase)
+	require.NoError(t, err)
+	s2, err := open(notSchemaBase)
+	require.NoError(t, err)
+
+	getRequestBodySchema(s2).Properties["name"].Value.Not.Value.Enum = []any{"root"}
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestPropertyNotUpdatedCheck), d, osm, checker.INFO)
+	require.Empty(t, errs)
+
+	getRequestBodySchema(s2).Properties["name"].Value.Not = nil
+
+	d, osm, err = diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs = checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestPropertyNotUpdatedCheck), d, osm, checker.INFO)
+	require.Empty(t, errs)
+}
+
+// BC: decreasing the max length of the 'not' schema of a request property is not breaking
+func TestRequestPropertyNotSchemaMaxLengthDecreased(t *testing.T) {
+	s1, err := open(notSchemaBase)
+	require.NoError(t, err)
+	s2, err := open(notSchemaBase)
+	require.NoError(t, err)
+
+	maxLength1, maxLength2 := uint64(5), uint64(3)
+	getRequestBodySchema(s1).Properties["name"].Value.Not.Value.MaxLength = &maxLength1
+	getRequestBodySchema(s2).Properties["name"].Value.Not.Value.MaxLength = &maxLength2
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
+	require.Empty(t, errs)
+}
+
+// BC: increasing the max length of a property under the 'not' schema of the request body is breaking
+func TestRequestPropertyUnderNotSchemaRelaxed(t *testing.T) {
+	s1, err := open(notSchemaBase)
+	require.NoError(t, err)
+	s2, err := open(notSchemaBase)
+	require.NoError(t, err)
+
+	maxLength1, maxLength2 := uint64(3), uint64(5)
+	getRequestBodySchema(s1).Not = openapi3.NewSchemaRef("", &openapi3.Schema{Properties: openapi3.Schemas{"code": openapi3.NewSchemaRef("", &openapi3.Schema{MaxLength: &maxLength1})}})
+	getRequestBodySchema(s2).Not = openapi3.NewSchemaRef("", &openapi3.Schema{Properties: openapi3.Schemas{"code": openapi3.NewSchemaRef("", &openapi3.Schema{MaxLength: &maxLength2})}})
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(checker.GetDefaultChecks(), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestPropertyNotSchemaBroadenedId,
+		Level:       checker.ERR,
+		Args:        []any{"/not/code"},
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(notSchemaBase),
+		OperationId: "createOneGroup",
+	}, errs[0])
+}
+
+// BC: decreasing the max length of a property under the 'not' schema of the request body is not breaking
+func TestRequestPropertyUnderNotSchemaRestricted(t *testing.T) {
+	s1, err := open(notSchemaBase)
+	require.NoError(t, err)
+	s2, err := open(notSchemaBase)
+	require.NoError(t, err)
+
+	maxLength1, maxLength2 := uint64(5), uint64(3)
+	getRequestBodySchema(s1).Not = openapi3.NewSchemaRef("", &openapi3.Schema{Properties: openapi3.Schemas{"code": openapi3.NewSchemaRef("", &openapi3.Schema{MaxLength: &maxLength1})}})
+	getRequestBodySchema(s2).Not = openapi3.NewSchemaRef("", &openapi3.Schema{Properties: openapi3.Schemas{"code": openapi3.NewSchemaRef("", &openapi3.Schema{MaxLength: &maxLength2})}})
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
+	require.Empty(t, errs)
+}
+
+// BC: relaxing the exclusive bounds or the multipleOf of the 'not' schema of a request property is breaking
+func TestRequestPropertyNotSchemaBoundsRelaxed(t *testing.T) {
+	bound := 1.0
+	for _, update := range []func(s1, s2 *openapi3.Schema){
+		func(s1, s2 *openapi3.Schema) { s1.Min, s2.Min = &bound, &bound; s1.ExclusiveMin = true },
+		func(s1, s2 *openapi3.Schema) { s1.Max, s2.Max = &bound, &bound; s1.ExclusiveMax = true },
+		func(s1, s2 *openapi3.Schema) { s1.MultipleOf = openapi3.Float64Ptr(4) },
+		func(s1, s2 *openapi3.Schema) {
+			s1.MultipleOf, s2.MultipleOf = openapi3.Float64Ptr(4), openapi3.Float64Ptr(2)
+		},
+	} {
+		s1, err := open(notSchemaBase)
+		require.NoError(t, err)
+		s2, err := open(notSchemaBase)
+		require.NoError(t, err)
+
+		update(getRequestBodySchema(s1).Properties["name"].Value.Not.Value, getRequestBodySchema(s2).Properties["name"].Value.Not.Value)
+
+		d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+		require.NoError(t, err)
+		errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestPropertyNotUpdatedCheck), d, osm, checker.INFO)
+		require.Len(t, errs, 1)
+		require.Equal(t, checker.RequestPropertyNotSchemaBroadenedId, errs[0].GetId())
+	}
+}
+
+// BC: changing the multipleOf of the 'not' schema of a request property to a multiple of it is not breaking
+func TestRequestPropertyNotSchemaMultipleOfRestricted(t *testing.T) {
+	s1, err := open(notSchemaBase)
+	require.NoError(t, err)
+	s2, err := open(notSchemaBase)
+	require.NoError(t, err)
+
+	getRequestBodySchema(s1).Properties["name"].Value.Not.Value.MultipleOf = openapi3.Float64Ptr(2)
+	getRequestBodySchema(s2).Properties["name"].Value.Not.Value.MultipleOf = openapi3.Float64Ptr(4)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestPropertyNotUpdatedCheck), d, osm, checker.INFO)
+	require.Empty(t, errs)
+}
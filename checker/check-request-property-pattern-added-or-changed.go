@@ -6,11 +6,15 @@ import (
 )
 
 const (
-	RequestPropertyPatternRemovedId = "request-property-pattern-removed"
-	RequestPropertyPatternAddedId   = "request-property-pattern-added"
-	RequestPropertyPatternChangedId = "request-property-pattern-changed"
+	RequestPropertyPatternRemovedId      = "request-property-pattern-removed"
+	RequestPropertyPatternAddedId        = "request-property-pattern-added"
+	RequestPropertyPatternChangedId      = "request-property-pattern-changed"
+	RequestPropertyPatternBroadenedId    = "request-property-pattern-broadened"
+	RequestPropertyPatternNarrowedId     = "request-property-pattern-narrowed"
+	RequestPropertyPatternIncomparableId = "request-property-pattern-incomparable"
 )
 
+// RequestPropertyPatternUpdatedCheck detects patterns that were added to, removed from or changed in request properties
 func RequestPropertyPatternUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
 	result := make(Changes, 0)
 	if diffReport.PathsDiff == nil {
@@ -48,7 +52,7 @@ func RequestPropertyPatternUpdatedCheck(diffReport *diff.Diff, operationsSources
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, propertyDiff.Revision, operationItem.Revision))
 						} else if patternDiff.From == "" {
 							result = append(result, ApiChange{
 								Id:          RequestPropertyPatternAddedId,
@@ -59,16 +63,19 @@ func RequestPropertyPatternUpdatedCheck(diffReport *diff.Diff, operationsSources
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, propertyDiff.Revision, operationItem.Revision))
 						} else {
-							level := WARN
-							comment := PatternChangedCommentId
-							if patternDiff.To == ".*" {
-								level = INFO
-								comment = ""
+							id, level, comment := getPatternChange(patternDiff.From, patternDiff.To, patternChangeIds{
+								broadened:    RequestPropertyPatternBroadenedId,
+								narrowed:     RequestPropertyPatternNarrowedId,
+								changed:      RequestPropertyPatternChangedId,
+								incomparable: RequestPropertyPatternIncomparableId,
+							}, INFO, ERR)
+							if id == "" {
+								id, level, comment = RequestPropertyPatternChangedId, WARN, PatternChangedCommentId
 							}
 							result = append(result, ApiChange{
-								Id:          RequestPropertyPatternChangedId,
+								Id:          id,
 								Level:       level,
 								Args:        []any{propName, patternDiff.From, patternDiff.To},
 								Comment:     comment,
@@ -76,7 +83,7 @@ func RequestPropertyPatternUpdatedCheck(diffReport *diff.Diff, operationsSources
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, propertyDiff.Revision, operationItem.Revision))
 						}
 					})
 			}
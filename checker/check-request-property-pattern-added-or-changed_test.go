@@ -16,7 +16,7 @@ func TestRequestPropertyPatternChanged(t *testing.T) {
 	s2, err := open("../data/checker/request_property_pattern_added_or_changed_revision.yaml")
 	require.NoError(t, err)
 
-	s2.Spec.Paths.Value("/test").Post.RequestBody.Value.Content["application/json"].Schema.Value.Properties["name"].Value.Pattern = "^[\\w\\s]+$"
+	s2.Spec.Paths.Value("/test").Post.RequestBody.Value.Content["application/json"].Schema.Value.Properties["name"].Value.Pattern = "^(?!_)[\\w\\s]+$"
 
 	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
 	require.NoError(t, err)
@@ -24,7 +24,7 @@ func TestRequestPropertyPatternChanged(t *testing.T) {
 	require.Len(t, errs, 1)
 	require.Equal(t, checker.ApiChange{
 		Id:        checker.RequestPropertyPatternChangedId,
-		Args:      []any{"name", "^\\w+$", "^[\\w\\s]+$"},
+		Args:      []any{"name", "^\\w+$", "^(?!_)[\\w\\s]+$"},
 		Level:     checker.WARN,
 		Operation: "POST",
 		Path:      "/test",
@@ -34,6 +34,50 @@ func TestRequestPropertyPatternChanged(t *testing.T) {
 	require.Equal(t, "This is a warning because it is difficult to automatically analyze if the new pattern is a superset of the previous pattern (e.g. changed from '[0-9]+' to '[0-9]*')", errs[0].GetComment(checker.NewDefaultLocalizer()))
 }
 
+// CL: broadening request property pattern
+func TestRequestPropertyPatternBroadened(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, "../data/checker/request_property_pattern_added_or_changed_base.yaml", singleCheckConfig(checker.RequestPropertyPatternUpdatedCheck), func(s *load.SpecInfo) {
+		s.Spec.Paths.Value("/test").Post.RequestBody.Value.Content["application/json"].Schema.Value.Properties["name"].Value.Pattern = "^[\\w\\s]+$"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestPropertyPatternBroadenedId, errs[0].GetId())
+	require.Equal(t, checker.INFO, errs[0].GetLevel())
+	require.Equal(t, "broadened the pattern of the request property 'name' from '^\\w+$' to '^[\\w\\s]+$'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// CL: narrowing request property pattern
+func TestRequestPropertyPatternNarrowed(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, "../data/checker/request_property_pattern_added_or_changed_base.yaml", singleCheckConfig(checker.RequestPropertyPatternUpdatedCheck), func(s *load.SpecInfo) {
+		s.Spec.Paths.Value("/test").Post.RequestBody.Value.Content["application/json"].Schema.Value.Properties["name"].Value.Pattern = "^[a-z]+$"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestPropertyPatternNarrowedId, errs[0].GetId())
+	require.Equal(t, checker.ERR, errs[0].GetLevel())
+	require.Equal(t, "narrowed the pattern of the request property 'name' from '^\\w+$' to '^[a-z]+$'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// CL: changing request property pattern to one that matches other values
+func TestRequestPropertyPatternIncomparable(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, "../data/checker/request_property_pattern_added_or_changed_base.yaml", singleCheckConfig(checker.RequestPropertyPatternUpdatedCheck), func(s *load.SpecInfo) {
+		s.Spec.Paths.Value("/test").Post.RequestBody.Value.Content["application/json"].Schema.Value.Properties["name"].Value.Pattern = "^[a-z-]+$"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestPropertyPatternIncomparableId, errs[0].GetId())
+	require.Equal(t, checker.ERR, errs[0].GetLevel())
+	require.Equal(t, "Each of the patterns matches values that the other pattern doesn't match", errs[0].GetComment(checker.NewDefaultLocalizer()))
+}
+
+// CL: changing request property pattern to an equivalent one
+func TestRequestPropertyPatternEquivalent(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, "../data/checker/request_property_pattern_added_or_changed_base.yaml", singleCheckConfig(checker.RequestPropertyPatternUpdatedCheck), func(s *load.SpecInfo) {
+		s.Spec.Paths.Value("/test").Post.RequestBody.Value.Content["application/json"].Schema.Value.Properties["name"].Value.Pattern = "^[a-zA-Z0-9_]+$"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestPropertyPatternChangedId, errs[0].GetId())
+	require.Equal(t, checker.INFO, errs[0].GetLevel())
+	require.Empty(t, errs[0].GetComment(checker.NewDefaultLocalizer()))
+}
+
 // CL: adding request property pattern
 func TestRequestPropertyPatternAdded(t *testing.T) {
 	s1, err := open("../data/checker/request_property_pattern_added_or_changed_revision.yaml")
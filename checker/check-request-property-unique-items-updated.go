@@ -0,0 +1,78 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	RequestBodyUniqueItemsSetId     = "request-body-unique-items-set"
+	RequestPropertyUniqueItemsSetId = "request-property-unique-items-set"
+)
+
+// isSetValue returns true if a boolean schema keyword, like uniqueItems, was changed from false to true
+func isSetValue(valueDiff *diff.ValueDiff) bool {
+	return valueDiff != nil && valueDiff.From == false && valueDiff.To == true
+}
+
+// isUnsetValue returns true if a boolean schema keyword, like uniqueItems, was changed from true to false
+func isUnsetValue(valueDiff *diff.ValueDiff) bool {
+	return valueDiff != nil && valueDiff.From == true && valueDiff.To == false
+}
+
+// RequestPropertyUniqueItemsUpdatedCheck detects request bodies and request properties that started to require unique items
+func RequestPropertyUniqueItemsUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.RequestBodyDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+
+			modifiedMediaTypes := operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified
+			for _, mediaTypeDiff := range modifiedMediaTypes {
+				if mediaTypeDiff.SchemaDiff != nil && isSetValue(mediaTypeDiff.SchemaDiff.UniqueItemsDiff) {
+					result = append(result, ApiChange{
+						Id:          RequestBodyUniqueItemsSetId,
+						Level:       ERR,
+						Operation:   operation,
+						OperationId: operationItem.Revision.OperationID,
+						Path:        path,
+						Source:      load.NewSource(source),
+					}.withLocation(config, mediaTypeDiff.SchemaDiff.Revision, operationItem.Revision))
+				}
+
+				CheckModifiedPropertiesDiff(
+					mediaTypeDiff.SchemaDiff,
+					func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+						if !isSetValue(propertyDiff.UniqueItemsDiff) {
+							return
+						}
+						if propertyDiff.Revision.ReadOnly {
+							return
+						}
+
+						result = append(result, ApiChange{
+							Id:          RequestPropertyUniqueItemsSetId,
+							Level:       ERR,
+							Args:        []any{propertyFullName(propertyPath, propertyName)},
+							Operation:   operation,
+							OperationId: operationItem.Revision.OperationID,
+							Path:        path,
+							Source:      load.NewSource(source),
+						}.withLocation(config, propertyDiff.Revision, operationItem.Revision))
+					})
+			}
+		}
+	}
+	return result
+}
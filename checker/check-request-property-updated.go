@@ -43,7 +43,7 @@ func RequestPropertyUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, propertyItem, operationItem.Revision))
 						}
 					})
 				CheckAddedPropertiesDiff(
@@ -65,7 +65,7 @@ func RequestPropertyUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, propertyItem, operationItem.Revision))
 						} else {
 							result = append(result, ApiChange{
 								Id:          NewOptionalRequestPropertyId,
@@ -75,7 +75,7 @@ func RequestPropertyUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, propertyItem, operationItem.Revision))
 						}
 					})
 			}
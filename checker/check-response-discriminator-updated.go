@@ -46,7 +46,7 @@ func ResponseDiscriminatorUpdatedCheck(diffReport *diff.Diff, operationsSources
 					OperationId: operationItem.Revision.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, operationItem.Revision))
 			}
 
 			for responseStatus, responsesDiff := range operationItem.ResponsesDiff.Modified {
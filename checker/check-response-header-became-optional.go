@@ -48,7 +48,7 @@ func ResponseHeaderBecameOptionalCheck(diffReport *diff.Diff, operationsSources
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      load.NewSource(source),
-					})
+					}.withLocation(config, responseDiff.Revision, operationItem.Revision))
 				}
 			}
 		}
@@ -46,7 +46,7 @@ func ResponseHeaderRemovedCheck(diffReport *diff.Diff, operationsSources *diff.O
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      load.NewSource(source),
-						})
+						}.withLocation(config, responseDiff.Base.Headers[headerName].Value, responseDiff.Base, operationItem.Base))
 					} else {
 						result = append(result, ApiChange{
 							Id:          OptionalResponseHeaderRemovedId,
@@ -56,7 +56,7 @@ func ResponseHeaderRemovedCheck(diffReport *diff.Diff, operationsSources *diff.O
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      load.NewSource(source),
-						})
+						}.withLocation(config, responseDiff.Base.Headers[headerName].Value, responseDiff.Base, operationItem.Base))
 					}
 				}
 			}
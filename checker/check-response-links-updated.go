@@ -0,0 +1,112 @@
+package checker
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	ResponseLinkRemovedId          = "response-link-removed"
+	ResponseLinkAddedId            = "response-link-added"
+	ResponseLinkTargetChangedId    = "response-link-target-changed"
+	ResponseLinkParameterRemovedId = "response-link-parameter-removed"
+	ResponseLinkParameterAddedId   = "response-link-parameter-added"
+	ResponseLinkParameterChangedId = "response-link-parameter-changed"
+)
+
+// getLinkTarget returns the operation that a link points to, either by operationId or by operationRef
+func getLinkTarget(link *openapi3.Link) string {
+	if link == nil {
+		return ""
+	}
+	if link.OperationID != "" {
+		return link.OperationID
+	}
+	return link.OperationRef
+}
+
+func getLink(links openapi3.Links, linkName string) *openapi3.Link {
+	if linkRef := links[linkName]; linkRef != nil {
+		return linkRef.Value
+	}
+	return nil
+}
+
+func getResponseLink(response *openapi3.Response, linkName string) *openapi3.Link {
+	if response == nil {
+		return nil
+	}
+	return getLink(response.Links, linkName)
+}
+
+// ResponseLinksUpdatedCheck detects links that were removed from responses or that now point elsewhere
+// Clients that follow links to their next operation rely on the target operation and on the values passed to its parameters
+func ResponseLinksUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.ResponsesDiff == nil || operationItem.ResponsesDiff.Modified == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+			for responseStatus, responseDiff := range operationItem.ResponsesDiff.Modified {
+				if responseDiff == nil || responseDiff.LinksDiff == nil {
+					continue
+				}
+
+				newChange := func(id string, level Level, args []any, element any) Change {
+					return ApiChange{
+						Id:          id,
+						Level:       level,
+						Args:        append(args, responseStatus),
+						Operation:   operation,
+						OperationId: operationItem.Revision.OperationID,
+						Path:        path,
+						Source:      load.NewSource(source),
+					}.withLocation(config, element, responseDiff.Revision, operationItem.Revision)
+				}
+
+				for _, linkName := range responseDiff.LinksDiff.Deleted {
+					result = append(result, newChange(ResponseLinkRemovedId, WARN, []any{linkName}, nil))
+				}
+
+				for _, linkName := range responseDiff.LinksDiff.Added {
+					result = append(result, newChange(ResponseLinkAddedId, INFO, []any{linkName}, getResponseLink(responseDiff.Revision, linkName)))
+				}
+
+				for linkName, linkDiff := range responseDiff.LinksDiff.Modified {
+					baseLink := getResponseLink(responseDiff.Base, linkName)
+					revisionLink := getResponseLink(responseDiff.Revision, linkName)
+
+					if baseTarget, revisionTarget := getLinkTarget(baseLink), getLinkTarget(revisionLink); baseTarget != revisionTarget {
+						result = append(result, newChange(ResponseLinkTargetChangedId, WARN, []any{linkName, baseTarget, revisionTarget}, revisionLink))
+					}
+
+					if linkDiff.ParametersDiff == nil {
+						continue
+					}
+
+					for _, paramName := range linkDiff.ParametersDiff.Deleted {
+						result = append(result, newChange(ResponseLinkParameterRemovedId, WARN, []any{paramName, linkName}, revisionLink))
+					}
+
+					for _, paramName := range linkDiff.ParametersDiff.Added {
+						result = append(result, newChange(ResponseLinkParameterAddedId, INFO, []any{paramName, linkName}, revisionLink))
+					}
+
+					for paramName, paramDiff := range linkDiff.ParametersDiff.Modified {
+						result = append(result, newChange(ResponseLinkParameterChangedId, WARN, []any{paramName, linkName, paramDiff.From, paramDiff.To}, revisionLink))
+					}
+				}
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,89 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/load"
+)
+
+const responseLinksBase = "../data/checker/response_links_base.yaml"
+
+func getResponseLinks(s *load.SpecInfo) openapi3.Links {
+	return s.Spec.Paths.Value("/api/v1.0/groups").Post.Responses.Value("201").Value.Links
+}
+
+// CL: removing a response link
+func TestResponseLinkRemoved(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, responseLinksBase, singleCheckConfig(checker.ResponseLinksUpdatedCheck), func(s *load.SpecInfo) {
+		delete(getResponseLinks(s), "GetGroup")
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.ResponseLinkRemovedId,
+		Args:        []any{"GetGroup", "201"},
+		Level:       checker.WARN,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(responseLinksBase),
+		OperationId: "createGroup",
+	}, errs[0])
+	require.Equal(t, "removed the link 'GetGroup' from the response with the status '201'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// CL: adding a response link
+func TestResponseLinkAdded(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, responseLinksBase, singleCheckConfig(checker.ResponseLinksUpdatedCheck), func(s *load.SpecInfo) {
+		getResponseLinks(s)["GetGroupById"] = &openapi3.LinkRef{Value: &openapi3.Link{OperationID: "getGroup"}}
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ResponseLinkAddedId, errs[0].GetId())
+	require.Equal(t, checker.INFO, errs[0].GetLevel())
+}
+
+// CL: changing the operationId of a response link
+func TestResponseLinkTargetChanged(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, responseLinksBase, singleCheckConfig(checker.ResponseLinksUpdatedCheck), func(s *load.SpecInfo) {
+		getResponseLinks(s)["GetGroup"].Value.OperationID = "getGroupV2"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ResponseLinkTargetChangedId, errs[0].GetId())
+	require.Equal(t, checker.WARN, errs[0].GetLevel())
+	require.Equal(t, "the target of the link 'GetGroup' was changed from 'getGroup' to 'getGroupV2' in the response with the status '201'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// CL: replacing the operationId of a response link with an operationRef
+func TestResponseLinkTargetChangedToOperationRef(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, responseLinksBase, singleCheckConfig(checker.ResponseLinksUpdatedCheck), func(s *load.SpecInfo) {
+		link := getResponseLinks(s)["GetGroup"].Value
+		link.OperationID = ""
+		link.OperationRef = "#/paths/~1api~1v1.0~1groups~1{groupId}/get"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ResponseLinkTargetChangedId, errs[0].GetId())
+	require.Equal(t, []any{"GetGroup", "getGroup", "#/paths/~1api~1v1.0~1groups~1{groupId}/get", "201"}, errs[0].(checker.ApiChange).Args)
+}
+
+// CL: changing the expression of a response link parameter
+func TestResponseLinkParameterChanged(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, responseLinksBase, singleCheckConfig(checker.ResponseLinksUpdatedCheck), func(s *load.SpecInfo) {
+		getResponseLinks(s)["GetGroup"].Value.Parameters["groupId"] = "$response.body#/groupId"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ResponseLinkParameterChangedId, errs[0].GetId())
+	require.Equal(t, checker.WARN, errs[0].GetLevel())
+	require.Equal(t, "the parameter 'groupId' of the link 'GetGroup' was changed from '$response.body#/id' to '$response.body#/groupId' in the response with the status '201'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// CL: removing and adding response link parameters
+func TestResponseLinkParameterReplaced(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, responseLinksBase, singleCheckConfig(checker.ResponseLinksUpdatedCheck), func(s *load.SpecInfo) {
+		parameters := getResponseLinks(s)["GetGroup"].Value.Parameters
+		delete(parameters, "groupId")
+		parameters["id"] = "$response.body#/id"
+	})
+	require.Len(t, errs, 2)
+	require.ElementsMatch(t, []string{checker.ResponseLinkParameterRemovedId, checker.ResponseLinkParameterAddedId}, []string{errs[0].GetId(), errs[1].GetId()})
+}
@@ -6,10 +6,14 @@ import (
 )
 
 const (
-	ResponseMediaTypeRemovedId = "response-media-type-removed"
-	ResponseMediaTypeAddedId   = "response-media-type-added"
+	ResponseMediaTypeRemovedId   = "response-media-type-removed"
+	ResponseMediaTypeAddedId     = "response-media-type-added"
+	ResponseMediaTypeBroadenedId = "response-media-type-broadened"
+	ResponseMediaTypeNarrowedId  = "response-media-type-narrowed"
+	ResponseMediaTypeChangedId   = "response-media-type-changed"
 )
 
+// ResponseMediaTypeUpdatedCheck detects media types that were added to or removed from responses, or replaced by related media types
 func ResponseMediaTypeUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
 	result := make(Changes, 0)
 	if diffReport.PathsDiff == nil {
@@ -31,9 +35,6 @@ func ResponseMediaTypeUpdatedCheck(diffReport *diff.Diff, operationsSources *dif
 				if responsesDiff.ContentDiff == nil {
 					continue
 				}
-				if responsesDiff.ContentDiff.MediaTypeDeleted == nil {
-					continue
-				}
 				for _, mediaType := range responsesDiff.ContentDiff.MediaTypeDeleted {
 					result = append(result, ApiChange{
 						Id:          ResponseMediaTypeRemovedId,
@@ -43,7 +44,7 @@ func ResponseMediaTypeUpdatedCheck(diffReport *diff.Diff, operationsSources *dif
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      load.NewSource(source),
-					})
+					}.withLocation(config, responsesDiff.Base.Content.Get(mediaType), responsesDiff.Base, operationItem.Base))
 				}
 				for _, mediaType := range responsesDiff.ContentDiff.MediaTypeAdded {
 					result = append(result, ApiChange{
@@ -54,7 +55,30 @@ func ResponseMediaTypeUpdatedCheck(diffReport *diff.Diff, operationsSources *dif
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      load.NewSource(source),
-					})
+					}.withLocation(config, responsesDiff.Revision.Content.Get(mediaType), responsesDiff.Revision, operationItem.Revision))
+				}
+				for mediaType, mediaTypeDiff := range responsesDiff.ContentDiff.MediaTypeModified {
+					if mediaTypeDiff.NameDiff == nil {
+						continue
+					}
+					revisionMediaType := mediaTypeDiff.RevisionName(mediaType)
+
+					// clients keep expecting the base media type, so a broader response media type may return content they can't handle
+					id, level := getMediaTypeChange(mediaType, revisionMediaType, mediaTypeChangeIds{
+						broadened: ResponseMediaTypeBroadenedId,
+						narrowed:  ResponseMediaTypeNarrowedId,
+						changed:   ResponseMediaTypeChangedId,
+					}, WARN, INFO)
+
+					result = append(result, ApiChange{
+						Id:          id,
+						Level:       level,
+						Args:        []any{mediaType, revisionMediaType, responseStatus},
+						Operation:   operation,
+						OperationId: operationItem.Revision.OperationID,
+						Path:        path,
+						Source:      load.NewSource(source),
+					}.withLocation(config, responsesDiff.Revision.Content.Get(revisionMediaType), responsesDiff.Revision, operationItem.Revision))
 				}
 			}
 		}
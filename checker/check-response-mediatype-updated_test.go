@@ -52,3 +52,41 @@ func TestDeleteNewMediaType(t *testing.T) {
 		OperationId: "createOneGroup",
 	}, errs[0])
 }
+
+// CL: replacing a response media type with a wildcard
+func TestResponseMediaTypeBroadened(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, mediaTypeMatchBase, singleCheckConfig(checker.ResponseMediaTypeUpdatedCheck), func(s *load.SpecInfo) {
+		renameMediaType(s.Spec.Paths.Value("/orders").Post.Responses.Value("201").Value.Content, "application/json", "application/*")
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.ResponseMediaTypeBroadenedId,
+		Args:        []any{"application/json", "application/*", "201"},
+		Level:       checker.WARN,
+		Operation:   "POST",
+		Path:        "/orders",
+		Source:      load.NewSource(mediaTypeMatchBase),
+		OperationId: "createOrder",
+	}, errs[0])
+	require.Equal(t, "the media type 'application/json' was broadened to 'application/*' for the response with the status '201'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// CL: adding a parameter to a response media type
+func TestResponseMediaTypeNarrowed(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, mediaTypeMatchBase, singleCheckConfig(checker.ResponseMediaTypeUpdatedCheck), func(s *load.SpecInfo) {
+		renameMediaType(s.Spec.Paths.Value("/orders").Post.Responses.Value("201").Value.Content, "application/json", "application/json; charset=utf-8")
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ResponseMediaTypeNarrowedId, errs[0].GetId())
+	require.Equal(t, checker.INFO, errs[0].GetLevel())
+}
+
+// BC: replacing a response media type with a vendor media type is breaking
+func TestResponseMediaTypeChanged(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, mediaTypeMatchBase, singleCheckConfig(checker.ResponseMediaTypeUpdatedCheck), func(s *load.SpecInfo) {
+		renameMediaType(s.Spec.Paths.Value("/orders").Post.Responses.Value("201").Value.Content, "application/json", "application/vnd.acme.v2+json")
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ResponseMediaTypeChangedId, errs[0].GetId())
+	require.Equal(t, checker.ERR, errs[0].GetLevel())
+}
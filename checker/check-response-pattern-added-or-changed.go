@@ -6,11 +6,16 @@ import (
 )
 
 const (
-	ResponsePropertyPatternAddedId   = "response-property-pattern-added"
-	ResponsePropertyPatternChangedId = "response-property-pattern-changed"
-	ResponsePropertyPatternRemovedId = "response-property-pattern-removed"
+	ResponsePropertyPatternAddedId        = "response-property-pattern-added"
+	ResponsePropertyPatternChangedId      = "response-property-pattern-changed"
+	ResponsePropertyPatternRemovedId      = "response-property-pattern-removed"
+	ResponsePropertyPatternBroadenedId    = "response-property-pattern-broadened"
+	ResponsePropertyPatternNarrowedId     = "response-property-pattern-narrowed"
+	ResponsePropertyPatternIncomparableId = "response-property-pattern-incomparable"
 )
 
+// ResponsePatternAddedOrChangedCheck detects patterns that were added to, removed from or changed in response properties
+// Broadening a pattern breaks clients that rely on the values that they receive matching the base pattern, but narrowing it is safe
 func ResponsePatternAddedOrChangedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
 	result := make(Changes, 0)
 	if diffReport.PathsDiff == nil {
@@ -50,6 +55,8 @@ func ResponsePatternAddedOrChangedCheck(diffReport *diff.Diff, operationsSources
 							propName := propertyFullName(propertyPath, propertyName)
 
 							id := ResponsePropertyPatternChangedId
+							level := INFO
+							comment := ""
 							args := []any{propName, patternDiff.From, patternDiff.To, responseStatus}
 							if patternDiff.To == "" || patternDiff.To == nil {
 								id = ResponsePropertyPatternRemovedId
@@ -57,17 +64,25 @@ func ResponsePatternAddedOrChangedCheck(diffReport *diff.Diff, operationsSources
 							} else if patternDiff.From == "" || patternDiff.From == nil {
 								id = ResponsePropertyPatternAddedId
 								args = []any{propName, patternDiff.To, responseStatus}
+							} else if changeId, changeLevel, changeComment := getPatternChange(patternDiff.From, patternDiff.To, patternChangeIds{
+								broadened:    ResponsePropertyPatternBroadenedId,
+								narrowed:     ResponsePropertyPatternNarrowedId,
+								changed:      ResponsePropertyPatternChangedId,
+								incomparable: ResponsePropertyPatternIncomparableId,
+							}, ERR, INFO); changeId != "" {
+								id, level, comment = changeId, changeLevel, changeComment
 							}
 
 							result = append(result, ApiChange{
 								Id:          id,
-								Level:       INFO,
+								Level:       level,
 								Args:        args,
+								Comment:     comment,
 								Operation:   operation,
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, propertyDiff.Revision, responseDiff.Revision, operationItem.Revision))
 						})
 				}
 			}
@@ -9,7 +9,7 @@ import (
 	"github.com/tufin/oasdiff/load"
 )
 
-// CL: changing response property pattern
+// BC: broadening response property pattern is breaking
 func TestResponsePropertyPatternChanged(t *testing.T) {
 	s1, err := open("../data/checker/response_pattern_added_or_changed_base.yaml")
 	require.NoError(t, err)
@@ -24,9 +24,9 @@ func TestResponsePropertyPatternChanged(t *testing.T) {
 	require.Len(t, errs, 1)
 	require.Equal(t, checker.ApiChange{
 
-		Id:          checker.ResponsePropertyPatternChangedId,
+		Id:          checker.ResponsePropertyPatternBroadenedId,
 		Args:        []any{"data/created", "^[a-z]+$", "^(?:([a-z]+-)*([a-z]+)?)$", "200"},
-		Level:       checker.INFO,
+		Level:       checker.ERR,
 		Operation:   "POST",
 		Path:        "/api/v1.0/groups",
 		Source:      load.NewSource("../data/checker/response_pattern_added_or_changed_revision.yaml"),
@@ -83,3 +83,24 @@ func TestResponsePropertyPatternRemoved(t *testing.T) {
 		OperationId: "createOneGroup",
 	}, errs[0])
 }
+
+// BC: changing response property pattern to one that matches other values is breaking
+func TestResponsePropertyPatternIncomparable(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, "../data/checker/response_pattern_added_or_changed_base.yaml", singleCheckConfig(checker.ResponsePatternAddedOrChangedCheck), func(s *load.SpecInfo) {
+		s.Spec.Components.Schemas["GroupView"].Value.Properties["data"].Value.Properties["created"].Value.Pattern = "^[0-9]+$"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ResponsePropertyPatternIncomparableId, errs[0].GetId())
+	require.Equal(t, checker.ERR, errs[0].GetLevel())
+	require.Equal(t, "Each of the patterns matches values that the other pattern doesn't match", errs[0].GetComment(checker.NewDefaultLocalizer()))
+}
+
+// CL: narrowing response property pattern
+func TestResponsePropertyPatternNarrowed(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, "../data/checker/response_pattern_added_or_changed_base.yaml", singleCheckConfig(checker.ResponsePatternAddedOrChangedCheck), func(s *load.SpecInfo) {
+		s.Spec.Components.Schemas["GroupView"].Value.Properties["data"].Value.Properties["created"].Value.Pattern = "^[a-c]+$"
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ResponsePropertyPatternNarrowedId, errs[0].GetId())
+	require.Equal(t, checker.INFO, errs[0].GetLevel())
+}
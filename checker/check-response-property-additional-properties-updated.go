@@ -0,0 +1,87 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	ResponseBodyAdditionalPropertiesAllowedId     = "response-body-additional-properties-allowed"
+	ResponseBodyAdditionalPropertiesWidenedId     = "response-body-additional-properties-widened"
+	ResponsePropertyAdditionalPropertiesAllowedId = "response-property-additional-properties-allowed"
+	ResponsePropertyAdditionalPropertiesWidenedId = "response-property-additional-properties-widened"
+)
+
+// ResponsePropertyAdditionalPropertiesUpdatedCheck detects responses that may contain additional properties that they couldn't contain before
+// Allowing additional properties may break clients that reject extra fields, and removing the schema of additional properties may break clients that rely on it
+// Changes inside the schema of additional properties are detected by the property checks, like changes to any other property
+func ResponsePropertyAdditionalPropertiesUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			source := (*operationsSources)[operationItem.Revision]
+
+			if operationItem.ResponsesDiff == nil {
+				continue
+			}
+
+			for responseStatus, responseDiff := range operationItem.ResponsesDiff.Modified {
+				if responseDiff.ContentDiff == nil ||
+					responseDiff.ContentDiff.MediaTypeModified == nil {
+					continue
+				}
+
+				newChange := func(id string, args []any, schemaDiff *diff.SchemaDiff) Change {
+					return ApiChange{
+						Id:          id,
+						Level:       WARN,
+						Args:        args,
+						Operation:   operation,
+						OperationId: operationItem.Revision.OperationID,
+						Path:        path,
+						Source:      load.NewSource(source),
+					}.withLocation(config, schemaDiff.Revision, responseDiff.Revision, operationItem.Revision)
+				}
+
+				modifiedMediaTypes := responseDiff.ContentDiff.MediaTypeModified
+				for _, mediaTypeDiff := range modifiedMediaTypes {
+					if mediaTypeDiff.SchemaDiff == nil {
+						continue
+					}
+
+					if from, to, changed := getAdditionalPropertiesStates(mediaTypeDiff.SchemaDiff); changed {
+						if from == additionalPropertiesNone {
+							result = append(result, newChange(ResponseBodyAdditionalPropertiesAllowedId, []any{responseStatus}, mediaTypeDiff.SchemaDiff))
+						} else if from == additionalPropertiesSchema && to == additionalPropertiesAny {
+							result = append(result, newChange(ResponseBodyAdditionalPropertiesWidenedId, []any{responseStatus}, mediaTypeDiff.SchemaDiff))
+						}
+					}
+
+					CheckModifiedPropertiesDiff(
+						mediaTypeDiff.SchemaDiff,
+						func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+							from, to, changed := getAdditionalPropertiesStates(propertyDiff)
+							if !changed {
+								return
+							}
+
+							propName := propertyFullName(propertyPath, propertyName)
+
+							if from == additionalPropertiesNone {
+								result = append(result, newChange(ResponsePropertyAdditionalPropertiesAllowedId, []any{propName, responseStatus}, propertyDiff))
+							} else if from == additionalPropertiesSchema && to == additionalPropertiesAny {
+								result = append(result, newChange(ResponsePropertyAdditionalPropertiesWidenedId, []any{propName, responseStatus}, propertyDiff))
+							}
+						})
+				}
+			}
+		}
+	}
+	return result
+}
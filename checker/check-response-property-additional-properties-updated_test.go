@@ -0,0 +1,89 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+func getResponseBodySchema(s *load.SpecInfo) *openapi3.Schema {
+	return s.Spec.Paths.Value("/api/v1.0/groups").Post.Responses.Value("200").Value.Content["application/json"].Schema.Value
+}
+
+// BC: allowing additional properties in the response body is breaking
+func TestResponseBodyAdditionalPropertiesAllowed(t *testing.T) {
+	s1, err := open(additionalPropertiesBase)
+	require.NoError(t, err)
+	s2, err := open(additionalPropertiesBase)
+	require.NoError(t, err)
+
+	getResponseBodySchema(s2).AdditionalProperties = openapi3.AdditionalProperties{}
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.ResponsePropertyAdditionalPropertiesUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.ResponseBodyAdditionalPropertiesAllowedId,
+		Args:        []any{"200"},
+		Level:       checker.WARN,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(additionalPropertiesBase),
+		OperationId: "createOneGroup",
+	}, errs[0])
+	require.Equal(t, "the response's body now allows additional properties for the status '200'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: removing the schema of additional properties in a response property is breaking
+func TestResponsePropertyAdditionalPropertiesWidened(t *testing.T) {
+	s1, err := open(additionalPropertiesBase)
+	require.NoError(t, err)
+	s2, err := open(additionalPropertiesBase)
+	require.NoError(t, err)
+
+	getResponseBodySchema(s2).Properties["labels"].Value.AdditionalProperties = openapi3.AdditionalProperties{Has: openapi3.BoolPtr(true)}
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.ResponsePropertyAdditionalPropertiesUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.ResponsePropertyAdditionalPropertiesWidenedId,
+		Args:        []any{"labels", "200"},
+		Level:       checker.WARN,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(additionalPropertiesBase),
+		OperationId: "createOneGroup",
+	}, errs[0])
+}
+
+// BC: increasing the max length of additional properties in a response property is breaking
+func TestResponsePropertyAdditionalPropertiesMaxLengthIncreased(t *testing.T) {
+	s1, err := open(additionalPropertiesBase)
+	require.NoError(t, err)
+	s2, err := open(additionalPropertiesBase)
+	require.NoError(t, err)
+
+	maxLength := uint64(20)
+	getResponseBodySchema(s2).Properties["labels"].Value.AdditionalProperties.Schema.Value.MaxLength = &maxLength
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.ResponsePropertyMaxLengthIncreasedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.ResponsePropertyMaxLengthIncreasedId,
+		Args:        []any{"labels/additionalProperties/", uint64(10), uint64(20), "200"},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(additionalPropertiesBase),
+		OperationId: "createOneGroup",
+	}, errs[0])
+}
@@ -49,7 +49,7 @@ func ResponsePropertyAllOfUpdatedCheck(diffReport *diff.Diff, operationsSources
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      load.NewSource(source),
-						})
+						}.withLocation(config, responsesDiff.Revision, operationItem.Revision))
 					}
 
 					if mediaTypeDiff.SchemaDiff.AllOfDiff != nil && len(mediaTypeDiff.SchemaDiff.AllOfDiff.Deleted) > 0 {
@@ -61,7 +61,7 @@ func ResponsePropertyAllOfUpdatedCheck(diffReport *diff.Diff, operationsSources
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      load.NewSource(source),
-						})
+						}.withLocation(config, responsesDiff.Revision, operationItem.Revision))
 					}
 
 					CheckModifiedPropertiesDiff(
@@ -81,7 +81,7 @@ func ResponsePropertyAllOfUpdatedCheck(diffReport *diff.Diff, operationsSources
 									OperationId: operationItem.Revision.OperationID,
 									Path:        path,
 									Source:      load.NewSource(source),
-								})
+								}.withLocation(config, propertyDiff.Revision, responsesDiff.Revision, operationItem.Revision))
 							}
 
 							if len(propertyDiff.AllOfDiff.Deleted) > 0 {
@@ -94,7 +94,7 @@ func ResponsePropertyAllOfUpdatedCheck(diffReport *diff.Diff, operationsSources
 									OperationId: operationItem.Revision.OperationID,
 									Path:        path,
 									Source:      load.NewSource(source),
-								})
+								}.withLocation(config, propertyDiff.Revision, responsesDiff.Revision, operationItem.Revision))
 							}
 						})
 				}
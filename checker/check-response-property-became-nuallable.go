@@ -46,7 +46,7 @@ func ResponsePropertyBecameNullableCheck(diffReport *diff.Diff, operationsSource
 							OperationId: operationItem.Revision.OperationID,
 							Path:        path,
 							Source:      load.NewSource(source),
-						})
+						}.withLocation(config, responseDiff.Revision, operationItem.Revision))
 					}
 
 					CheckModifiedPropertiesDiff(
@@ -68,7 +68,7 @@ func ResponsePropertyBecameNullableCheck(diffReport *diff.Diff, operationsSource
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, propertyDiff.Revision, responseDiff.Revision, operationItem.Revision))
 						})
 				}
 			}
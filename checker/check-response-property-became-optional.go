@@ -59,7 +59,7 @@ func ResponsePropertyBecameOptionalCheck(diffReport *diff.Diff, operationsSource
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, responseDiff.Revision, operationItem.Revision))
 						}
 					}
 
@@ -94,7 +94,7 @@ func ResponsePropertyBecameOptionalCheck(diffReport *diff.Diff, operationsSource
 									OperationId: operationItem.Revision.OperationID,
 									Path:        path,
 									Source:      load.NewSource(source),
-								})
+								}.withLocation(config, propertyDiff.Revision, responseDiff.Revision, operationItem.Revision))
 							}
 						})
 				}
@@ -57,7 +57,7 @@ func ResponsePropertyBecameRequiredCheck(diffReport *diff.Diff, operationsSource
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, responseDiff.Revision, operationItem.Revision))
 						}
 					}
 
@@ -89,7 +89,7 @@ func ResponsePropertyBecameRequiredCheck(diffReport *diff.Diff, operationsSource
 									OperationId: operationItem.Revision.OperationID,
 									Path:        path,
 									Source:      load.NewSource(source),
-								})
+								}.withLocation(config, propertyDiff.Revision, responseDiff.Revision, operationItem.Revision))
 							}
 						})
 				}
@@ -0,0 +1,81 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	ResponseBodyExclusiveMinUnsetId     = "response-body-exclusive-min-unset"
+	ResponseBodyExclusiveMaxUnsetId     = "response-body-exclusive-max-unset"
+	ResponsePropertyExclusiveMinUnsetId = "response-property-exclusive-min-unset"
+	ResponsePropertyExclusiveMaxUnsetId = "response-property-exclusive-max-unset"
+)
+
+// ResponsePropertyExclusiveBoundsUnsetCheck detects responses whose min or max is no longer exclusive, so the bound itself may now be returned
+func ResponsePropertyExclusiveBoundsUnsetCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.ResponsesDiff == nil || operationItem.ResponsesDiff.Modified == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+			for responseStatus, responseDiff := range operationItem.ResponsesDiff.Modified {
+				if responseDiff == nil ||
+					responseDiff.ContentDiff == nil ||
+					responseDiff.ContentDiff.MediaTypeModified == nil {
+					continue
+				}
+
+				newChange := func(id string, args []any, element any) Change {
+					return ApiChange{
+						Id:          id,
+						Level:       ERR,
+						Args:        args,
+						Operation:   operation,
+						OperationId: operationItem.Revision.OperationID,
+						Path:        path,
+						Source:      load.NewSource(source),
+					}.withLocation(config, element, responseDiff.Revision, operationItem.Revision)
+				}
+
+				modifiedMediaTypes := responseDiff.ContentDiff.MediaTypeModified
+				for _, mediaTypeDiff := range modifiedMediaTypes {
+					if mediaTypeDiff.SchemaDiff != nil {
+						if isExclusiveMinUnset(mediaTypeDiff.SchemaDiff) {
+							result = append(result, newChange(ResponseBodyExclusiveMinUnsetId, []any{*mediaTypeDiff.SchemaDiff.Revision.Min}, mediaTypeDiff.SchemaDiff.Revision))
+						}
+						if isExclusiveMaxUnset(mediaTypeDiff.SchemaDiff) {
+							result = append(result, newChange(ResponseBodyExclusiveMaxUnsetId, []any{*mediaTypeDiff.SchemaDiff.Revision.Max}, mediaTypeDiff.SchemaDiff.Revision))
+						}
+					}
+
+					CheckModifiedPropertiesDiff(
+						mediaTypeDiff.SchemaDiff,
+						func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+							if propertyDiff.Revision.WriteOnly {
+								return
+							}
+
+							propName := propertyFullName(propertyPath, propertyName)
+
+							if isExclusiveMinUnset(propertyDiff) {
+								result = append(result, newChange(ResponsePropertyExclusiveMinUnsetId, []any{propName, *propertyDiff.Revision.Min, responseStatus}, propertyDiff.Revision))
+							}
+							if isExclusiveMaxUnset(propertyDiff) {
+								result = append(result, newChange(ResponsePropertyExclusiveMaxUnsetId, []any{propName, *propertyDiff.Revision.Max, responseStatus}, propertyDiff.Revision))
+							}
+						})
+				}
+			}
+		}
+	}
+	return result
+}
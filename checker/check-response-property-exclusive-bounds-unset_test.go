@@ -0,0 +1,50 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+// BC: making the min of a response property inclusive is breaking
+func TestResponsePropertyExclusiveMinUnset(t *testing.T) {
+	s1, err := open(schemaConstraintsBase)
+	require.NoError(t, err)
+	s2, err := open(schemaConstraintsBase)
+	require.NoError(t, err)
+
+	getResponseBodySchema(s2).Properties["amount"].Value.ExclusiveMin = false
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.ResponsePropertyExclusiveBoundsUnsetCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.ResponsePropertyExclusiveMinUnsetId,
+		Args:        []any{"amount", float64(0), "200"},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(schemaConstraintsBase),
+		OperationId: "createOneGroup",
+	}, errs[0])
+	require.Equal(t, "the 'amount' response property's min '0.00' is no longer exclusive for the response status '200'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: making the max of a response property exclusive is not breaking
+func TestResponsePropertyExclusiveMaxSet(t *testing.T) {
+	s1, err := open(schemaConstraintsBase)
+	require.NoError(t, err)
+	s2, err := open(schemaConstraintsBase)
+	require.NoError(t, err)
+
+	getResponseBodySchema(s1).Properties["amount"].Value.ExclusiveMax = false
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.ResponsePropertyExclusiveBoundsUnsetCheck), d, osm, checker.INFO)
+	require.Empty(t, errs)
+}
@@ -45,7 +45,7 @@ func ResponsePropertyMaxIncreasedCheck(diffReport *diff.Diff, operationsSources
 									OperationId: operationItem.Revision.OperationID,
 									Path:        path,
 									Source:      load.NewSource(source),
-								})
+								}.withLocation(config, responseDiff.Revision, operationItem.Revision))
 							}
 						}
 					}
@@ -77,7 +77,7 @@ func ResponsePropertyMaxIncreasedCheck(diffReport *diff.Diff, operationsSources
 								OperationId: operationItem.Revision.OperationID,
 								Path:        path,
 								Source:      load.NewSource(source),
-							})
+							}.withLocation(config, propertyDiff.Revision, responseDiff.Revision, operationItem.Revision))
 						})
 				}
 
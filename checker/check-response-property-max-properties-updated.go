@@ -0,0 +1,84 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	ResponseBodyMaxPropertiesIncreasedId     = "response-body-max-properties-increased"
+	ResponseBodyMaxPropertiesUnsetId         = "response-body-max-properties-unset"
+	ResponsePropertyMaxPropertiesIncreasedId = "response-property-max-properties-increased"
+	ResponsePropertyMaxPropertiesUnsetId     = "response-property-max-properties-unset"
+)
+
+// ResponsePropertyMaxPropertiesUpdatedCheck detects responses that may now contain more properties than before
+func ResponsePropertyMaxPropertiesUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.ResponsesDiff == nil || operationItem.ResponsesDiff.Modified == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+			for responseStatus, responseDiff := range operationItem.ResponsesDiff.Modified {
+				if responseDiff == nil ||
+					responseDiff.ContentDiff == nil ||
+					responseDiff.ContentDiff.MediaTypeModified == nil {
+					continue
+				}
+
+				newChange := func(id string, args []any, element any) Change {
+					return ApiChange{
+						Id:          id,
+						Level:       ERR,
+						Args:        args,
+						Operation:   operation,
+						OperationId: operationItem.Revision.OperationID,
+						Path:        path,
+						Source:      load.NewSource(source),
+					}.withLocation(config, element, responseDiff.Revision, operationItem.Revision)
+				}
+
+				modifiedMediaTypes := responseDiff.ContentDiff.MediaTypeModified
+				for _, mediaTypeDiff := range modifiedMediaTypes {
+					if mediaTypeDiff.SchemaDiff != nil && mediaTypeDiff.SchemaDiff.MaxPropsDiff != nil {
+						maxPropsDiff := mediaTypeDiff.SchemaDiff.MaxPropsDiff
+						if maxPropsDiff.From != nil && maxPropsDiff.To == nil {
+							result = append(result, newChange(ResponseBodyMaxPropertiesUnsetId, []any{maxPropsDiff.From}, mediaTypeDiff.SchemaDiff.Revision))
+						} else if IsIncreasedValue(maxPropsDiff) {
+							result = append(result, newChange(ResponseBodyMaxPropertiesIncreasedId, []any{maxPropsDiff.From, maxPropsDiff.To}, mediaTypeDiff.SchemaDiff.Revision))
+						}
+					}
+
+					CheckModifiedPropertiesDiff(
+						mediaTypeDiff.SchemaDiff,
+						func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+							maxPropsDiff := propertyDiff.MaxPropsDiff
+							if maxPropsDiff == nil || maxPropsDiff.From == nil {
+								return
+							}
+							if propertyDiff.Revision.WriteOnly {
+								return
+							}
+
+							propName := propertyFullName(propertyPath, propertyName)
+
+							if maxPropsDiff.To == nil {
+								result = append(result, newChange(ResponsePropertyMaxPropertiesUnsetId, []any{propName, maxPropsDiff.From, responseStatus}, propertyDiff.Revision))
+							} else if IsIncreasedValue(maxPropsDiff) {
+								result = append(result, newChange(ResponsePropertyMaxPropertiesIncreasedId, []any{propName, maxPropsDiff.From, maxPropsDiff.To, responseStatus}, propertyDiff.Revision))
+							}
+						})
+				}
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,77 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	ResponseBodyMinPropertiesDecreasedId     = "response-body-min-properties-decreased"
+	ResponsePropertyMinPropertiesDecreasedId = "response-property-min-properties-decreased"
+)
+
+// ResponsePropertyMinPropertiesDecreasedCheck detects responses that may now contain fewer properties than before
+func ResponsePropertyMinPropertiesDecreasedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.ResponsesDiff == nil || operationItem.ResponsesDiff.Modified == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+			for responseStatus, responseDiff := range operationItem.ResponsesDiff.Modified {
+				if responseDiff == nil ||
+					responseDiff.ContentDiff == nil ||
+					responseDiff.ContentDiff.MediaTypeModified == nil {
+					continue
+				}
+				modifiedMediaTypes := responseDiff.ContentDiff.MediaTypeModified
+				for _, mediaTypeDiff := range modifiedMediaTypes {
+					if mediaTypeDiff.SchemaDiff != nil && mediaTypeDiff.SchemaDiff.MinPropsDiff != nil {
+						minPropsDiff := mediaTypeDiff.SchemaDiff.MinPropsDiff
+						if IsDecreasedValue(minPropsDiff) {
+							result = append(result, ApiChange{
+								Id:          ResponseBodyMinPropertiesDecreasedId,
+								Level:       ERR,
+								Args:        []any{minPropsDiff.From, minPropsDiff.To},
+								Operation:   operation,
+								OperationId: operationItem.Revision.OperationID,
+								Path:        path,
+								Source:      load.NewSource(source),
+							}.withLocation(config, responseDiff.Revision, operationItem.Revision))
+						}
+					}
+
+					CheckModifiedPropertiesDiff(
+						mediaTypeDiff.SchemaDiff,
+						func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+							minPropsDiff := propertyDiff.MinPropsDiff
+							if minPropsDiff == nil || !IsDecreasedValue(minPropsDiff) {
+								return
+							}
+							if propertyDiff.Revision.WriteOnly {
+								return
+							}
+
+							result = append(result, ApiChange{
+								Id:          ResponsePropertyMinPropertiesDecreasedId,
+								Level:       ERR,
+								Args:        []any{propertyFullName(propertyPath, propertyName), minPropsDiff.From, minPropsDiff.To, responseStatus},
+								Operation:   operation,
+								OperationId: operationItem.Revision.OperationID,
+								Path:        path,
+								Source:      load.NewSource(source),
+							}.withLocation(config, propertyDiff.Revision, responseDiff.Revision, operationItem.Revision))
+						})
+				}
+			}
+		}
+	}
+	return result
+}
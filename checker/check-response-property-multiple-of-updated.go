@@ -0,0 +1,85 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	ResponseBodyMultipleOfChangedId     = "response-body-multiple-of-changed"
+	ResponseBodyMultipleOfUnsetId       = "response-body-multiple-of-unset"
+	ResponsePropertyMultipleOfChangedId = "response-property-multiple-of-changed"
+	ResponsePropertyMultipleOfUnsetId   = "response-property-multiple-of-unset"
+)
+
+// ResponsePropertyMultipleOfUpdatedCheck detects changes to the multipleOf of responses that allow values that clients don't expect
+// A changed multipleOf is reported only if the new multipleOf isn't a multiple of the previous one
+func ResponsePropertyMultipleOfUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.ResponsesDiff == nil || operationItem.ResponsesDiff.Modified == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+			for responseStatus, responseDiff := range operationItem.ResponsesDiff.Modified {
+				if responseDiff == nil ||
+					responseDiff.ContentDiff == nil ||
+					responseDiff.ContentDiff.MediaTypeModified == nil {
+					continue
+				}
+
+				newChange := func(id string, args []any, element any) Change {
+					return ApiChange{
+						Id:          id,
+						Level:       ERR,
+						Args:        args,
+						Operation:   operation,
+						OperationId: operationItem.Revision.OperationID,
+						Path:        path,
+						Source:      load.NewSource(source),
+					}.withLocation(config, element, responseDiff.Revision, operationItem.Revision)
+				}
+
+				modifiedMediaTypes := responseDiff.ContentDiff.MediaTypeModified
+				for _, mediaTypeDiff := range modifiedMediaTypes {
+					if mediaTypeDiff.SchemaDiff != nil && mediaTypeDiff.SchemaDiff.MultipleOfDiff != nil {
+						multipleOfDiff := mediaTypeDiff.SchemaDiff.MultipleOfDiff
+						if multipleOfDiff.From != nil && multipleOfDiff.To == nil {
+							result = append(result, newChange(ResponseBodyMultipleOfUnsetId, []any{multipleOfDiff.From}, mediaTypeDiff.SchemaDiff.Revision))
+						} else if multipleOfDiff.From != nil && !isMultipleOf(multipleOfDiff.To, multipleOfDiff.From) {
+							result = append(result, newChange(ResponseBodyMultipleOfChangedId, []any{multipleOfDiff.From, multipleOfDiff.To}, mediaTypeDiff.SchemaDiff.Revision))
+						}
+					}
+
+					CheckModifiedPropertiesDiff(
+						mediaTypeDiff.SchemaDiff,
+						func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+							multipleOfDiff := propertyDiff.MultipleOfDiff
+							if multipleOfDiff == nil || multipleOfDiff.From == nil {
+								return
+							}
+							if propertyDiff.Revision.WriteOnly {
+								return
+							}
+
+							propName := propertyFullName(propertyPath, propertyName)
+
+							if multipleOfDiff.To == nil {
+								result = append(result, newChange(ResponsePropertyMultipleOfUnsetId, []any{propName, multipleOfDiff.From, responseStatus}, propertyDiff.Revision))
+							} else if !isMultipleOf(multipleOfDiff.To, multipleOfDiff.From) {
+								result = append(result, newChange(ResponsePropertyMultipleOfChangedId, []any{propName, multipleOfDiff.From, multipleOfDiff.To, responseStatus}, propertyDiff.Revision))
+							}
+						})
+				}
+			}
+		}
+	}
+	return result
+}
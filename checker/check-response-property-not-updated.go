@@ -0,0 +1,75 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	ResponseBodyNotSchemaRemovedId     = "response-body-not-schema-removed"
+	ResponsePropertyNotSchemaRemovedId = "response-property-not-schema-removed"
+)
+
+// ResponsePropertyNotUpdatedCheck detects 'not' schemas that were removed from responses
+// The response may now contain values that the 'not' schema excluded, which clients may not expect
+func ResponsePropertyNotUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			source := (*operationsSources)[operationItem.Revision]
+
+			if operationItem.ResponsesDiff == nil {
+				continue
+			}
+
+			for responseStatus, responseDiff := range operationItem.ResponsesDiff.Modified {
+				if responseDiff.ContentDiff == nil ||
+					responseDiff.ContentDiff.MediaTypeModified == nil {
+					continue
+				}
+
+				newChange := func(id string, args []any, schemaDiff *diff.SchemaDiff) Change {
+					return ApiChange{
+						Id:          id,
+						Level:       WARN,
+						Args:        args,
+						Operation:   operation,
+						OperationId: operationItem.Revision.OperationID,
+						Path:        path,
+						Source:      load.NewSource(source),
+					}.withLocation(config, schemaDiff.Revision, responseDiff.Revision, operationItem.Revision)
+				}
+
+				modifiedMediaTypes := responseDiff.ContentDiff.MediaTypeModified
+				for _, mediaTypeDiff := range modifiedMediaTypes {
+					if mediaTypeDiff.SchemaDiff == nil {
+						continue
+					}
+
+					if isNotSchemaRemoved(mediaTypeDiff.SchemaDiff) {
+						result = append(result, newChange(ResponseBodyNotSchemaRemovedId, []any{responseStatus}, mediaTypeDiff.SchemaDiff))
+					}
+
+					CheckModifiedPropertiesDiff(
+						mediaTypeDiff.SchemaDiff,
+						func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+							if isNotSchemaRemoved(propertyDiff) {
+								result = append(result, newChange(ResponsePropertyNotSchemaRemovedId, []any{propertyFullName(propertyPath, propertyName), responseStatus}, propertyDiff))
+							}
+						})
+				}
+			}
+		}
+	}
+	return result
+}
+
+func isNotSchemaRemoved(schemaDiff *diff.SchemaDiff) bool {
+	return schemaDiff.NotDiff != nil && schemaDiff.NotDiff.SchemaDeleted
+}
@@ -0,0 +1,76 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+// CL: removing the 'not' schema from the response body
+func TestResponseBodyNotSchemaRemoved(t *testing.T) {
+	s1, err := open(notSchemaBase)
+	require.NoError(t, err)
+	s2, err := open(notSchemaBase)
+	require.NoError(t, err)
+
+	getResponseBodySchema(s2).Not = nil
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.ResponsePropertyNotUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.ResponseBodyNotSchemaRemovedId,
+		Args:        []any{"200"},
+		Level:       checker.WARN,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(notSchemaBase),
+		OperationId: "createOneGroup",
+	}, errs[0])
+	require.Equal(t, "removed the 'not' schema from the response's body for the status '200'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// CL: removing the 'not' schema from a response property
+func TestResponsePropertyNotSchemaRemoved(t *testing.T) {
+	s1, err := open(notSchemaBase)
+	require.NoError(t, err)
+	s2, err := open(notSchemaBase)
+	require.NoError(t, err)
+
+	getResponseBodySchema(s2).Properties["id"].Value.Not = nil
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.ResponsePropertyNotUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.ResponsePropertyNotSchemaRemovedId,
+		Args:        []any{"id", "200"},
+		Level:       checker.WARN,
+		Operation:   "POST",
+		Path:        "/api/v1.0/groups",
+		Source:      load.NewSource(notSchemaBase),
+		OperationId: "createOneGroup",
+	}, errs[0])
+	require.Equal(t, "removed the 'not' schema from the response property 'id' for the status '200'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// property checks skip the properties under 'not' schemas, since a constraint of a 'not' schema has the opposite meaning
+func TestResponsePropertyNotSchemaNoRecursion(t *testing.T) {
+	s1, err := open(notSchemaBase)
+	require.NoError(t, err)
+	s2, err := open(notSchemaBase)
+	require.NoError(t, err)
+
+	maxLength := uint64(5)
+	getResponseBodySchema(s2).Properties["id"].Value.Not.Value.MaxLength = &maxLength
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.ResponsePropertyMaxLengthIncreasedCheck), d, osm, checker.INFO)
+	require.Empty(t, errs)
+}
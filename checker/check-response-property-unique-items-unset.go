@@ -0,0 +1,72 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	ResponseBodyUniqueItemsUnsetId     = "response-body-unique-items-unset"
+	ResponsePropertyUniqueItemsUnsetId = "response-property-unique-items-unset"
+)
+
+// ResponsePropertyUniqueItemsUnsetCheck detects responses that may now contain duplicate items
+func ResponsePropertyUniqueItemsUnsetCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.ResponsesDiff == nil || operationItem.ResponsesDiff.Modified == nil {
+				continue
+			}
+			source := (*operationsSources)[operationItem.Revision]
+			for responseStatus, responseDiff := range operationItem.ResponsesDiff.Modified {
+				if responseDiff == nil ||
+					responseDiff.ContentDiff == nil ||
+					responseDiff.ContentDiff.MediaTypeModified == nil {
+					continue
+				}
+				modifiedMediaTypes := responseDiff.ContentDiff.MediaTypeModified
+				for _, mediaTypeDiff := range modifiedMediaTypes {
+					if mediaTypeDiff.SchemaDiff != nil && isUnsetValue(mediaTypeDiff.SchemaDiff.UniqueItemsDiff) {
+						result = append(result, ApiChange{
+							Id:          ResponseBodyUniqueItemsUnsetId,
+							Level:       ERR,
+							Operation:   operation,
+							OperationId: operationItem.Revision.OperationID,
+							Path:        path,
+							Source:      load.NewSource(source),
+						}.withLocation(config, responseDiff.Revision, operationItem.Revision))
+					}
+
+					CheckModifiedPropertiesDiff(
+						mediaTypeDiff.SchemaDiff,
+						func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+							if !isUnsetValue(propertyDiff.UniqueItemsDiff) {
+								return
+							}
+							if propertyDiff.Revision.WriteOnly {
+								return
+							}
+
+							result = append(result, ApiChange{
+								Id:          ResponsePropertyUniqueItemsUnsetId,
+								Level:       ERR,
+								Args:        []any{propertyFullName(propertyPath, propertyName), responseStatus},
+								Operation:   operation,
+								OperationId: operationItem.Revision.OperationID,
+								Path:        path,
+								Source:      load.NewSource(source),
+							}.withLocation(config, propertyDiff.Revision, responseDiff.Revision, operationItem.Revision))
+						})
+				}
+			}
+		}
+	}
+	return result
+}
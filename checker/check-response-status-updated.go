@@ -1,7 +1,6 @@
 package checker
 
 import (
-	"strconv"
 	"strings"
 
 	"github.com/tufin/oasdiff/diff"
@@ -13,6 +12,8 @@ const (
 	ResponseNonSuccessStatusRemovedId = "response-non-success-status-removed"
 	ResponseSuccessStatusAddedId      = "response-success-status-added"
 	ResponseNonSuccessStatusAddedId   = "response-non-success-status-added"
+	ResponseSuccessStatusCoveredId    = "response-success-status-covered"
+	ResponseNonSuccessStatusCoveredId = "response-non-success-status-covered"
 )
 
 func ResponseSuccessStatusUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
@@ -31,6 +32,8 @@ func ResponseNonSuccessStatusUpdatedCheck(diffReport *diff.Diff, operationsSourc
 	return responseStatusUpdated(diffReport, operationsSources, config, notSuccess, ResponseNonSuccessStatusRemovedId, INFO)
 }
 
+// responseStatusUpdated detects response statuses that were removed or added, including status ranges like 4XX and the default response
+// A status that is no longer declared, but is covered by a range or by the default response, is still a documented outcome, so it isn't reported as removed
 func responseStatusUpdated(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config, filter func(int) bool, id string, defaultLevel Level) Changes {
 	result := make(Changes, 0)
 	if diffReport.PathsDiff == nil {
@@ -49,8 +52,8 @@ func responseStatusUpdated(diffReport *diff.Diff, operationsSources *diff.Operat
 			}
 			source := (*operationsSources)[operationItem.Revision]
 			for _, responseStatus := range operationItem.ResponsesDiff.Deleted {
-				status, err := strconv.Atoi(responseStatus)
-				if err != nil {
+				status, ok := diff.GetResponseStatusCode(responseStatus)
+				if !ok {
 					continue
 				}
 
@@ -63,14 +66,14 @@ func responseStatusUpdated(diffReport *diff.Diff, operationsSources *diff.Operat
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      load.NewSource(source),
-					})
+					}.withLocation(config, operationResponse(operationItem.Base, responseStatus), operationItem.Base))
 				}
 			}
 
 			for _, responseStatus := range operationItem.ResponsesDiff.Added {
 				addedId := strings.Replace(id, "removed", "added", 1)
-				status, err := strconv.Atoi(responseStatus)
-				if err != nil {
+				status, ok := diff.GetResponseStatusCode(responseStatus)
+				if !ok {
 					continue
 				}
 
@@ -83,7 +86,30 @@ func responseStatusUpdated(diffReport *diff.Diff, operationsSources *diff.Operat
 						OperationId: operationItem.Revision.OperationID,
 						Path:        path,
 						Source:      load.NewSource(source),
-					})
+					}.withLocation(config, operationResponse(operationItem.Revision, responseStatus), operationItem.Revision))
+				}
+			}
+
+			for responseStatus, responseDiff := range operationItem.ResponsesDiff.Modified {
+				if responseDiff.StatusDiff == nil {
+					continue
+				}
+				coveredId := strings.Replace(id, "removed", "covered", 1)
+				status, ok := diff.GetResponseStatusCode(responseStatus)
+				if !ok {
+					continue
+				}
+
+				if filter(status) {
+					result = append(result, ApiChange{
+						Id:          coveredId,
+						Level:       config.getLogLevel(coveredId, INFO),
+						Args:        []any{responseStatus, responseDiff.StatusDiff.To},
+						Operation:   operation,
+						OperationId: operationItem.Revision.OperationID,
+						Path:        path,
+						Source:      load.NewSource(source),
+					}.withLocation(config, responseDiff.Revision, operationItem.Revision))
 				}
 			}
 		}
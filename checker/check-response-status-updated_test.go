@@ -3,6 +3,7 @@ package checker_test
 import (
 	"testing"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/stretchr/testify/require"
 	"github.com/tufin/oasdiff/checker"
 	"github.com/tufin/oasdiff/diff"
@@ -108,3 +109,130 @@ func TestResponseSuccessStatusRemoved(t *testing.T) {
 		OperationId: "createOneGroup",
 	}, errs[0])
 }
+
+// BC: removing a success status is reported at the location of the response in the base spec
+func TestResponseSuccessStatusRemoved_Location(t *testing.T) {
+	s1, err := open("../data/checker/response_status_base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/checker/response_status_base.yaml")
+	require.NoError(t, err)
+
+	delete(s2.Spec.Paths.Value("/api/v1.0/groups").Post.Responses.Map(), "200")
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	config := singleCheckConfig(checker.ResponseSuccessStatusUpdatedCheck)
+	config.Locations = load.GetLocations(s1, s2)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(config, d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, "../data/checker/response_status_base.yaml", errs[0].GetSourceFile())
+	require.Equal(t, 18, errs[0].GetSourceLine())
+	require.Equal(t, 23, errs[0].GetSourceLineEnd())
+	require.Equal(t, 8, errs[0].GetSourceColumn())
+}
+
+// replaceResponseStatus replaces a response status, keeping the response object
+func replaceResponseStatus(responses *openapi3.Responses, from, to string) {
+	responses.Set(to, responses.Value(from))
+	delete(responses.Map(), from)
+}
+
+// CL: replacing a success response status with a status range
+func TestResponseSuccessStatusCoveredByRange(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, "../data/checker/response_status_base.yaml", singleCheckConfig(checker.ResponseSuccessStatusUpdatedCheck), func(s *load.SpecInfo) {
+		replaceResponseStatus(s.Spec.Paths.Value("/api/v1.0/groups").Post.Responses, "200", "2XX")
+	})
+	require.Len(t, errs, 2)
+	require.ElementsMatch(t, []checker.Change{
+		checker.ApiChange{
+			Id:          checker.ResponseSuccessStatusCoveredId,
+			Args:        []any{"200", "2XX"},
+			Level:       checker.INFO,
+			Operation:   "POST",
+			Path:        "/api/v1.0/groups",
+			Source:      load.NewSource("../data/checker/response_status_base.yaml"),
+			OperationId: "createOneGroup",
+		},
+		checker.ApiChange{
+			Id:          checker.ResponseSuccessStatusAddedId,
+			Args:        []any{"2XX"},
+			Level:       checker.INFO,
+			Operation:   "POST",
+			Path:        "/api/v1.0/groups",
+			Source:      load.NewSource("../data/checker/response_status_base.yaml"),
+			OperationId: "createOneGroup",
+		},
+	}, []checker.Change(errs))
+	require.Equal(t, "the success response with the status '200' was removed, but the status is still covered by the response '2XX'", errs[1].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// CL: replacing a non-success response status with the default response
+func TestResponseNonSuccessStatusCoveredByDefault(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, "../data/checker/response_status_base.yaml", singleCheckConfig(checker.ResponseNonSuccessStatusUpdatedCheck), func(s *load.SpecInfo) {
+		replaceResponseStatus(s.Spec.Paths.Value("/api/v1.0/groups").Post.Responses, "409", "default")
+	})
+	require.Len(t, errs, 2)
+	require.Equal(t, checker.ResponseNonSuccessStatusAddedId, errs[0].GetId())
+	require.Equal(t, checker.ResponseNonSuccessStatusCoveredId, errs[1].GetId())
+	require.Equal(t, []any{"409", "default"}, errs[1].(checker.ApiChange).Args)
+}
+
+// CL: replacing a non-success response status with a status range
+func TestResponseNonSuccessStatusCoveredByRange(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, "../data/checker/response_status_base.yaml", singleCheckConfig(checker.ResponseNonSuccessStatusUpdatedCheck), func(s *load.SpecInfo) {
+		responses := s.Spec.Paths.Value("/api/v1.0/groups").Post.Responses
+		responses.Set("default", responses.Value("409"))
+		replaceResponseStatus(responses, "409", "4XX")
+	})
+	require.Len(t, errs, 3)
+	require.Equal(t, checker.ResponseNonSuccessStatusCoveredId, errs[2].GetId())
+	require.Equal(t, []any{"409", "4XX"}, errs[2].(checker.ApiChange).Args)
+}
+
+// CL: replacing a success response status with the default response
+func TestResponseSuccessStatusCoveredByDefault(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, "../data/checker/response_status_base.yaml", singleCheckConfig(checker.ResponseSuccessStatusUpdatedCheck), func(s *load.SpecInfo) {
+		replaceResponseStatus(s.Spec.Paths.Value("/api/v1.0/groups").Post.Responses, "200", "default")
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ResponseSuccessStatusCoveredId, errs[0].GetId())
+	require.Equal(t, []any{"200", "default"}, errs[0].(checker.ApiChange).Args)
+	require.Equal(t, checker.INFO, errs[0].GetLevel())
+}
+
+// BC: removing a success status range is breaking
+func TestResponseSuccessStatusRangeRemoved(t *testing.T) {
+	s1, err := open("../data/checker/response_status_base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/checker/response_status_base.yaml")
+	require.NoError(t, err)
+
+	replaceResponseStatus(s1.Spec.Paths.Value("/api/v1.0/groups").Post.Responses, "200", "2XX")
+	replaceResponseStatus(s2.Spec.Paths.Value("/api/v1.0/groups").Post.Responses, "200", "201")
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(singleCheckConfig(checker.ResponseSuccessStatusUpdatedCheck), d, osm)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ResponseSuccessStatusRemovedId, errs[0].GetId())
+	require.Equal(t, []any{"2XX"}, errs[0].(checker.ApiChange).Args)
+}
+
+// BC: removing the media type of a response status that was replaced by a status range is breaking
+func TestResponseStatusCoveredByRangeMediaTypeRemoved(t *testing.T) {
+	s1, err := open("../data/checker/response_status_base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/checker/response_status_base.yaml")
+	require.NoError(t, err)
+
+	responses := s2.Spec.Paths.Value("/api/v1.0/groups").Post.Responses
+	responses.Set("4XX", &openapi3.ResponseRef{Value: openapi3.NewResponse().WithDescription("Client error")})
+	delete(responses.Map(), "409")
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(singleCheckConfig(checker.ResponseMediaTypeUpdatedCheck), d, osm)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ResponseMediaTypeRemovedId, errs[0].GetId())
+	require.Equal(t, []any{"application/json", "409"}, errs[0].(checker.ApiChange).Args)
+}
@@ -0,0 +1,33 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+)
+
+const (
+	WebhookRequestRequiredPropertyRemovedId = "webhook-request-required-property-removed"
+	WebhookRequestOptionalPropertyRemovedId = "webhook-request-optional-property-removed"
+	WebhookRequestNewRequiredPropertyId     = "webhook-request-new-required-property"
+	WebhookRequestNewOptionalPropertyId     = "webhook-request-new-optional-property"
+	WebhookRequestPropertyTypeChangedId     = "webhook-request-property-type-changed"
+)
+
+// WebhookRequestPropertyUpdatedCheck detects changes to the properties of webhook requests
+// The API sends webhook requests, so they are checked like callback requests
+func WebhookRequestPropertyUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+
+	ids := sentRequestPropertyIds{
+		requiredPropertyRemoved: WebhookRequestRequiredPropertyRemovedId,
+		optionalPropertyRemoved: WebhookRequestOptionalPropertyRemovedId,
+		newRequiredProperty:     WebhookRequestNewRequiredPropertyId,
+		newOptionalProperty:     WebhookRequestNewOptionalPropertyId,
+		propertyTypeChanged:     WebhookRequestPropertyTypeChangedId,
+	}
+
+	forEachModifiedWebhookOperation(diffReport, operationsSources, func(webhookName, operation string, operationItem *diff.MethodDiff, source string) {
+		result = append(result, sentRequestPropertyUpdated(operationItem, ids, newWebhookChange(config, webhookName, operation, operationItem, source))...)
+	})
+
+	return result
+}
@@ -0,0 +1,31 @@
+package checker
+
+import (
+	"github.com/tufin/oasdiff/diff"
+)
+
+const (
+	WebhookResponseSuccessStatusRemovedId   = "webhook-response-success-status-removed"
+	WebhookResponseSuccessStatusAddedId     = "webhook-response-success-status-added"
+	WebhookResponseNewRequiredPropertyId    = "webhook-response-new-required-property"
+	WebhookResponsePropertyBecameRequiredId = "webhook-response-property-became-required"
+)
+
+// WebhookResponseUpdatedCheck detects tightened requirements on the responses to webhook requests
+// The consumers that receive the webhook requests send the responses, so they are checked like callback responses
+func WebhookResponseUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+
+	ids := sentResponseIds{
+		successStatusRemoved:   WebhookResponseSuccessStatusRemovedId,
+		successStatusAdded:     WebhookResponseSuccessStatusAddedId,
+		newRequiredProperty:    WebhookResponseNewRequiredPropertyId,
+		propertyBecameRequired: WebhookResponsePropertyBecameRequiredId,
+	}
+
+	forEachModifiedWebhookOperation(diffReport, operationsSources, func(webhookName, operation string, operationItem *diff.MethodDiff, source string) {
+		result = append(result, sentResponseUpdated(operationItem, ids, newWebhookChange(config, webhookName, operation, operationItem, source))...)
+	})
+
+	return result
+}
@@ -0,0 +1,97 @@
+package checker
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	WebhookRemovedId          = "webhook-removed"
+	WebhookAddedId            = "webhook-added"
+	WebhookOperationRemovedId = "webhook-operation-removed"
+	WebhookOperationAddedId   = "webhook-operation-added"
+)
+
+// WebhookUpdatedCheck detects webhooks, and operations in webhooks, that were added or removed
+// Like in callbacks, the roles are reversed: the API sends the webhook requests and the API consumers receive them
+// Webhook changes are reported with the webhook name as the path
+func WebhookUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.WebhooksDiff == nil {
+		return result
+	}
+
+	newChange := func(id string, level Level, webhookName, operation string, operationItem *openapi3.Operation) Change {
+		return ApiChange{
+			Id:          id,
+			Level:       level,
+			Args:        []any{webhookName},
+			Operation:   operation,
+			OperationId: operationItem.OperationID,
+			Path:        webhookName,
+			Source:      load.NewSource((*operationsSources)[operationItem]),
+		}.withLocation(config, operationItem)
+	}
+
+	webhooksDiff := diffReport.WebhooksDiff
+
+	for _, webhookName := range webhooksDiff.Deleted {
+		for operation, operationItem := range webhooksDiff.Base.Value(webhookName).Operations() {
+			result = append(result, newChange(WebhookRemovedId, ERR, webhookName, operation, operationItem))
+		}
+	}
+
+	for _, webhookName := range webhooksDiff.Added {
+		for operation, operationItem := range webhooksDiff.Revision.Value(webhookName).Operations() {
+			result = append(result, newChange(WebhookAddedId, INFO, webhookName, operation, operationItem))
+		}
+	}
+
+	for webhookName, pathItem := range webhooksDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for _, operation := range pathItem.OperationsDiff.Deleted {
+			result = append(result, newChange(WebhookOperationRemovedId, ERR, webhookName, operation, pathItem.Base.GetOperation(operation)))
+		}
+		for _, operation := range pathItem.OperationsDiff.Added {
+			result = append(result, newChange(WebhookOperationAddedId, INFO, webhookName, operation, pathItem.Revision.GetOperation(operation)))
+		}
+	}
+
+	return result
+}
+
+// webhookOperationVisitor is called for each operation that was modified in a webhook
+type webhookOperationVisitor func(webhookName, operation string, operationItem *diff.MethodDiff, source string)
+
+func forEachModifiedWebhookOperation(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, visitor webhookOperationVisitor) {
+	if diffReport.WebhooksDiff == nil {
+		return
+	}
+
+	for webhookName, pathItem := range diffReport.WebhooksDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			visitor(webhookName, operation, operationItem, (*operationsSources)[operationItem.Revision])
+		}
+	}
+}
+
+// newWebhookChange returns a function that creates changes in a modified webhook operation
+func newWebhookChange(config *Config, webhookName, operation string, operationItem *diff.MethodDiff, source string) sentOperationChange {
+	return func(id string, level Level, args []any, element any) Change {
+		return ApiChange{
+			Id:          id,
+			Level:       level,
+			Args:        append(args, webhookName),
+			Operation:   operation,
+			OperationId: operationItem.Revision.OperationID,
+			Path:        webhookName,
+			Source:      load.NewSource(source),
+		}.withLocation(config, element, operationItem.Revision)
+	}
+}
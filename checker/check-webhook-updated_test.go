@@ -0,0 +1,203 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+const webhookBase = "../data/checker/webhook_base.yaml"
+
+func getWebhooks(t *testing.T, s *load.SpecInfo) *openapi3.Paths {
+	t.Helper()
+	require.NotNil(t, s.Webhooks)
+	return s.Webhooks
+}
+
+// BC: removing a webhook is breaking
+func TestWebhookRemoved(t *testing.T) {
+	s1, err := open(webhookBase)
+	require.NoError(t, err)
+	s2, err := open(webhookBase)
+	require.NoError(t, err)
+
+	delete(getWebhooks(t, s2).Map(), "newPet")
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.WebhookUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.WebhookRemovedId,
+		Args:        []any{"newPet"},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "newPet",
+		Source:      load.NewSource(webhookBase),
+		OperationId: "newPet",
+	}, errs[0])
+	require.Equal(t, "removed the webhook 'newPet'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// CL: removing a webhook is reported at the location of the webhook operation in the base spec
+func TestWebhookRemoved_Location(t *testing.T) {
+	s1, err := open(webhookBase)
+	require.NoError(t, err)
+	s2, err := open(webhookBase)
+	require.NoError(t, err)
+
+	delete(getWebhooks(t, s2).Map(), "newPet")
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	config := singleCheckConfig(checker.WebhookUpdatedCheck)
+	config.Locations = load.GetLocations(s1, s2)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(config, d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, webhookBase, errs[0].GetSourceFile())
+	require.Equal(t, 6, errs[0].GetSourceLine())
+	require.Equal(t, 22, errs[0].GetSourceLineEnd())
+	require.Equal(t, 4, errs[0].GetSourceColumn())
+}
+
+// BC: removing an operation from a webhook is breaking
+func TestWebhookOperationRemoved(t *testing.T) {
+	s1, err := open(webhookBase)
+	require.NoError(t, err)
+	s2, err := open(webhookBase)
+	require.NoError(t, err)
+
+	pathItem := getWebhooks(t, s2).Value("newPet")
+	pathItem.Put = pathItem.Post
+	pathItem.Post = nil
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.WebhookUpdatedCheck), d, osm, checker.INFO)
+	require.ElementsMatch(t, []checker.ApiChange{
+		{
+			Id:          checker.WebhookOperationRemovedId,
+			Args:        []any{"newPet"},
+			Level:       checker.ERR,
+			Operation:   "POST",
+			Path:        "newPet",
+			Source:      load.NewSource(webhookBase),
+			OperationId: "newPet",
+		},
+		{
+			Id:          checker.WebhookOperationAddedId,
+			Args:        []any{"newPet"},
+			Level:       checker.INFO,
+			Operation:   "PUT",
+			Path:        "newPet",
+			Source:      load.NewSource(webhookBase),
+			OperationId: "newPet",
+		},
+	}, errs)
+}
+
+// CL: adding a webhook
+func TestWebhookAdded(t *testing.T) {
+	s1, err := open(webhookBase)
+	require.NoError(t, err)
+	s2, err := open(webhookBase)
+	require.NoError(t, err)
+
+	webhooks := getWebhooks(t, s2)
+	webhooks.Set("updatedPet", webhooks.Value("newPet"))
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.WebhookUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.WebhookAddedId,
+		Args:        []any{"updatedPet"},
+		Level:       checker.INFO,
+		Operation:   "POST",
+		Path:        "updatedPet",
+		Source:      load.NewSource(webhookBase),
+		OperationId: "newPet",
+	}, errs[0])
+}
+
+// BC: removing a required property from a webhook request is breaking
+func TestWebhookRequestPropertyRemoved(t *testing.T) {
+	s1, err := open(webhookBase)
+	require.NoError(t, err)
+	s2, err := open(webhookBase)
+	require.NoError(t, err)
+
+	schema := s2.Spec.Components.Schemas["Pet"].Value
+	delete(schema.Properties, "id")
+	schema.Required = []string{}
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.WebhookRequestPropertyUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.WebhookRequestRequiredPropertyRemovedId,
+		Args:        []any{"id", "newPet"},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "newPet",
+		Source:      load.NewSource(webhookBase),
+		OperationId: "newPet",
+	}, errs[0])
+	require.Equal(t, "removed the required request property 'id' from the webhook 'newPet'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: changing the type of a webhook request property is breaking
+func TestWebhookRequestPropertyTypeChanged(t *testing.T) {
+	s1, err := open(webhookBase)
+	require.NoError(t, err)
+	s2, err := open(webhookBase)
+	require.NoError(t, err)
+
+	s2.Spec.Components.Schemas["Pet"].Value.Properties["id"] = openapi3.NewSchemaRef("", openapi3.NewStringSchema())
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.WebhookRequestPropertyUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.WebhookRequestPropertyTypeChangedId,
+		Args:        []any{"id", "integer", "string", "newPet"},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "newPet",
+		Source:      load.NewSource(webhookBase),
+		OperationId: "newPet",
+	}, errs[0])
+}
+
+// BC: adding a required property to a webhook response is breaking
+func TestWebhookResponseNewRequiredProperty(t *testing.T) {
+	s1, err := open(webhookBase)
+	require.NoError(t, err)
+	s2, err := open(webhookBase)
+	require.NoError(t, err)
+
+	schema := getWebhooks(t, s2).Value("newPet").Post.Responses.Value("200").Value.Content["application/json"].Schema.Value
+	schema.Properties["id"] = openapi3.NewSchemaRef("", openapi3.NewStringSchema())
+	schema.Required = []string{"id"}
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.WebhookResponseUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.WebhookResponseNewRequiredPropertyId,
+		Args:        []any{"id", "200", "newPet"},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "newPet",
+		Source:      load.NewSource(webhookBase),
+		OperationId: "newPet",
+	}, errs[0])
+}
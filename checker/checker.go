@@ -38,6 +38,9 @@ func CheckBackwardCompatibilityUntilLevel(config *Config, diffReport *diff.Diff,
 		result = append(result, errs...)
 	}
 
+	result = removeInlineIgnored(config, diffReport, operationsSources, result)
+	result = config.applyLevelOverrides(result)
+
 	filteredResult := make(Changes, 0)
 	for _, change := range result {
 		if change.GetLevel() >= level {
@@ -112,7 +115,7 @@ func removeDraftAndAlphaOperationsDiffs(config *Config, diffReport *diff.Diff, r
 					OperationId: operationItem.Revision.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, operationItem.Base))
 				continue
 			}
 			revisionStability, err := getStabilityLevel(pathDiff.Revision.Operations()[operation].Extensions)
@@ -126,7 +129,7 @@ func removeDraftAndAlphaOperationsDiffs(config *Config, diffReport *diff.Diff, r
 					OperationId: operationItem.Revision.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, operationItem.Revision))
 				continue
 			}
 			source := (*operationsSources)[pathDiff.Revision.Operations()[operation]]
@@ -142,7 +145,7 @@ func removeDraftAndAlphaOperationsDiffs(config *Config, diffReport *diff.Diff, r
 					OperationId: operationItem.Revision.OperationID,
 					Path:        path,
 					Source:      load.NewSource(source),
-				})
+				}.withLocation(config, operationItem.Revision))
 				continue
 			}
 			if revisionStability == STABILITY_DRAFT || revisionStability == STABILITY_ALPHA {
@@ -168,7 +171,7 @@ func newParsingError(config *Config,
 		OperationId: operationItem.OperationID,
 		Path:        path,
 		Source:      load.NewSource(source),
-	})
+	}.withLocation(config, operationItem))
 	return result
 }
 
@@ -0,0 +1,121 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/load"
+)
+
+const requestCookieParametersBase = "../data/checker/request_cookie_parameters_base.yaml"
+
+func getCookieParameter(s *load.SpecInfo, name string) *openapi3.Parameter {
+	return s.Spec.Paths.Value("/cart").Get.Parameters.GetByInAndName(openapi3.ParameterInCookie, name)
+}
+
+// BC: adding a new required request cookie is breaking
+func TestBreaking_NewRequiredRequestCookie(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, requestCookieParametersBase, checker.GetDefaultChecks(), func(s *load.SpecInfo) {
+		s.Spec.Paths.Value("/cart").Get.Parameters = append(s.Spec.Paths.Value("/cart").Get.Parameters, &openapi3.ParameterRef{
+			Value: openapi3.NewCookieParameter("csrf").WithRequired(true).WithSchema(openapi3.NewStringSchema()),
+		})
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.NewRequiredRequestParameterId, errs[0].GetId())
+	require.Equal(t, checker.ERR, errs[0].GetLevel())
+	require.Equal(t, "added the new required 'cookie' request parameter 'csrf'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: adding a new required request cookie with the same name as an optional query parameter is breaking
+func TestBreaking_NewRequiredRequestCookieWithQueryParameterName(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, requestCookieParametersBase, checker.GetDefaultChecks(), func(s *load.SpecInfo) {
+		s.Spec.Paths.Value("/cart").Get.Parameters = append(s.Spec.Paths.Value("/cart").Get.Parameters, &openapi3.ParameterRef{
+			Value: openapi3.NewCookieParameter("locale").WithRequired(true).WithSchema(openapi3.NewStringSchema()),
+		})
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.NewRequiredRequestParameterId, errs[0].GetId())
+	require.Equal(t, "added the new required 'cookie' request parameter 'locale'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: adding a new optional request cookie to all the operations of a path is not breaking
+func TestBreaking_NewOptionalRequestCookieToPath(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, requestCookieParametersBase, checker.GetDefaultChecks(), func(s *load.SpecInfo) {
+		s.Spec.Paths.Value("/cart").Parameters = append(s.Spec.Paths.Value("/cart").Parameters, &openapi3.ParameterRef{
+			Value: openapi3.NewCookieParameter("tenant").WithSchema(openapi3.NewStringSchema()),
+		})
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.NewOptionalRequestDefaultParameterToExistingPathId, errs[0].GetId())
+	require.Equal(t, checker.INFO, errs[0].GetLevel())
+	require.Equal(t, "added the new optional 'cookie' request parameter 'tenant' to all path's operations", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: removing a request cookie is breaking
+func TestBreaking_RequestCookieRemoved(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, requestCookieParametersBase, checker.GetDefaultChecks(), func(s *load.SpecInfo) {
+		params := s.Spec.Paths.Value("/cart").Get.Parameters
+		s.Spec.Paths.Value("/cart").Get.Parameters = openapi3.Parameters{params[1], params[2]}
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestParameterRemovedId, errs[0].GetId())
+	require.Equal(t, "deleted the 'cookie' request parameter 'session'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: changing an optional request cookie to required is breaking
+func TestBreaking_RequestCookieBecameRequired(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, requestCookieParametersBase, checker.GetDefaultChecks(), func(s *load.SpecInfo) {
+		getCookieParameter(s, "prefs").Required = true
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestParameterBecomeRequiredId, errs[0].GetId())
+	require.Equal(t, "the 'cookie' request parameter 'prefs' became required", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: new required property in request cookie is breaking
+func TestBreaking_NewRequiredRequestCookieProperty(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, requestCookieParametersBase, checker.GetDefaultChecks(), func(s *load.SpecInfo) {
+		schema := getCookieParameter(s, "prefs").Schema.Value
+		schema.Properties["region"] = openapi3.NewStringSchema().NewRef()
+		schema.Required = []string{"region"}
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.NewRequiredRequestCookiePropertyId, errs[0].GetId())
+	require.Equal(t, checker.ERR, errs[0].GetLevel())
+	require.Equal(t, "added the new required 'prefs' request cookie's property 'region'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: changing an existing property in request cookie to required is breaking
+func TestBreaking_RequestCookiePropertyBecameRequired(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, requestCookieParametersBase, checker.GetDefaultChecks(), func(s *load.SpecInfo) {
+		getCookieParameter(s, "prefs").Schema.Value.Required = []string{"theme"}
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestCookiePropertyBecameRequiredId, errs[0].GetId())
+	require.Equal(t, checker.ERR, errs[0].GetLevel())
+	require.Equal(t, "the 'prefs' request cookie's property 'theme' became required", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: changing an existing property in request cookie to enum is breaking
+func TestBreaking_RequestCookiePropertyBecameEnum(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, requestCookieParametersBase, checker.GetDefaultChecks(), func(s *load.SpecInfo) {
+		getCookieParameter(s, "prefs").Schema.Value.Properties["currency"].Value.Enum = []any{"EUR", "USD"}
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestCookiePropertyBecameEnumId, errs[0].GetId())
+	require.Equal(t, checker.ERR, errs[0].GetLevel())
+	require.Equal(t, "the 'prefs' request cookie's property 'currency' was restricted to a list of enum values", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: changing a request cookie to enum is breaking
+func TestBreaking_RequestCookieBecameEnum(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, requestCookieParametersBase, checker.GetDefaultChecks(), func(s *load.SpecInfo) {
+		getCookieParameter(s, "session").Schema.Value.Enum = []any{"guest"}
+	})
+	require.Len(t, errs, 2)
+	require.Equal(t, checker.RequestParameterEnumValueAddedId, errs[1].GetId())
+	require.Equal(t, checker.RequestParameterBecameEnumId, errs[0].GetId())
+	require.Equal(t, "the 'cookie' request parameter 'session' was restricted to a list of enum values", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
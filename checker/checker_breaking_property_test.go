@@ -214,6 +214,17 @@ func TestBreaking_ReqParameterHeaderPropertyBecameEnum(t *testing.T) {
 	require.Equal(t, checker.RequestHeaderPropertyBecameEnumId, errs[0].GetId())
 }
 
+// BC: changing an existing header param to enum is breaking
+func TestBreaking_ReqParameterHeaderBecameEnum(t *testing.T) {
+	errs := getUpdatedSpecChanges(t, "../data/enums/request-parameter-property-no-enum.yaml", singleCheckConfig(checker.RequestHeaderPropertyBecameEnumCheck), func(s *load.SpecInfo) {
+		s.Spec.Paths.Value("/books/{bookId}").Get.Parameters.GetByInAndName(openapi3.ParameterInHeader, "bookId").Schema.Value.Enum = []any{map[string]any{"name": "a"}}
+	})
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestHeaderPropertyBecameEnumId, errs[0].GetId())
+	require.Equal(t, checker.ERR, errs[0].GetLevel())
+	require.Equal(t, []any{"bookId"}, errs[0].(checker.ApiChange).Args)
+}
+
 // BC: changing a response body to nullable is breaking
 func TestBreaking_RespBodyNullable(t *testing.T) {
 	s1, err := open("../data/nullable/base-body.yaml")
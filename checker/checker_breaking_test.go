@@ -510,7 +510,7 @@ func TestBreaking_AddPatternRecursive(t *testing.T) {
 	require.Equal(t, "added the pattern '^[a-z]+$' to the request property 'data/created'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
 }
 
-// BC: modifying a pattern in a schema is breaking
+// BC: broadening a pattern in a schema that is shared by requests and responses is breaking for the responses
 func TestBreaking_ModifyPattern(t *testing.T) {
 	s1, err := open("../data/pattern-base.yaml")
 	require.NoError(t, err)
@@ -521,13 +521,14 @@ func TestBreaking_ModifyPattern(t *testing.T) {
 	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
 	require.NoError(t, err)
 	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
-	require.NotEmpty(t, errs)
-	require.Len(t, errs, 1)
-	require.Equal(t, checker.RequestPropertyPatternChangedId, errs[0].GetId())
-	require.Equal(t, "changed the pattern of the request property 'created' from '^[a-z]+$' to '.+'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+	require.Len(t, errs, 2)
+	for _, err := range errs {
+		require.Equal(t, checker.ResponsePropertyPatternBroadenedId, err.GetId())
+		require.Equal(t, checker.ERR, err.GetLevel())
+	}
 }
 
-// BC: modifying a pattern in request parameter is breaking
+// BC: narrowing a pattern in request parameter is breaking
 func TestBreaking_ModifyParameterPattern(t *testing.T) {
 	s1, err := open("../data/pattern-parameter-base.yaml")
 	require.NoError(t, err)
@@ -540,11 +541,12 @@ func TestBreaking_ModifyParameterPattern(t *testing.T) {
 	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
 	require.NotEmpty(t, errs)
 	require.Len(t, errs, 1)
-	require.Equal(t, checker.RequestParameterPatternChangedId, errs[0].GetId())
-	require.Equal(t, "changed the pattern of the 'path' request parameter 'groupId' from '[0-9a-f]+' to '[0-9]+'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+	require.Equal(t, checker.RequestParameterPatternNarrowedId, errs[0].GetId())
+	require.Equal(t, checker.ERR, errs[0].GetLevel())
+	require.Equal(t, "narrowed the pattern of the 'path' request parameter 'groupId' from '[0-9a-f]+' to '[0-9]+'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
 }
 
-// BC: modifying a pattern to ".*" in a schema is not breaking
+// BC: modifying a pattern to ".*" in a schema that is shared by requests and responses is breaking for the responses
 func TestBreaking_ModifyPatternToAnyString(t *testing.T) {
 	s1, err := open("../data/pattern-base.yaml")
 	require.NoError(t, err)
@@ -555,7 +557,48 @@ func TestBreaking_ModifyPatternToAnyString(t *testing.T) {
 	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
 	require.NoError(t, err)
 	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
-	require.Empty(t, errs)
+	require.Len(t, errs, 2)
+	for _, err := range errs {
+		require.Equal(t, checker.ResponsePropertyPatternBroadenedId, err.GetId())
+		require.Equal(t, checker.ERR, err.GetLevel())
+	}
+}
+
+// BC: modifying a pattern to another pattern that matches any string, like "^(?:.*)", in a schema that is shared by requests and responses is breaking for the responses
+func TestBreaking_ModifyPatternToEquivalentOfAnyString(t *testing.T) {
+	s1, err := open("../data/pattern-base.yaml")
+	require.NoError(t, err)
+
+	s2, err := open("../data/pattern-base.yaml")
+	require.NoError(t, err)
+	s2.Spec.Components.Schemas["GroupView"].Value.Properties["created"].Value.Pattern = "^(?:.*)"
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
+	require.Len(t, errs, 2)
+	for _, err := range errs {
+		require.Equal(t, checker.ResponsePropertyPatternBroadenedId, err.GetId())
+		require.Equal(t, checker.ERR, err.GetLevel())
+	}
+}
+
+// BC: modifying a pattern to an ECMA-262 pattern with a lookahead in a schema is breaking
+func TestBreaking_ModifyPatternWithLookahead(t *testing.T) {
+	s1, err := open("../data/pattern-base.yaml")
+	require.NoError(t, err)
+
+	s2, err := open("../data/pattern-base.yaml")
+	require.NoError(t, err)
+	s2.Spec.Components.Schemas["GroupView"].Value.Properties["created"].Value.Pattern = "^(?!admin$)[a-z]+$"
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestPropertyPatternChangedId, errs[0].GetId())
+	require.Equal(t, checker.WARN, errs[0].GetLevel())
+	require.Equal(t, "changed the pattern of the request property 'created' from '^[a-z]+$' to '^(?!admin$)[a-z]+$'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
 }
 
 // BC: modifying the default value of an optional request parameter is breaking
@@ -758,3 +801,18 @@ func TestBreaking_RequestPropertyAllOfRemoved(t *testing.T) {
 	require.Equal(t, checker.WARN, errs[1].GetLevel())
 	require.Equal(t, "removed 'Breed3' from the '/allOf[#/components/schemas/Dog]/breed' request property 'allOf' list", errs[1].GetUncolorizedText(checker.NewDefaultLocalizer()))
 }
+
+// BC: changing a server URL is breaking
+func TestBreaking_Servers(t *testing.T) {
+	s1, err := open("../data/servers/baseswagger.json")
+	require.NoError(t, err)
+
+	s2, err := open("../data/servers/revisionswagger.json")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
+	require.Len(t, errs, 2)
+	require.ElementsMatch(t, []string{checker.APIServerURLChangedId, checker.APIOperationServerURLChangedId}, []string{errs[0].GetId(), errs[1].GetId()})
+}
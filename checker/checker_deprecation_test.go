@@ -30,6 +30,22 @@ func singleCheckConfig(c checker.BackwardCompatibilityCheck) *checker.Config {
 	}
 }
 
+// getUpdatedSpecChanges runs the checks on a spec and on a copy of it that was modified by update
+func getUpdatedSpecChanges(t *testing.T, file string, config *checker.Config, update func(s *load.SpecInfo)) checker.Changes {
+	t.Helper()
+
+	s1, err := open(file)
+	require.NoError(t, err)
+	s2, err := open(file)
+	require.NoError(t, err)
+
+	update(s2)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	return checker.CheckBackwardCompatibilityUntilLevel(config, d, osm, checker.INFO)
+}
+
 // BC: deleting an operation before sunset date is breaking
 func TestBreaking_RemoveBeforeSunset(t *testing.T) {
 
@@ -1,6 +1,7 @@
 package checker_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -24,6 +25,23 @@ func verifyNonBreakingChangeIsChangelogEntry(t *testing.T, d *diff.Diff, osm *di
 	require.Equal(t, changeId, errs[0].GetId())
 }
 
+// dWithoutCallbacks is like d, but drops the changes to callbacks, to test the changes to the operations of specs that also change their callbacks
+func dWithoutCallbacks(t *testing.T, config *diff.Config, v1, v2 int) checker.Changes {
+	t.Helper()
+	l1 := l(t, v1)
+	l2 := l(t, v2)
+	d, osm, err := diff.GetWithOperationsSourcesMap(config, &l1, &l2)
+	require.NoError(t, err)
+
+	levelOverrides := map[string]checker.Level{}
+	for _, rule := range checker.GetAllRules() {
+		if strings.HasPrefix(rule.Id, "callback-") {
+			levelOverrides[rule.Id] = checker.NONE
+		}
+	}
+	return checker.CheckBackwardCompatibility(checker.GetDefaultChecks().WithLevelOverrides(levelOverrides), d, osm)
+}
+
 // BC: no change is not breaking
 func TestBreaking_Same(t *testing.T) {
 	s1 := l(t, 1)
@@ -71,49 +89,60 @@ func TestBreaking_RequestBodyRequiredDisabled(t *testing.T) {
 // BC: deleting a tag is not breaking
 func TestBreaking_DeletedTag(t *testing.T) {
 	r := d(t, getConfig(), 1, 5)
-	require.Len(t, r, 6)
-	require.Equal(t, checker.ResponseBodyTypeChangedId, r[0].GetId())
-	require.Equal(t, checker.ResponseSuccessStatusRemovedId, r[1].GetId())
-	require.Equal(t, checker.APIPathRemovedWithoutDeprecationId, r[2].GetId())
-	require.Equal(t, checker.APIPathRemovedWithoutDeprecationId, r[3].GetId())
-	require.Equal(t, checker.OptionalResponseHeaderRemovedId, r[4].GetId())
-	require.Equal(t, checker.RequestParameterRemovedId, r[5].GetId())
+	require.Len(t, r, 8)
+	require.Equal(t, checker.APIServerRemovedId, r[0].GetId())
+	require.Equal(t, checker.ResponseBodyTypeChangedId, r[1].GetId())
+	require.Equal(t, checker.ResponseMediaTypeRemovedId, r[2].GetId())
+	require.Equal(t, checker.APIOperationServerURLChangedId, r[3].GetId())
+	require.Equal(t, checker.APIPathRemovedWithoutDeprecationId, r[4].GetId())
+	require.Equal(t, checker.APIPathRemovedWithoutDeprecationId, r[5].GetId())
+	require.Equal(t, checker.OptionalResponseHeaderRemovedId, r[6].GetId())
+	require.Equal(t, checker.RequestParameterRemovedId, r[7].GetId())
 }
 
 // BC: adding an enum value is not breaking
 func TestBreaking_AddedEnum(t *testing.T) {
-	r := d(t, getConfig(), 1, 3)
-	require.Len(t, r, 6)
-	require.Equal(t, checker.ResponseSuccessStatusRemovedId, r[0].GetId())
-	require.Equal(t, checker.ResponseSuccessStatusRemovedId, r[1].GetId())
-	require.Equal(t, checker.RequestParameterRemovedId, r[2].GetId())
-	require.Equal(t, checker.RequestParameterRemovedId, r[3].GetId())
-	require.Equal(t, checker.RequestParameterRemovedId, r[4].GetId())
+	r := dWithoutCallbacks(t, getConfig(), 1, 3)
+	require.Len(t, r, 9)
+	require.Equal(t, checker.APIServerRemovedId, r[0].GetId())
+	require.Equal(t, checker.RequestParameterNotSchemaAddedId, r[1].GetId())
+	require.Equal(t, checker.ResponseMediaTypeRemovedId, r[2].GetId())
+	require.Equal(t, checker.ResponseMediaTypeRemovedId, r[3].GetId())
+	require.Equal(t, checker.APIOperationServerURLChangedId, r[4].GetId())
 	require.Equal(t, checker.RequestParameterRemovedId, r[5].GetId())
+	require.Equal(t, checker.RequestParameterRemovedId, r[6].GetId())
+	require.Equal(t, checker.RequestParameterRemovedId, r[7].GetId())
+	require.Equal(t, checker.RequestParameterRemovedId, r[8].GetId())
 }
 
 // BC: changing extensions is not breaking
 func TestBreaking_ModifiedExtension(t *testing.T) {
-	r := d(t, getConfig(), 1, 3)
-	require.Len(t, r, 6)
-	require.Equal(t, checker.ResponseSuccessStatusRemovedId, r[0].GetId())
-	require.Equal(t, checker.ResponseSuccessStatusRemovedId, r[1].GetId())
-	require.Equal(t, checker.RequestParameterRemovedId, r[2].GetId())
-	require.Equal(t, checker.RequestParameterRemovedId, r[3].GetId())
-	require.Equal(t, checker.RequestParameterRemovedId, r[4].GetId())
+	r := dWithoutCallbacks(t, getConfig(), 1, 3)
+	require.Len(t, r, 9)
+	require.Equal(t, checker.APIServerRemovedId, r[0].GetId())
+	require.Equal(t, checker.RequestParameterNotSchemaAddedId, r[1].GetId())
+	require.Equal(t, checker.ResponseMediaTypeRemovedId, r[2].GetId())
+	require.Equal(t, checker.ResponseMediaTypeRemovedId, r[3].GetId())
+	require.Equal(t, checker.APIOperationServerURLChangedId, r[4].GetId())
 	require.Equal(t, checker.RequestParameterRemovedId, r[5].GetId())
+	require.Equal(t, checker.RequestParameterRemovedId, r[6].GetId())
+	require.Equal(t, checker.RequestParameterRemovedId, r[7].GetId())
+	require.Equal(t, checker.RequestParameterRemovedId, r[8].GetId())
 }
 
 // BC: changing comments is not breaking
 func TestBreaking_Comments(t *testing.T) {
-	r := d(t, getConfig(), 1, 3)
-	require.Len(t, r, 6)
-	require.Equal(t, checker.ResponseSuccessStatusRemovedId, r[0].GetId())
-	require.Equal(t, checker.ResponseSuccessStatusRemovedId, r[1].GetId())
-	require.Equal(t, checker.RequestParameterRemovedId, r[2].GetId())
-	require.Equal(t, checker.RequestParameterRemovedId, r[3].GetId())
-	require.Equal(t, checker.RequestParameterRemovedId, r[4].GetId())
+	r := dWithoutCallbacks(t, getConfig(), 1, 3)
+	require.Len(t, r, 9)
+	require.Equal(t, checker.APIServerRemovedId, r[0].GetId())
+	require.Equal(t, checker.RequestParameterNotSchemaAddedId, r[1].GetId())
+	require.Equal(t, checker.ResponseMediaTypeRemovedId, r[2].GetId())
+	require.Equal(t, checker.ResponseMediaTypeRemovedId, r[3].GetId())
+	require.Equal(t, checker.APIOperationServerURLChangedId, r[4].GetId())
 	require.Equal(t, checker.RequestParameterRemovedId, r[5].GetId())
+	require.Equal(t, checker.RequestParameterRemovedId, r[6].GetId())
+	require.Equal(t, checker.RequestParameterRemovedId, r[7].GetId())
+	require.Equal(t, checker.RequestParameterRemovedId, r[8].GetId())
 }
 
 // BC: new optional header param is not breaking
@@ -166,20 +195,24 @@ func TestBreaking_NewRequiredResponseHeader(t *testing.T) {
 
 // BC: changing operation ID is not breaking
 func TestBreaking_OperationID(t *testing.T) {
-	r := d(t, getConfig(), 3, 1)
-	require.Len(t, r, 3)
+	r := dWithoutCallbacks(t, getConfig(), 3, 1)
+	require.Len(t, r, 5)
 	require.Equal(t, checker.RequestParameterMaxLengthDecreasedId, r[0].GetId())
-	require.Equal(t, checker.RequestParameterEnumValueRemovedId, r[1].GetId())
-	require.Equal(t, checker.RequestParameterPatternAddedId, r[2].GetId())
+	require.Equal(t, checker.APIOperationServerRemovedId, r[1].GetId())
+	require.Equal(t, checker.APIOperationServerURLChangedId, r[2].GetId())
+	require.Equal(t, checker.RequestParameterEnumValueRemovedId, r[3].GetId())
+	require.Equal(t, checker.RequestParameterPatternAddedId, r[4].GetId())
 }
 
 // BC: changing a link to operation ID is not breaking
 func TestBreaking_LinkOperationID(t *testing.T) {
-	r := d(t, getConfig(), 3, 1)
-	require.Len(t, r, 3)
+	r := dWithoutCallbacks(t, getConfig(), 3, 1)
+	require.Len(t, r, 5)
 	require.Equal(t, checker.RequestParameterMaxLengthDecreasedId, r[0].GetId())
-	require.Equal(t, checker.RequestParameterEnumValueRemovedId, r[1].GetId())
-	require.Equal(t, checker.RequestParameterPatternAddedId, r[2].GetId())
+	require.Equal(t, checker.APIOperationServerRemovedId, r[1].GetId())
+	require.Equal(t, checker.APIOperationServerURLChangedId, r[2].GetId())
+	require.Equal(t, checker.RequestParameterEnumValueRemovedId, r[3].GetId())
+	require.Equal(t, checker.RequestParameterPatternAddedId, r[4].GetId())
 }
 
 // BC: adding a media-type to response is not breaking
@@ -250,20 +283,6 @@ func TestBreaking_DeprecatedSchema(t *testing.T) {
 	require.Empty(t, errs)
 }
 
-// BC: changing servers is not breaking
-func TestBreaking_Servers(t *testing.T) {
-	s1, err := open("../data/servers/baseswagger.json")
-	require.NoError(t, err)
-
-	s2, err := open("../data/servers/revisionswagger.json")
-	require.NoError(t, err)
-
-	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
-	require.NoError(t, err)
-	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
-	require.Empty(t, errs)
-}
-
 // BC: adding a tag is not breaking
 func TestBreaking_TagAdded(t *testing.T) {
 	s1 := l(t, 1)
@@ -52,17 +52,37 @@ func interfaceToString(arg interface{}) string {
 	return fmt.Sprintf("%v", arg)
 }
 
+// isSubschemaModified returns true if a subschema exists in both the base and the revision, so that its properties can be compared
+func isSubschemaModified(schemaDiff *diff.SchemaDiff) bool {
+	return schemaDiff != nil && !schemaDiff.SchemaAdded && !schemaDiff.SchemaDeleted
+}
+
+// negatedPropertyProcessor processes a modified property, where negated is true for properties under an odd number of 'not' schemas
+// The constraints of a negated property have the opposite effect: relaxing them rejects more values
+type negatedPropertyProcessor func(propertyPath string, propertyName string, propertyItem *diff.SchemaDiff, propertyParentItem *diff.SchemaDiff, negated bool)
+
+// CheckModifiedPropertiesDiff calls the processor for the modified properties of a schema
+// Properties under 'not' schemas are skipped, since the processors assume that relaxing a constraint accepts more values
 func CheckModifiedPropertiesDiff(schemaDiff *diff.SchemaDiff, processor func(propertyPath string, propertyName string, propertyItem *diff.SchemaDiff, propertyParentItem *diff.SchemaDiff)) {
+	checkNegatedModifiedPropertiesDiff(schemaDiff, func(propertyPath string, propertyName string, propertyItem *diff.SchemaDiff, propertyParentItem *diff.SchemaDiff, negated bool) {
+		if !negated {
+			processor(propertyPath, propertyName, propertyItem, propertyParentItem)
+		}
+	})
+}
+
+// checkNegatedModifiedPropertiesDiff calls the processor for the modified properties of a schema, including the properties under 'not' schemas
+func checkNegatedModifiedPropertiesDiff(schemaDiff *diff.SchemaDiff, processor negatedPropertyProcessor) {
 	if schemaDiff == nil {
 		return
 	}
 
-	processModifiedPropertiesDiff("", "", schemaDiff, nil, processor)
+	processModifiedPropertiesDiff("", "", schemaDiff, nil, false, processor)
 }
 
-func processModifiedPropertiesDiff(propertyPath string, propertyName string, schemaDiff *diff.SchemaDiff, parentDiff *diff.SchemaDiff, processor func(propertyPath string, propertyName string, propertyItem *diff.SchemaDiff, propertyParentItem *diff.SchemaDiff)) {
+func processModifiedPropertiesDiff(propertyPath string, propertyName string, schemaDiff *diff.SchemaDiff, parentDiff *diff.SchemaDiff, negated bool, processor negatedPropertyProcessor) {
 	if propertyName != "" || propertyPath != "" {
-		processor(propertyPath, propertyName, schemaDiff, parentDiff)
+		processor(propertyPath, propertyName, schemaDiff, parentDiff, negated)
 	}
 
 	if propertyName != "" {
@@ -75,29 +95,37 @@ func processModifiedPropertiesDiff(propertyPath string, propertyName string, sch
 
 	if schemaDiff.AllOfDiff != nil {
 		for k, v := range schemaDiff.AllOfDiff.Modified {
-			processModifiedPropertiesDiff(fmt.Sprintf("%s/allOf[%s]", propertyPath, k), "", v, schemaDiff, processor)
+			processModifiedPropertiesDiff(fmt.Sprintf("%s/allOf[%s]", propertyPath, k), "", v, schemaDiff, negated, processor)
 		}
 	}
 
 	if schemaDiff.AnyOfDiff != nil {
 		for k, v := range schemaDiff.AnyOfDiff.Modified {
-			processModifiedPropertiesDiff(fmt.Sprintf("%s/anyOf[%s]", propertyPath, k), "", v, schemaDiff, processor)
+			processModifiedPropertiesDiff(fmt.Sprintf("%s/anyOf[%s]", propertyPath, k), "", v, schemaDiff, negated, processor)
 		}
 	}
 
 	if schemaDiff.OneOfDiff != nil {
 		for k, v := range schemaDiff.OneOfDiff.Modified {
-			processModifiedPropertiesDiff(fmt.Sprintf("%s/oneOf[%s]", propertyPath, k), "", v, schemaDiff, processor)
+			processModifiedPropertiesDiff(fmt.Sprintf("%s/oneOf[%s]", propertyPath, k), "", v, schemaDiff, negated, processor)
 		}
 	}
 
 	if schemaDiff.ItemsDiff != nil {
-		processModifiedPropertiesDiff(fmt.Sprintf("%s/items", propertyPath), "", schemaDiff.ItemsDiff, schemaDiff, processor)
+		processModifiedPropertiesDiff(fmt.Sprintf("%s/items", propertyPath), "", schemaDiff.ItemsDiff, schemaDiff, negated, processor)
+	}
+
+	if isSubschemaModified(schemaDiff.AdditionalPropertiesDiff) {
+		processModifiedPropertiesDiff(fmt.Sprintf("%s/additionalProperties", propertyPath), "", schemaDiff.AdditionalPropertiesDiff, schemaDiff, negated, processor)
+	}
+
+	if isSubschemaModified(schemaDiff.NotDiff) {
+		processModifiedPropertiesDiff(fmt.Sprintf("%s/not", propertyPath), "", schemaDiff.NotDiff, schemaDiff, !negated, processor)
 	}
 
 	if schemaDiff.PropertiesDiff != nil {
 		for i, v := range schemaDiff.PropertiesDiff.Modified {
-			processModifiedPropertiesDiff(propertyPath, i, v, schemaDiff, processor)
+			processModifiedPropertiesDiff(propertyPath, i, v, schemaDiff, negated, processor)
 		}
 	}
 }
@@ -106,10 +134,10 @@ func CheckAddedPropertiesDiff(schemaDiff *diff.SchemaDiff, processor func(proper
 	if schemaDiff == nil {
 		return
 	}
-	processAddedPropertiesDiff("", "", schemaDiff, nil, processor)
+	processAddedPropertiesDiff("", "", schemaDiff, nil, false, processor)
 }
 
-func processAddedPropertiesDiff(propertyPath string, propertyName string, schemaDiff *diff.SchemaDiff, parentDiff *diff.SchemaDiff, processor func(propertyPath string, propertyName string, propertyItem *openapi3.Schema, propertyParentDiff *diff.SchemaDiff)) {
+func processAddedPropertiesDiff(propertyPath string, propertyName string, schemaDiff *diff.SchemaDiff, parentDiff *diff.SchemaDiff, negated bool, processor func(propertyPath string, propertyName string, propertyItem *openapi3.Schema, propertyParentDiff *diff.SchemaDiff)) {
 	if propertyName != "" {
 		if propertyPath == "" {
 			propertyPath = propertyName
@@ -120,32 +148,44 @@ func processAddedPropertiesDiff(propertyPath string, propertyName string, schema
 
 	if schemaDiff.AllOfDiff != nil {
 		for k, v := range schemaDiff.AllOfDiff.Modified {
-			processAddedPropertiesDiff(fmt.Sprintf("%s/allOf[%s]", propertyPath, k), "", v, schemaDiff, processor)
+			processAddedPropertiesDiff(fmt.Sprintf("%s/allOf[%s]", propertyPath, k), "", v, schemaDiff, negated, processor)
 		}
 	}
 
 	if schemaDiff.AnyOfDiff != nil {
 		for k, v := range schemaDiff.AnyOfDiff.Modified {
-			processAddedPropertiesDiff(fmt.Sprintf("%s/anyOf[%s]", propertyPath, k), "", v, schemaDiff, processor)
+			processAddedPropertiesDiff(fmt.Sprintf("%s/anyOf[%s]", propertyPath, k), "", v, schemaDiff, negated, processor)
 		}
 	}
 
 	if schemaDiff.OneOfDiff != nil {
 		for k, v := range schemaDiff.OneOfDiff.Modified {
-			processAddedPropertiesDiff(fmt.Sprintf("%s/oneOf[%s]", propertyPath, k), "", v, schemaDiff, processor)
+			processAddedPropertiesDiff(fmt.Sprintf("%s/oneOf[%s]", propertyPath, k), "", v, schemaDiff, negated, processor)
 		}
 	}
 
 	if schemaDiff.ItemsDiff != nil {
-		processAddedPropertiesDiff(fmt.Sprintf("%s/items", propertyPath), "", schemaDiff.ItemsDiff, schemaDiff, processor)
+		processAddedPropertiesDiff(fmt.Sprintf("%s/items", propertyPath), "", schemaDiff.ItemsDiff, schemaDiff, negated, processor)
+	}
+
+	if isSubschemaModified(schemaDiff.AdditionalPropertiesDiff) {
+		processAddedPropertiesDiff(fmt.Sprintf("%s/additionalProperties", propertyPath), "", schemaDiff.AdditionalPropertiesDiff, schemaDiff, negated, processor)
+	}
+
+	if isSubschemaModified(schemaDiff.NotDiff) {
+		processAddedPropertiesDiff(fmt.Sprintf("%s/not", propertyPath), "", schemaDiff.NotDiff, schemaDiff, !negated, processor)
 	}
 
 	if schemaDiff.PropertiesDiff != nil {
 		for _, v := range schemaDiff.PropertiesDiff.Added {
+			if negated {
+				// the processors assume that the property constrains the accepted values rather than the rejected ones
+				continue
+			}
 			processor(propertyPath, v, schemaDiff.Revision.Properties[v].Value, schemaDiff)
 		}
 		for i, v := range schemaDiff.PropertiesDiff.Modified {
-			processAddedPropertiesDiff(propertyPath, i, v, schemaDiff, processor)
+			processAddedPropertiesDiff(propertyPath, i, v, schemaDiff, negated, processor)
 		}
 	}
 }
@@ -155,10 +195,10 @@ func CheckDeletedPropertiesDiff(schemaDiff *diff.SchemaDiff, processor func(prop
 		return
 	}
 
-	processDeletedPropertiesDiff("", "", schemaDiff, nil, processor)
+	processDeletedPropertiesDiff("", "", schemaDiff, nil, false, processor)
 }
 
-func processDeletedPropertiesDiff(propertyPath string, propertyName string, schemaDiff *diff.SchemaDiff, parentDiff *diff.SchemaDiff, processor func(propertyPath string, propertyName string, propertyItem *openapi3.Schema, propertyParentDiff *diff.SchemaDiff)) {
+func processDeletedPropertiesDiff(propertyPath string, propertyName string, schemaDiff *diff.SchemaDiff, parentDiff *diff.SchemaDiff, negated bool, processor func(propertyPath string, propertyName string, propertyItem *openapi3.Schema, propertyParentDiff *diff.SchemaDiff)) {
 	if propertyName != "" {
 		if propertyPath == "" {
 			propertyPath = propertyName
@@ -169,31 +209,43 @@ func processDeletedPropertiesDiff(propertyPath string, propertyName string, sche
 
 	if schemaDiff.AllOfDiff != nil {
 		for k, v := range schemaDiff.AllOfDiff.Modified {
-			processDeletedPropertiesDiff(fmt.Sprintf("%s/allOf[%s]", propertyPath, k), "", v, schemaDiff, processor)
+			processDeletedPropertiesDiff(fmt.Sprintf("%s/allOf[%s]", propertyPath, k), "", v, schemaDiff, negated, processor)
 		}
 	}
 	if schemaDiff.AnyOfDiff != nil {
 		for k, v := range schemaDiff.AnyOfDiff.Modified {
-			processDeletedPropertiesDiff(fmt.Sprintf("%s/anyOf[%s]", propertyPath, k), "", v, schemaDiff, processor)
+			processDeletedPropertiesDiff(fmt.Sprintf("%s/anyOf[%s]", propertyPath, k), "", v, schemaDiff, negated, processor)
 		}
 	}
 
 	if schemaDiff.OneOfDiff != nil {
 		for k, v := range schemaDiff.OneOfDiff.Modified {
-			processDeletedPropertiesDiff(fmt.Sprintf("%s/oneOf[%s]", propertyPath, k), "", v, schemaDiff, processor)
+			processDeletedPropertiesDiff(fmt.Sprintf("%s/oneOf[%s]", propertyPath, k), "", v, schemaDiff, negated, processor)
 		}
 	}
 
 	if schemaDiff.ItemsDiff != nil {
-		processDeletedPropertiesDiff(fmt.Sprintf("%s/items", propertyPath), "", schemaDiff.ItemsDiff, schemaDiff, processor)
+		processDeletedPropertiesDiff(fmt.Sprintf("%s/items", propertyPath), "", schemaDiff.ItemsDiff, schemaDiff, negated, processor)
+	}
+
+	if isSubschemaModified(schemaDiff.AdditionalPropertiesDiff) {
+		processDeletedPropertiesDiff(fmt.Sprintf("%s/additionalProperties", propertyPath), "", schemaDiff.AdditionalPropertiesDiff, schemaDiff, negated, processor)
+	}
+
+	if isSubschemaModified(schemaDiff.NotDiff) {
+		processDeletedPropertiesDiff(fmt.Sprintf("%s/not", propertyPath), "", schemaDiff.NotDiff, schemaDiff, !negated, processor)
 	}
 
 	if schemaDiff.PropertiesDiff != nil {
 		for _, v := range schemaDiff.PropertiesDiff.Deleted {
+			if negated {
+				// the processors assume that the property constrains the accepted values rather than the rejected ones
+				continue
+			}
 			processor(propertyPath, v, schemaDiff.Base.Properties[v].Value, schemaDiff)
 		}
 		for i, v := range schemaDiff.PropertiesDiff.Modified {
-			processDeletedPropertiesDiff(propertyPath, i, v, schemaDiff, processor)
+			processDeletedPropertiesDiff(propertyPath, i, v, schemaDiff, negated, processor)
 		}
 	}
 }
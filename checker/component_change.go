@@ -95,6 +95,11 @@ func (c ComponentChange) GetSourceColumnEnd() int {
 	return c.SourceColumnEnd
 }
 
+// HasSourceLocation returns true if the change was located in the spec, so that its line and column are meaningful
+func (c ComponentChange) HasSourceLocation() bool {
+	return c.SourceFile != ""
+}
+
 func (c ComponentChange) SingleLineError(l Localizer, colorMode ColorMode) string {
 	const format = "%s, %s components/%s %s [%s]. %s"
 
@@ -1,10 +1,13 @@
 package checker
 
+import "github.com/tufin/oasdiff/load"
+
 type Config struct {
 	Checks              []BackwardCompatibilityCheck
 	MinSunsetBetaDays   int
 	MinSunsetStableDays int
 	LogLevelOverrides   map[string]Level
+	Locations           load.Locations
 }
 
 func (c *Config) getLogLevel(checkerId string, defaultLevel Level) Level {
@@ -13,3 +16,62 @@ func (c *Config) getLogLevel(checkerId string, defaultLevel Level) Level {
 	}
 	return defaultLevel
 }
+
+// WithLevelOverrides sets the level of specific checks, overriding both the default levels and the levels of included checks
+// Changes of checks that are overridden with NONE are dropped
+func (c *Config) WithLevelOverrides(levelOverrides map[string]Level) *Config {
+	if c.LogLevelOverrides == nil {
+		c.LogLevelOverrides = map[string]Level{}
+	}
+	for id, level := range levelOverrides {
+		c.LogLevelOverrides[id] = level
+	}
+	return c
+}
+
+// applyLevelOverrides sets the overridden levels on all changes, including those of checks that set their level without consulting the config
+func (c *Config) applyLevelOverrides(changes Changes) Changes {
+	if len(c.LogLevelOverrides) == 0 {
+		return changes
+	}
+
+	result := make(Changes, 0, len(changes))
+	for _, change := range changes {
+		level, ok := c.LogLevelOverrides[change.GetId()]
+		if !ok {
+			result = append(result, change)
+			continue
+		}
+		if level == NONE {
+			continue
+		}
+		result = append(result, withLevel(change, level))
+	}
+	return result
+}
+
+func withLevel(change Change, level Level) Change {
+	switch c := change.(type) {
+	case ApiChange:
+		c.Level = level
+		return c
+	case ComponentChange:
+		c.Level = level
+		return c
+	case SecurityChange:
+		c.Level = level
+		return c
+	case ServerChange:
+		c.Level = level
+		return c
+	}
+	return change
+}
+
+// getLocation returns the source location of the first element that has one
+func (c *Config) getLocation(elements ...any) *load.Location {
+	if c == nil {
+		return nil
+	}
+	return c.Locations.Get(elements...)
+}
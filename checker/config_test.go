@@ -0,0 +1,77 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/utils"
+)
+
+func getResponseStatusRemovedDiff(t *testing.T) (*diff.Diff, *diff.OperationsSourcesMap) {
+	t.Helper()
+
+	s1, err := open("../data/checker/response_status_base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/checker/response_status_base.yaml")
+	require.NoError(t, err)
+
+	delete(s2.Spec.Paths.Value("/api/v1.0/groups").Post.Responses.Map(), "200")
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, s2)
+	require.NoError(t, err)
+	return d, osm
+}
+
+func TestLevelOverrides_Downgrade(t *testing.T) {
+	d, osm := getResponseStatusRemovedDiff(t)
+
+	config := singleCheckConfig(checker.ResponseSuccessStatusUpdatedCheck).WithLevelOverrides(map[string]checker.Level{
+		checker.ResponseSuccessStatusRemovedId: checker.INFO,
+	})
+
+	errs := checker.CheckBackwardCompatibilityUntilLevel(config, d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.INFO, errs[0].GetLevel())
+
+	// breaking changes exclude INFO
+	require.Empty(t, checker.CheckBackwardCompatibility(config, d, osm))
+}
+
+func TestLevelOverrides_Off(t *testing.T) {
+	d, osm := getResponseStatusRemovedDiff(t)
+
+	config := singleCheckConfig(checker.ResponseSuccessStatusUpdatedCheck).WithLevelOverrides(map[string]checker.Level{
+		checker.ResponseSuccessStatusRemovedId: checker.NONE,
+	})
+
+	require.Empty(t, checker.CheckBackwardCompatibilityUntilLevel(config, d, osm, checker.INFO))
+}
+
+func TestLevelOverrides_OverrideIncludedCheck(t *testing.T) {
+	config := checker.GetAllChecks(utils.StringList{checker.ResponseNonSuccessStatusRemovedId}, checker.BetaDeprecationDays, checker.StableDeprecationDays).
+		WithLevelOverrides(map[string]checker.Level{checker.ResponseNonSuccessStatusRemovedId: checker.WARN})
+	require.Equal(t, checker.WARN, config.LogLevelOverrides[checker.ResponseNonSuccessStatusRemovedId])
+}
+
+func TestParseLevelOverrides(t *testing.T) {
+	overrides, err := checker.ParseLevelOverrides(utils.StringList{"endpoint-added=WARN", "response-success-status-removed=off", "api-tag-removed=info"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]checker.Level{
+		checker.EndpointAddedId:                checker.WARN,
+		checker.ResponseSuccessStatusRemovedId: checker.NONE,
+		checker.APITagRemovedId:                checker.INFO,
+	}, overrides)
+}
+
+func TestParseLevelOverrides_Invalid(t *testing.T) {
+	_, err := checker.ParseLevelOverrides(utils.StringList{"endpoint-added"})
+	require.EqualError(t, err, `invalid level override "endpoint-added", expected id=LEVEL`)
+
+	_, err = checker.ParseLevelOverrides(utils.StringList{"no-such-check=ERR"})
+	require.EqualError(t, err, `invalid level override "no-such-check=ERR", unknown check "no-such-check"`)
+
+	_, err = checker.ParseLevelOverrides(utils.StringList{"endpoint-added=FATAL"})
+	require.EqualError(t, err, `invalid level override "endpoint-added=FATAL", level must be one of ERR, WARN, INFO or OFF`)
+}
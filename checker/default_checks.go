@@ -2,6 +2,7 @@ package checker
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/tufin/oasdiff/utils"
 )
@@ -25,6 +26,32 @@ func LevelOverrides(includeChecks utils.StringList) map[string]Level {
 	return result
 }
 
+// ParseLevelOverrides parses a list of level overrides in the form of id=LEVEL, where LEVEL is ERR, WARN, INFO or OFF
+func ParseLevelOverrides(overrides utils.StringList) (map[string]Level, error) {
+	ids := utils.StringSet{}
+	for _, rule := range GetAllRules() {
+		ids.Add(rule.Id)
+	}
+
+	result := map[string]Level{}
+	for _, override := range overrides {
+		id, levelName, found := strings.Cut(override, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid level override %q, expected id=LEVEL", override)
+		}
+		id = strings.TrimSpace(id)
+		if !ids.Contains(id) {
+			return nil, fmt.Errorf("invalid level override %q, unknown check %q", override, id)
+		}
+		level, err := NewLevelOverride(strings.TrimSpace(levelName))
+		if err != nil {
+			return nil, fmt.Errorf("invalid level override %q, level must be one of ERR, WARN, INFO or OFF", override)
+		}
+		result[id] = level
+	}
+	return result, nil
+}
+
 func GetAllChecks(includeChecks utils.StringList, deprecationDaysBeta int, deprecationDaysStable int) *Config {
 	return getBackwardCompatibilityCheckConfig(allChecks(), LevelOverrides(includeChecks), deprecationDaysBeta, deprecationDaysStable)
 }
@@ -79,8 +106,14 @@ func allChecks() []BackwardCompatibilityCheck {
 	defaultChecks := defaultChecks()
 	optionalChecks := optionalChecks()
 
+	// optional checks with several rules must only run once
+	m := utils.StringSet{}
 	for _, v := range optionalChecks {
-		defaultChecks = append(defaultChecks, v)
+		pStr := fmt.Sprintf("%v", v)
+		if !m.Contains(pStr) {
+			m.Add(pStr)
+			defaultChecks = append(defaultChecks, v)
+		}
 	}
 	return defaultChecks
 }
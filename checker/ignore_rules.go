@@ -0,0 +1,237 @@
+package checker
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ignoreDateLayout   = "2006-01-02"
+	ignoreRegexPrefix  = "regex:"
+	ignoreSecurityName = "security"
+	ignoreServersName  = "servers"
+)
+
+// IgnoreRule describes changes to ignore in a structured ignore file
+// Rules match on the change id and attributes rather than on the localized text, so they are not affected by the language or by changes in the wording of messages
+// Each pattern is a glob where '*' matches any sequence of characters and '?' matches a single character, or a regular expression when prefixed with "regex:"
+// Empty patterns match anything
+type IgnoreRule struct {
+	Id          string   `yaml:"id"`
+	Method      string   `yaml:"method"`
+	Path        string   `yaml:"path"`
+	OperationId string   `yaml:"operationId"`
+	Component   string   `yaml:"component"`
+	Args        []string `yaml:"args"`
+	Reason      string   `yaml:"reason"`
+	Owner       string   `yaml:"owner"`
+	Expires     string   `yaml:"expires"`
+
+	matchers    []*regexp.Regexp
+	argMatchers []*regexp.Regexp
+	expires     time.Time
+}
+
+// IgnoreRules is a list of rules, loaded from a structured ignore file
+type IgnoreRules []*IgnoreRule
+
+// LoadIgnoreRules reads a YAML list of ignore rules and validates them
+func LoadIgnoreRules(file string) (IgnoreRules, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := IgnoreRules{}
+	if err := yaml.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+	}
+
+	for i, rule := range rules {
+		if rule == nil {
+			return nil, fmt.Errorf("rule #%d is empty", i+1)
+		}
+		if err := rule.init(); err != nil {
+			return nil, fmt.Errorf("rule #%d: %w", i+1, err)
+		}
+	}
+
+	return rules, nil
+}
+
+func (rule *IgnoreRule) init() error {
+	if rule.Id == "" {
+		return errors.New("id is required")
+	}
+	if rule.Reason == "" {
+		return errors.New("reason is required")
+	}
+	if rule.Owner == "" {
+		return errors.New("owner is required")
+	}
+
+	if rule.Expires != "" {
+		expires, err := time.Parse(ignoreDateLayout, rule.Expires)
+		if err != nil {
+			return fmt.Errorf("invalid expiration date %q, expected YYYY-MM-DD", rule.Expires)
+		}
+		// the rule applies until the end of the expiration day
+		rule.expires = expires.AddDate(0, 0, 1)
+	}
+
+	var err error
+	if rule.matchers, err = compileIgnorePatterns(rule.Id, rule.Method, rule.Path, rule.OperationId, rule.Component); err != nil {
+		return err
+	}
+	// the method is matched case-insensitively
+	if rule.matchers[1] != nil {
+		rule.matchers[1] = regexp.MustCompile("(?i)" + rule.matchers[1].String())
+	}
+	if rule.argMatchers, err = compileIgnorePatterns(rule.Args...); err != nil {
+		return err
+	}
+
+	if !matchesAnyRule(rule.matchers[0]) {
+		return fmt.Errorf("unknown check %q", rule.Id)
+	}
+
+	return nil
+}
+
+// matchesAnyRule returns true if the id pattern matches the id of any check, to catch typos
+func matchesAnyRule(matcher *regexp.Regexp) bool {
+	for _, rule := range GetAllRules() {
+		if matchIgnorePattern(matcher, rule.Id) {
+			return true
+		}
+	}
+	return false
+}
+
+func compileIgnorePatterns(patterns ...string) ([]*regexp.Regexp, error) {
+	result := make([]*regexp.Regexp, len(patterns))
+	for i, pattern := range patterns {
+		matcher, err := compileIgnorePattern(pattern)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = matcher
+	}
+	return result, nil
+}
+
+// compileIgnorePattern compiles a glob or a regular expression into a regular expression that matches the whole value
+func compileIgnorePattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+
+	if expr, ok := strings.CutPrefix(pattern, ignoreRegexPrefix); ok {
+		matcher, err := regexp.Compile("^(?:" + expr + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q: %w", expr, err)
+		}
+		return matcher, nil
+	}
+
+	var expr strings.Builder
+	expr.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			expr.WriteString(".*")
+		case '?':
+			expr.WriteString(".")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	expr.WriteString("$")
+	return regexp.MustCompile(expr.String()), nil
+}
+
+func matchIgnorePattern(matcher *regexp.Regexp, value string) bool {
+	return matcher == nil || matcher.MatchString(value)
+}
+
+// IsExpired returns true if the rule has an expiration date that has passed
+func (rule *IgnoreRule) IsExpired(now time.Time) bool {
+	return !rule.expires.IsZero() && !now.Before(rule.expires)
+}
+
+// Match returns true if the change matches all the patterns of the rule
+func (rule *IgnoreRule) Match(change Change) bool {
+	values := []string{change.GetId(), change.GetOperation(), change.GetPath(), change.GetOperationId(), getChangeComponent(change)}
+	for i, value := range values {
+		if !matchIgnorePattern(rule.matchers[i], value) {
+			return false
+		}
+	}
+
+	// args are matched by position
+	args := change.GetArgs()
+	if len(rule.argMatchers) > len(args) {
+		return false
+	}
+	for i, matcher := range rule.argMatchers {
+		if !matchIgnorePattern(matcher, fmt.Sprint(args[i])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func getChangeComponent(change Change) string {
+	switch c := change.(type) {
+	case ComponentChange:
+		return c.Component
+	case SecurityChange:
+		return ignoreSecurityName
+	case ServerChange:
+		return ignoreServersName
+	}
+	return ""
+}
+
+// Apply removes the changes that match any of the rules that haven't expired
+// It returns the remaining changes and the expired rules, which should be reported so that they can be removed or renewed
+func (rules IgnoreRules) Apply(changes Changes, now time.Time) (Changes, IgnoreRules) {
+	active := IgnoreRules{}
+	expired := IgnoreRules{}
+	for _, rule := range rules {
+		if rule.IsExpired(now) {
+			expired = append(expired, rule)
+		} else {
+			active = append(active, rule)
+		}
+	}
+
+	result := make(Changes, 0, len(changes))
+	for _, change := range changes {
+		if !active.match(change) {
+			result = append(result, change)
+		}
+	}
+
+	return result, expired
+}
+
+func (rules IgnoreRules) match(change Change) bool {
+	for _, rule := range rules {
+		if rule.Match(change) {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule *IgnoreRule) String() string {
+	return fmt.Sprintf("id: %q, owner: %q, reason: %q, expires: %s", rule.Id, rule.Owner, rule.Reason, rule.Expires)
+}
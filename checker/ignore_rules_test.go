@@ -0,0 +1,107 @@
+package checker_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+	"github.com/tufin/oasdiff/utils"
+)
+
+func writeIgnoreRules(t *testing.T, content string) string {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), "ignore.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(content), 0644))
+	return file
+}
+
+func getIgnoreRulesChanges(t *testing.T) checker.Changes {
+	t.Helper()
+
+	s1 := l(t, 1)
+	s2 := l(t, 3)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), &s1, &s2)
+	require.NoError(t, err)
+	return checker.CheckBackwardCompatibility(checker.GetChecks(utils.StringList{checker.APISchemasRemovedId}), d, osm)
+}
+
+func TestIgnoreRules(t *testing.T) {
+	errs := getIgnoreRulesChanges(t)
+	require.Len(t, errs, 12)
+
+	rules, err := checker.LoadIgnoreRules("../data/ignore-rules/example.yaml")
+	require.NoError(t, err)
+	require.Len(t, rules, 4)
+
+	errs, expired := rules.Apply(errs, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.Len(t, errs, 9)
+
+	// the expired rule no longer applies
+	require.Len(t, expired, 1)
+	require.Equal(t, "2020-01-01", expired[0].Expires)
+	require.Contains(t, errs, checker.ApiChange{
+		Id:          checker.RequestParameterRemovedId,
+		Args:        []any{"query", "filter"},
+		Level:       checker.WARN,
+		Operation:   "GET",
+		OperationId: "GetSecurityScore",
+		Path:        "/api/{domain}/{project}/badges/security-score",
+		Source:      load.NewSource("../data/openapi-test3.yaml"),
+	})
+}
+
+func TestIgnoreRules_Expires(t *testing.T) {
+	rules, err := checker.LoadIgnoreRules(writeIgnoreRules(t, "- id: '*'\n  reason: all\n  owner: me\n  expires: 2024-01-31\n"))
+	require.NoError(t, err)
+
+	require.False(t, rules[0].IsExpired(time.Date(2024, 1, 31, 23, 59, 0, 0, time.UTC)))
+	require.True(t, rules[0].IsExpired(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)))
+
+	errs, expired := rules.Apply(getIgnoreRulesChanges(t), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.Empty(t, errs)
+	require.Empty(t, expired)
+}
+
+func TestIgnoreRules_Regex(t *testing.T) {
+	rules, err := checker.LoadIgnoreRules(writeIgnoreRules(t, "- id: '*'\n  path: 'regex:/api/\\{domain\\}/.*/install-command'\n  reason: deprecated\n  owner: me\n"))
+	require.NoError(t, err)
+
+	errs, _ := rules.Apply(getIgnoreRulesChanges(t), time.Now())
+	require.Len(t, errs, 10)
+}
+
+func TestIgnoreRules_MissingId(t *testing.T) {
+	_, err := checker.LoadIgnoreRules(writeIgnoreRules(t, "- path: /api\n  reason: unused\n  owner: me\n"))
+	require.EqualError(t, err, "rule #1: id is required")
+}
+
+func TestIgnoreRules_UnknownId(t *testing.T) {
+	_, err := checker.LoadIgnoreRules(writeIgnoreRules(t, "- id: api-schema-removd\n  reason: unused\n  owner: me\n"))
+	require.EqualError(t, err, `rule #1: unknown check "api-schema-removd"`)
+}
+
+func TestIgnoreRules_MissingReason(t *testing.T) {
+	_, err := checker.LoadIgnoreRules(writeIgnoreRules(t, "- id: api-schema-removed\n  owner: me\n"))
+	require.EqualError(t, err, "rule #1: reason is required")
+}
+
+func TestIgnoreRules_MissingOwner(t *testing.T) {
+	_, err := checker.LoadIgnoreRules(writeIgnoreRules(t, "- id: api-schema-removed\n  reason: unused\n"))
+	require.EqualError(t, err, "rule #1: owner is required")
+}
+
+func TestIgnoreRules_InvalidExpires(t *testing.T) {
+	_, err := checker.LoadIgnoreRules(writeIgnoreRules(t, "- id: api-schema-removed\n  reason: unused\n  owner: me\n  expires: 31.01.2024\n"))
+	require.EqualError(t, err, `rule #1: invalid expiration date "31.01.2024", expected YYYY-MM-DD`)
+}
+
+func TestIgnoreRules_InvalidRegex(t *testing.T) {
+	_, err := checker.LoadIgnoreRules(writeIgnoreRules(t, "- id: '*'\n  path: 'regex:(('\n  reason: unused\n  owner: me\n"))
+	require.ErrorContains(t, err, `rule #1: invalid regular expression "(("`)
+}
@@ -16,11 +16,11 @@ func TestIgnore(t *testing.T) {
 	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), &s1, &s2)
 	require.NoError(t, err)
 	errs := checker.CheckBackwardCompatibility(checker.GetDefaultChecks(), d, osm)
-	require.Equal(t, 6, len(errs))
+	require.Equal(t, 10, len(errs))
 
 	errs, err = checker.ProcessIgnoredBackwardCompatibilityErrors(checker.ERR, errs, "../data/ignore-err-example.txt", checker.NewDefaultLocalizer())
 	require.NoError(t, err)
-	require.Equal(t, 5, len(errs))
+	require.Equal(t, 9, len(errs))
 }
 
 func TestIgnoreSubpath(t *testing.T) {
@@ -61,9 +61,9 @@ func TestIgnoreComponent(t *testing.T) {
 	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), &s1, &s2)
 	require.NoError(t, err)
 	errs := checker.CheckBackwardCompatibility(checker.GetChecks(utils.StringList{checker.APISchemasRemovedId}), d, osm)
-	require.Equal(t, 8, len(errs))
+	require.Equal(t, 12, len(errs))
 
 	errs, err = checker.ProcessIgnoredBackwardCompatibilityErrors(checker.ERR, errs, "../data/ignore-err-example.txt", checker.NewDefaultLocalizer())
 	require.NoError(t, err)
-	require.Equal(t, 5, len(errs))
+	require.Equal(t, 9, len(errs))
 }
@@ -0,0 +1,394 @@
+package checker
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/diff"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// IgnoreExtension suppresses changes to an element in the revision spec, along with all the elements under it
+	// The value is either a list of check ids, or an object with a list of check ids under "checks" and a "reason"
+	IgnoreExtension = "x-oasdiff-ignore"
+
+	requestChangePrefix          = "request-"
+	requestParameterChangePrefix = "request-parameter-"
+	responseChangePrefix         = "response-"
+)
+
+// nonBodyChangePrefixes are the prefixes of the ids of changes to parameters and headers, which share the prefixes of the changes to the request and response bodies
+var nonBodyChangePrefixes = []string{requestParameterChangePrefix, "request-header-", "request-cookie-", "response-header-"}
+
+// propertyChangeIdRegex matches the ids of changes to request and response properties, like request-property-removed or response-optional-write-only-property-added
+var propertyChangeIdRegex = regexp.MustCompile(`^(request|response)-((required|optional)-)?(write-only-)?property-`)
+
+// propertyArgIndexes are the positions of the property path in the args of changes to properties where it isn't the first arg
+// A negative position means that the change has no property path
+var propertyArgIndexes = map[string]int{
+	RequestPropertyAllOfAddedId:                   1,
+	RequestPropertyAllOfRemovedId:                 1,
+	RequestPropertyAnyOfAddedId:                   1,
+	RequestPropertyAnyOfRemovedId:                 1,
+	RequestPropertyOneOfAddedId:                   1,
+	RequestPropertyOneOfRemovedId:                 1,
+	RequestPropertyDiscriminatorMappingAddedId:    1,
+	RequestPropertyDiscriminatorMappingDeletedId:  1,
+	RequestPropertyDiscriminatorMappingChangedId:  3,
+	RequestPropertyEnumValueAddedId:               1,
+	RequestPropertyEnumValueRemovedId:             1,
+	RequestPropertyPatternAddedId:                 1,
+	RequestPropertyPatternRemovedId:               1,
+	RequestPropertyXExtensibleEnumValueRemovedId:  1,
+	ResponsePropertyAllOfAddedId:                  1,
+	ResponsePropertyAllOfRemovedId:                1,
+	ResponsePropertyAnyOfAddedId:                  1,
+	ResponsePropertyAnyOfRemovedId:                1,
+	ResponsePropertyOneOfAddedId:                  1,
+	ResponsePropertyOneOfRemovedId:                1,
+	ResponsePropertyDiscriminatorMappingAddedId:   1,
+	ResponsePropertyDiscriminatorMappingDeletedId: 1,
+	ResponsePropertyDiscriminatorMappingChangedId: 3,
+	ResponsePropertyEnumValueAddedId:              1,
+	ResponsePropertyEnumValueRemovedId:            1,
+	ResponseWriteOnlyPropertyEnumValueAddedId:     1,
+	ResponsePropertyTypeChangedId:                 -1,
+}
+
+// getPropertyArg returns the property path of a change to a request or response property
+func getPropertyArg(change ApiChange) (string, bool) {
+	if !propertyChangeIdRegex.MatchString(change.Id) {
+		return "", false
+	}
+
+	index, ok := propertyArgIndexes[change.Id]
+	if !ok {
+		index = 0
+	}
+	if index < 0 || index >= len(change.Args) {
+		return "", false
+	}
+
+	propertyPath, ok := change.Args[index].(string)
+	return propertyPath, ok
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// inlineIgnore is the value of the x-oasdiff-ignore extension
+type inlineIgnore struct {
+	Checks []string `json:"checks"`
+	Reason string   `json:"reason"`
+}
+
+func (ignore *inlineIgnore) UnmarshalJSON(data []byte) error {
+	// short form: a list of check ids
+	if err := json.Unmarshal(data, &ignore.Checks); err == nil {
+		return nil
+	}
+
+	type plain inlineIgnore
+	if err := json.Unmarshal(data, (*plain)(ignore)); err != nil {
+		return fmt.Errorf("unparseable %s, expected a list of check ids or an object with checks and reason", IgnoreExtension)
+	}
+	return nil
+}
+
+// getInlineIgnore returns the check ids listed in the x-oasdiff-ignore extension, if any
+func getInlineIgnore(extensions map[string]any) ([]string, error) {
+	value, ok := extensions[IgnoreExtension]
+	if !ok || value == nil {
+		return nil, nil
+	}
+
+	data, ok := value.(json.RawMessage)
+	if !ok {
+		var err error
+		if data, err = json.Marshal(value); err != nil {
+			return nil, fmt.Errorf("unparseable %s", IgnoreExtension)
+		}
+	}
+
+	ignore := inlineIgnore{}
+	if err := json.Unmarshal(data, &ignore); err != nil {
+		return nil, err
+	}
+	return ignore.Checks, nil
+}
+
+// inlineIgnoreScope describes the changes that are suppressed by an x-oasdiff-ignore extension on a specific element
+type inlineIgnoreScope struct {
+	checks    []string
+	path      string
+	operation string // empty for path items
+
+	// parameters
+	parameterIn   string
+	parameterName string
+
+	// request body and response schemas
+	changePrefix string
+	property     []string // nil for the root schema
+}
+
+func (scope *inlineIgnoreScope) match(change ApiChange) bool {
+	if !slices.Contains(scope.checks, change.Id) ||
+		scope.path != change.Path ||
+		scope.operation != "" && scope.operation != change.Operation {
+		return false
+	}
+
+	if scope.parameterName != "" {
+		return strings.HasPrefix(change.Id, requestParameterChangePrefix) &&
+			len(change.Args) >= 2 &&
+			fmt.Sprint(change.Args[0]) == scope.parameterIn &&
+			fmt.Sprint(change.Args[1]) == scope.parameterName
+	}
+
+	if scope.changePrefix != "" {
+		if !strings.HasPrefix(change.Id, scope.changePrefix) || hasAnyPrefix(change.Id, nonBodyChangePrefixes) {
+			return false
+		}
+		if scope.property == nil {
+			return true
+		}
+		propertyPath, ok := getPropertyArg(change)
+		return ok && matchPropertyPath(scope.property, propertyPath)
+	}
+
+	return true
+}
+
+// matchPropertyPath checks if a property path, like data/items/name, is the given property or under it
+// Pattern segments that are empty match a single subschema segment, like allOf[...]
+func matchPropertyPath(pattern []string, propertyPath string) bool {
+	segments := splitPropertyPath(propertyPath)
+	if len(segments) < len(pattern) {
+		return false
+	}
+
+	for i, segment := range pattern {
+		if segment == "" {
+			if !isSubschemaSegment(segments[i]) {
+				return false
+			}
+			continue
+		}
+		if segment != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func splitPropertyPath(propertyPath string) []string {
+	result := []string{}
+	for _, segment := range strings.Split(propertyPath, "/") {
+		if segment != "" {
+			result = append(result, segment)
+		}
+	}
+	return result
+}
+
+func isSubschemaSegment(segment string) bool {
+	return strings.HasPrefix(segment, "allOf[") || strings.HasPrefix(segment, "anyOf[") || strings.HasPrefix(segment, "oneOf[")
+}
+
+// inlineIgnoreCollector walks the revision spec and collects the scopes of x-oasdiff-ignore extensions
+type inlineIgnoreCollector struct {
+	config *Config
+	scopes []*inlineIgnoreScope
+	errs   Changes
+
+	// annotated caches whether a schema or any of its subschemas has the extension, to avoid walking unannotated schemas
+	annotated map[*openapi3.Schema]bool
+}
+
+func (collector *inlineIgnoreCollector) add(extensions map[string]any, scope inlineIgnoreScope, operation string, operationItem *openapi3.Operation, source string) {
+	checks, err := getInlineIgnore(extensions)
+	if err != nil {
+		if operationItem == nil {
+			operationItem = &openapi3.Operation{}
+		}
+		collector.errs = newParsingError(collector.config, collector.errs, err, operation, operationItem, scope.path, source)
+		return
+	}
+	if len(checks) == 0 {
+		return
+	}
+
+	scope.checks = checks
+	collector.scopes = append(collector.scopes, &scope)
+}
+
+func (collector *inlineIgnoreCollector) collectPaths(paths *openapi3.Paths, operationsSources *diff.OperationsSourcesMap) {
+	for path, pathItem := range paths.Map() {
+		collector.add(pathItem.Extensions, inlineIgnoreScope{path: path}, "", nil, "")
+
+		for operation, operationItem := range pathItem.Operations() {
+			source := ""
+			if operationsSources != nil {
+				source = (*operationsSources)[operationItem]
+			}
+			collector.collectOperation(path, operation, operationItem, pathItem.Parameters, source)
+		}
+	}
+}
+
+func (collector *inlineIgnoreCollector) collectOperation(path, operation string, operationItem *openapi3.Operation, pathParameters openapi3.Parameters, source string) {
+	collector.add(operationItem.Extensions, inlineIgnoreScope{path: path, operation: operation}, operation, operationItem, source)
+
+	for _, parameters := range []openapi3.Parameters{pathParameters, operationItem.Parameters} {
+		for _, parameterRef := range parameters {
+			if parameterRef == nil || parameterRef.Value == nil {
+				continue
+			}
+			parameter := parameterRef.Value
+			collector.add(parameter.Extensions, inlineIgnoreScope{
+				path:          path,
+				operation:     operation,
+				parameterIn:   parameter.In,
+				parameterName: parameter.Name,
+			}, operation, operationItem, source)
+		}
+	}
+
+	if operationItem.RequestBody != nil && operationItem.RequestBody.Value != nil {
+		for _, mediaType := range operationItem.RequestBody.Value.Content {
+			collector.collectSchema(mediaType.Schema, inlineIgnoreScope{path: path, operation: operation, changePrefix: requestChangePrefix}, operation, operationItem, source, map[*openapi3.Schema]bool{})
+		}
+	}
+
+	if operationItem.Responses != nil {
+		for _, responseRef := range operationItem.Responses.Map() {
+			if responseRef == nil || responseRef.Value == nil {
+				continue
+			}
+			for _, mediaType := range responseRef.Value.Content {
+				collector.collectSchema(mediaType.Schema, inlineIgnoreScope{path: path, operation: operation, changePrefix: responseChangePrefix}, operation, operationItem, source, map[*openapi3.Schema]bool{})
+			}
+		}
+	}
+}
+
+// collectSchema collects the extensions of a schema and of its properties, using the same property paths as the property checks
+func (collector *inlineIgnoreCollector) collectSchema(schemaRef *openapi3.SchemaRef, scope inlineIgnoreScope, operation string, operationItem *openapi3.Operation, source string, visited map[*openapi3.Schema]bool) {
+	if schemaRef == nil || schemaRef.Value == nil || visited[schemaRef.Value] || !collector.isAnnotated(schemaRef.Value) {
+		return
+	}
+	schema := schemaRef.Value
+	visited[schema] = true
+	defer delete(visited, schema)
+
+	collector.add(schema.Extensions, scope, operation, operationItem, source)
+
+	child := func(segments ...string) inlineIgnoreScope {
+		result := scope
+		result.property = append(append([]string{}, scope.property...), segments...)
+		return result
+	}
+
+	for name, propertyRef := range schema.Properties {
+		collector.collectSchema(propertyRef, child(name), operation, operationItem, source, visited)
+	}
+	collector.collectSchema(schema.Items, child("items"), operation, operationItem, source, visited)
+	collector.collectSchema(schema.AdditionalProperties.Schema, child("additionalProperties"), operation, operationItem, source, visited)
+	collector.collectSchema(schema.Not, child("not"), operation, operationItem, source, visited)
+	for _, subschemas := range []openapi3.SchemaRefs{schema.AllOf, schema.AnyOf, schema.OneOf} {
+		for _, subschema := range subschemas {
+			// subschemas are identified by the diff, so any subschema segment matches
+			collector.collectSchema(subschema, child(""), operation, operationItem, source, visited)
+		}
+	}
+}
+
+func (collector *inlineIgnoreCollector) isAnnotated(schema *openapi3.Schema) bool {
+	result, _ := collector.isAnnotatedRec(schema, map[*openapi3.Schema]bool{})
+	return result
+}
+
+// isAnnotatedRec also returns whether the result is final, which isn't the case when it depends on a schema that is still being visited because of a cycle
+func (collector *inlineIgnoreCollector) isAnnotatedRec(schema *openapi3.Schema, visiting map[*openapi3.Schema]bool) (bool, bool) {
+	if result, ok := collector.annotated[schema]; ok {
+		return result, true
+	}
+	if visiting[schema] {
+		return false, false
+	}
+	visiting[schema] = true
+	defer delete(visiting, schema)
+
+	if schema.Extensions[IgnoreExtension] != nil {
+		collector.annotated[schema] = true
+		return true, true
+	}
+
+	subschemas := []*openapi3.SchemaRef{schema.Items, schema.AdditionalProperties.Schema, schema.Not}
+	for _, propertyRef := range schema.Properties {
+		subschemas = append(subschemas, propertyRef)
+	}
+	subschemas = append(append(append(subschemas, schema.AllOf...), schema.AnyOf...), schema.OneOf...)
+
+	final := true
+	for _, subschema := range subschemas {
+		if subschema == nil || subschema.Value == nil {
+			continue
+		}
+		result, subschemaFinal := collector.isAnnotatedRec(subschema.Value, visiting)
+		if result {
+			collector.annotated[schema] = true
+			return true, true
+		}
+		final = final && subschemaFinal
+	}
+
+	if final {
+		collector.annotated[schema] = false
+	}
+	return false, final
+}
+
+// removeInlineIgnored removes the changes suppressed by x-oasdiff-ignore extensions in the revision spec
+// Invalid extensions are reported as parsing errors
+func removeInlineIgnored(config *Config, diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, changes Changes) Changes {
+	if diffReport.PathsDiff == nil || diffReport.PathsDiff.Revision == nil {
+		return changes
+	}
+
+	collector := inlineIgnoreCollector{config: config, annotated: map[*openapi3.Schema]bool{}}
+	collector.collectPaths(diffReport.PathsDiff.Revision, operationsSources)
+
+	if len(collector.scopes) == 0 {
+		return append(changes, collector.errs...)
+	}
+
+	result := make(Changes, 0, len(changes))
+	for _, change := range changes {
+		if apiChange, ok := change.(ApiChange); ok && collector.match(apiChange) {
+			continue
+		}
+		result = append(result, change)
+	}
+	return append(result, collector.errs...)
+}
+
+func (collector *inlineIgnoreCollector) match(change ApiChange) bool {
+	for _, scope := range collector.scopes {
+		if scope.match(change) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,146 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+func getInlineIgnoreChanges(t *testing.T, revision *load.SpecInfo) checker.Changes {
+	t.Helper()
+
+	s1, err := open("../data/inline-ignore/base.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getConfig(), s1, revision)
+	require.NoError(t, err)
+	return checker.CheckBackwardCompatibilityUntilLevel(checker.GetDefaultChecks(), d, osm, checker.INFO)
+}
+
+// x-oasdiff-ignore suppresses the listed changes under the annotated operation, parameter or schema
+func TestInlineIgnore(t *testing.T) {
+	s2, err := open("../data/inline-ignore/revision.yaml")
+	require.NoError(t, err)
+
+	errs := getInlineIgnoreChanges(t, s2)
+	require.Len(t, errs, 2)
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestParameterTypeChangedId,
+		Args:        []any{"query", "sort", "string", "", "integer", ""},
+		Level:       checker.ERR,
+		Operation:   "GET",
+		OperationId: "listPets",
+		Path:        "/pets",
+		Source:      load.NewSource("../data/inline-ignore/revision.yaml"),
+	}, errs[0])
+	require.Equal(t, checker.ApiChange{
+		Id:          checker.RequestPropertyRemovedId,
+		Args:        []any{"tag"},
+		Level:       checker.WARN,
+		Operation:   "POST",
+		OperationId: "createPet",
+		Path:        "/pets",
+		Source:      load.NewSource("../data/inline-ignore/revision.yaml"),
+	}, errs[1])
+}
+
+// without x-oasdiff-ignore all changes are reported
+func TestInlineIgnore_NotAnnotated(t *testing.T) {
+	s2, err := open("../data/inline-ignore/revision.yaml")
+	require.NoError(t, err)
+
+	delete(s2.Spec.Paths.Value("/pets").Get.Extensions, checker.IgnoreExtension)
+	delete(s2.Spec.Paths.Value("/pets").Get.Parameters.GetByInAndName("query", "filter").Extensions, checker.IgnoreExtension)
+	delete(s2.Spec.Paths.Value("/pets").Post.RequestBody.Value.Content["application/json"].Schema.Value.Properties["owner"].Value.Extensions, checker.IgnoreExtension)
+	delete(s2.Spec.Components.Schemas["Pet"].Value.Extensions, checker.IgnoreExtension)
+
+	require.Len(t, getInlineIgnoreChanges(t, s2), 6)
+}
+
+// x-oasdiff-ignore only suppresses the listed checks
+func TestInlineIgnore_OtherCheck(t *testing.T) {
+	s2, err := open("../data/inline-ignore/revision.yaml")
+	require.NoError(t, err)
+
+	s2.Spec.Paths.Value("/pets").Get.Extensions[checker.IgnoreExtension] = []any{checker.EndpointAddedId}
+
+	errs := getInlineIgnoreChanges(t, s2)
+	require.Len(t, errs, 3)
+	require.Contains(t, errs, checker.ApiChange{
+		Id:          checker.RequestParameterRemovedId,
+		Args:        []any{"query", "limit"},
+		Level:       checker.WARN,
+		Operation:   "GET",
+		OperationId: "listPets",
+		Path:        "/pets",
+		Source:      load.NewSource("../data/inline-ignore/revision.yaml"),
+	})
+}
+
+// x-oasdiff-ignore on a property doesn't suppress changes to other properties whose args mention it
+func TestInlineIgnore_OtherProperty(t *testing.T) {
+	s2, err := open("../data/inline-ignore/revision.yaml")
+	require.NoError(t, err)
+
+	schema := s2.Spec.Paths.Value("/pets").Post.RequestBody.Value.Content["application/json"].Schema.Value
+	schema.Properties["owner"].Value.Extensions[checker.IgnoreExtension] = []any{checker.RequestPropertyPatternAddedId, checker.RequestPropertyRemovedId}
+	schema.Properties["tag"] = openapi3.NewSchemaRef("", openapi3.NewStringSchema().WithPattern("owner"))
+
+	errs := getInlineIgnoreChanges(t, s2)
+	require.Contains(t, errs, checker.ApiChange{
+		Id:          checker.RequestPropertyPatternAddedId,
+		Args:        []any{"owner", "tag"},
+		Level:       checker.WARN,
+		Comment:     checker.PatternChangedCommentId,
+		Operation:   "POST",
+		OperationId: "createPet",
+		Path:        "/pets",
+		Source:      load.NewSource("../data/inline-ignore/revision.yaml"),
+	})
+}
+
+// x-oasdiff-ignore on a request body schema doesn't suppress changes to the parameters of the operation
+func TestInlineIgnore_BodyDoesntSuppressParameters(t *testing.T) {
+	s2, err := open("../data/inline-ignore/revision.yaml")
+	require.NoError(t, err)
+
+	schema := openapi3.NewObjectSchema()
+	schema.Extensions = map[string]any{checker.IgnoreExtension: []any{checker.RequestParameterTypeChangedId}}
+	s2.Spec.Paths.Value("/pets").Get.RequestBody = &openapi3.RequestBodyRef{
+		Value: openapi3.NewRequestBody().WithJSONSchema(schema),
+	}
+
+	errs := getInlineIgnoreChanges(t, s2)
+	require.Contains(t, errs, checker.ApiChange{
+		Id:          checker.RequestParameterTypeChangedId,
+		Args:        []any{"query", "sort", "string", "", "integer", ""},
+		Level:       checker.ERR,
+		Operation:   "GET",
+		OperationId: "listPets",
+		Path:        "/pets",
+		Source:      load.NewSource("../data/inline-ignore/revision.yaml"),
+	})
+}
+
+// an invalid x-oasdiff-ignore is reported as a parsing error
+func TestInlineIgnore_Invalid(t *testing.T) {
+	s2, err := open("../data/inline-ignore/revision.yaml")
+	require.NoError(t, err)
+
+	s2.Spec.Paths.Value("/pets").Get.Extensions[checker.IgnoreExtension] = "all"
+
+	errs := getInlineIgnoreChanges(t, s2)
+	require.Contains(t, errs, checker.ApiChange{
+		Id:          checker.ParseErrorId,
+		Args:        []any{"unparseable x-oasdiff-ignore, expected a list of check ids or an object with checks and reason"},
+		Level:       checker.ERR,
+		Operation:   "GET",
+		OperationId: "listPets",
+		Path:        "/pets",
+		Source:      load.NewSource("../data/inline-ignore/revision.yaml"),
+	})
+}
@@ -2,6 +2,7 @@ package checker
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/TwiN/go-color"
 )
@@ -12,6 +13,8 @@ const (
 	ERR  Level = 3
 	WARN Level = 2
 	INFO Level = 1
+	// NONE turns a check off when used as a level override
+	NONE Level = 0
 )
 
 func NewLevel(level string) (Level, error) {
@@ -26,6 +29,14 @@ func NewLevel(level string) (Level, error) {
 	return INFO, fmt.Errorf("invalid level %s", level)
 }
 
+// NewLevelOverride parses the level of a check override: ERR, WARN, INFO or OFF
+func NewLevelOverride(level string) (Level, error) {
+	if strings.EqualFold(level, "OFF") {
+		return NONE, nil
+	}
+	return NewLevel(strings.ToUpper(level))
+}
+
 func (level Level) StringCond(colorMode ColorMode) string {
 	if isColorEnabled(colorMode) {
 		return level.PrettyString()
@@ -41,6 +52,8 @@ func (level Level) String() string {
 		return "warning"
 	case INFO:
 		return "info"
+	case NONE:
+		return "off"
 	default:
 		return "issue"
 	}
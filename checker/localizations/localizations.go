@@ -16,6 +16,12 @@ var localizations = map[string]string{
 	"en.messages.added-required-request-body-description":                    "required request body added",
 	"en.messages.api-deprecated-sunset-parse":                                "api sunset date %s can't be parsed for deprecated API: %v",
 	"en.messages.api-deprecated-sunset-parse-description":                    "endpoint deprecated with invalid or missing sunset date",
+	"en.messages.api-example-component-added":                                "the component example %s was added",
+	"en.messages.api-example-component-added-description":                    "example added in components/examples",
+	"en.messages.api-example-component-removed":                              "the component example %s was removed",
+	"en.messages.api-example-component-removed-description":                  "example deleted from components/examples",
+	"en.messages.api-example-component-value-changed":                        "the value of the component example %s was changed",
+	"en.messages.api-example-component-value-changed-description":            "example value modified in components/examples",
 	"en.messages.api-global-security-added":                                  "the security scheme %s was added to the API",
 	"en.messages.api-global-security-added-description":                      "security scheme added in security",
 	"en.messages.api-global-security-removed":                                "the security scheme %s was removed from the API",
@@ -24,14 +30,46 @@ var localizations = map[string]string{
 	"en.messages.api-global-security-scope-added-description":                "scope added to a security scheme in security",
 	"en.messages.api-global-security-scope-removed":                          "the security scope %s was removed from the global security scheme %s",
 	"en.messages.api-global-security-scope-removed-description":              "scope deleted from a security scheme in security",
+	"en.messages.api-link-component-added":                                   "the component link %s was added",
+	"en.messages.api-link-component-added-description":                       "link added in components/links",
+	"en.messages.api-link-component-parameter-added":                         "added the parameter %s to the component link %s",
+	"en.messages.api-link-component-parameter-added-description":             "link parameter added in components/links",
+	"en.messages.api-link-component-parameter-changed":                       "the parameter %s of the component link %s was changed from %s to %s",
+	"en.messages.api-link-component-parameter-changed-description":           "link parameter modified in components/links",
+	"en.messages.api-link-component-parameter-removed":                       "removed the parameter %s from the component link %s",
+	"en.messages.api-link-component-parameter-removed-description":           "link parameter deleted in components/links",
+	"en.messages.api-link-component-removed":                                 "the component link %s was removed",
+	"en.messages.api-link-component-removed-description":                     "link deleted from components/links",
+	"en.messages.api-link-component-target-changed":                          "the target of the component link %s was changed from %s to %s",
+	"en.messages.api-link-component-target-changed-description":              "link target modified in components/links",
 	"en.messages.api-operation-id-added":                                     "api operation id %s was added",
 	"en.messages.api-operation-id-added-description":                         "operation ID added to an endpoint",
 	"en.messages.api-operation-id-removed":                                   "api operation id %s removed and replaced with %s",
 	"en.messages.api-operation-id-removed-description":                       "operation ID deleted from an endpoint",
+	"en.messages.api-operation-server-added":                                 "added the operation server %s",
+	"en.messages.api-operation-server-added-description":                     "operation server added",
+	"en.messages.api-operation-server-removed":                               "removed the operation server %s",
+	"en.messages.api-operation-server-removed-description":                   "operation server removed",
+	"en.messages.api-operation-server-url-changed":                           "the operation server URL was changed from %s to %s",
+	"en.messages.api-operation-server-url-changed-description":               "operation server URL changed",
+	"en.messages.api-operation-server-variable-default-changed":              "the operation server %s variable %s default value was changed from %s to %s",
+	"en.messages.api-operation-server-variable-default-changed-description":  "operation server variable default value changed",
+	"en.messages.api-operation-server-variable-enum-value-removed":           "the operation server %s variable %s enum value %s was removed",
+	"en.messages.api-operation-server-variable-enum-value-removed-description": "operation server variable enum value removed",
 	"en.messages.api-path-removed-before-sunset":                             "api path removed before the sunset date %s",
 	"en.messages.api-path-removed-before-sunset-description":                 "path and endpoint deleted before sunset date",
 	"en.messages.api-path-removed-without-deprecation":                       "api path removed without deprecation",
 	"en.messages.api-path-removed-without-deprecation-description":           "path and endpoint deleted without deprecation",
+	"en.messages.api-path-server-added":                                      "added the path server %s",
+	"en.messages.api-path-server-added-description":                          "path server added",
+	"en.messages.api-path-server-removed":                                    "removed the path server %s",
+	"en.messages.api-path-server-removed-description":                        "path server removed",
+	"en.messages.api-path-server-url-changed":                                "the path server URL was changed from %s to %s",
+	"en.messages.api-path-server-url-changed-description":                    "path server URL changed",
+	"en.messages.api-path-server-variable-default-changed":                   "the path server %s variable %s default value was changed from %s to %s",
+	"en.messages.api-path-server-variable-default-changed-description":       "path server variable default value changed",
+	"en.messages.api-path-server-variable-enum-value-removed":                "the path server %s variable %s enum value %s was removed",
+	"en.messages.api-path-server-variable-enum-value-removed-description":    "path server variable enum value removed",
 	"en.messages.api-path-sunset-parse-description":                          "path and endpoint deleted with invalid or missing sunset date",
 	"en.messages.api-removed-before-sunset":                                  "api removed before the sunset date %s",
 	"en.messages.api-removed-before-sunset-description":                      "endpoint deleted before sunset date",
@@ -64,6 +102,16 @@ var localizations = map[string]string{
 	"en.messages.api-security-scope-removed":                                 "the security scope %s was removed from the endpoint's security scheme %s",
 	"en.messages.api-security-scope-removed-description":                     "scope deleted from an endpoint's security scheme",
 	"en.messages.api-security-updated":                                       "the endpoint scheme security %s was updated from %s to %s",
+	"en.messages.api-server-added":                                           "added the server %s",
+	"en.messages.api-server-added-description":                               "server added",
+	"en.messages.api-server-removed":                                         "removed the server %s",
+	"en.messages.api-server-removed-description":                             "server removed",
+	"en.messages.api-server-url-changed":                                     "the server URL was changed from %s to %s",
+	"en.messages.api-server-url-changed-description":                         "server URL changed",
+	"en.messages.api-server-variable-default-changed":                        "the server %s variable %s default value was changed from %s to %s",
+	"en.messages.api-server-variable-default-changed-description":            "server variable default value changed",
+	"en.messages.api-server-variable-enum-value-removed":                     "the server %s variable %s enum value %s was removed",
+	"en.messages.api-server-variable-enum-value-removed-description":         "server variable enum value removed",
 	"en.messages.api-stability-decreased-description":                        "endpoint stability level decreased",
 	"en.messages.api-sunset-date-changed-too-small":                          "api sunset date changed to earlier date from %s to %s, new sunset date must be not earlier than %s at least %s days from now",
 	"en.messages.api-sunset-date-changed-too-small-description":              "modified sunset date doesn't meet min required deprecation days",
@@ -74,6 +122,32 @@ var localizations = map[string]string{
 	"en.messages.api-tag-removed":                                            "api tag %s removed",
 	"en.messages.api-tag-removed-description":                                "endpoint tag deleted",
 	"en.messages.at":                                                                  "at",
+	"en.messages.callback-added":                                                      "added the callback %s",
+	"en.messages.callback-added-description":                                          "callback added",
+	"en.messages.callback-operation-added":                                            "added the callback %s operation %s %s",
+	"en.messages.callback-operation-added-description":                                "operation added to a callback",
+	"en.messages.callback-operation-removed":                                          "removed the callback %s operation %s %s",
+	"en.messages.callback-operation-removed-description":                              "operation removed from a callback",
+	"en.messages.callback-removed":                                                    "removed the callback %s",
+	"en.messages.callback-removed-description":                                        "callback removed",
+	"en.messages.callback-request-new-optional-property":                              "added the new optional request property %s to the callback %s %s %s",
+	"en.messages.callback-request-new-optional-property-description":                  "new optional property added to a callback request",
+	"en.messages.callback-request-new-required-property":                              "added the new required request property %s to the callback %s %s %s",
+	"en.messages.callback-request-new-required-property-description":                  "new required property added to a callback request",
+	"en.messages.callback-request-optional-property-removed":                          "removed the optional request property %s from the callback %s %s %s",
+	"en.messages.callback-request-optional-property-removed-description":              "optional property removed from a callback request",
+	"en.messages.callback-request-property-type-changed":                              "changed the type of the request property %s from %s to %s in the callback %s %s %s",
+	"en.messages.callback-request-property-type-changed-description":                  "callback request property type changed",
+	"en.messages.callback-request-required-property-removed":                          "removed the required request property %s from the callback %s %s %s",
+	"en.messages.callback-request-required-property-removed-description":              "required property removed from a callback request",
+	"en.messages.callback-response-new-required-property":                             "added the new required response property %s with the status %s to the callback %s %s %s",
+	"en.messages.callback-response-new-required-property-description":                 "new required property added to a callback response",
+	"en.messages.callback-response-property-became-required":                          "the response property %s with the status %s became required in the callback %s %s %s",
+	"en.messages.callback-response-property-became-required-description":              "callback response property became required",
+	"en.messages.callback-response-success-status-added":                              "added the success response with the status %s to the callback %s %s %s",
+	"en.messages.callback-response-success-status-added-description":                  "success status added to a callback response",
+	"en.messages.callback-response-success-status-removed":                            "removed the success response with the status %s from the callback %s %s %s",
+	"en.messages.callback-response-success-status-removed-description":                "success status removed from a callback response",
 	"en.messages.endpoint-added":                                                      "endpoint added",
 	"en.messages.endpoint-added-description":                                          "endpoint added",
 	"en.messages.endpoint-deprecated":                                                 "endpoint deprecated",
@@ -89,6 +163,8 @@ var localizations = map[string]string{
 	"en.messages.new-optional-request-property-description":                           "optional property added to request",
 	"en.messages.new-request-path-parameter":                                          "added the new path request parameter %s",
 	"en.messages.new-request-path-parameter-description":                              "new request path parameter",
+	"en.messages.new-required-request-cookie-property":                                "added the new required %s request cookie's property %s",
+	"en.messages.new-required-request-cookie-property-description":                    "new required request cookie property",
 	"en.messages.new-required-request-default-parameter-to-existing-path":             "added the new required %s request parameter %s to all path's operations",
 	"en.messages.new-required-request-default-parameter-to-existing-path-description": "required request parameter added at path level",
 	"en.messages.new-required-request-header-property":                                "added the new required %s request header's property %s",
@@ -101,6 +177,11 @@ var localizations = map[string]string{
 	"en.messages.optional-response-header-removed-description":                        "optional response header deleted",
 	"en.messages.parsing-error-description":                                           "invalid stability level",
 	"en.messages.pattern-changed-warn-comment":                                        "This is a warning because it is difficult to automatically analyze if the new pattern is a superset of the previous pattern (e.g. changed from '[0-9]+' to '[0-9]*')",
+	"en.messages.pattern-incomparable-comment":                                        "Each of the patterns matches values that the other pattern doesn't match",
+	"en.messages.request-body-additional-properties-disallowed":                       "the request's body no longer allows additional properties",
+	"en.messages.request-body-additional-properties-disallowed-description":           "additional properties disallowed in request body",
+	"en.messages.request-body-additional-properties-narrowed":                         "the request's body now restricts additional properties with a schema",
+	"en.messages.request-body-additional-properties-narrowed-description":             "additional properties restricted with a schema in request body",
 	"en.messages.request-body-all-of-added":                                           "added %s to the request body 'allOf' list",
 	"en.messages.request-body-all-of-added-description":                               "sub-schema added to allOf in request body",
 	"en.messages.request-body-all-of-removed":                                         "removed %s from the request body 'allOf' list",
@@ -137,8 +218,28 @@ var localizations = map[string]string{
 	"en.messages.request-body-discriminator-property-name-changed-description":        "request body discriminator property name changed",
 	"en.messages.request-body-discriminator-removed":                                  "removed request discriminator",
 	"en.messages.request-body-discriminator-removed-description":                      "request body discriminator deleted",
+	"en.messages.request-body-encoding-allow-reserved-added":                          "reserved characters are now allowed without percent-encoding in the request body part %s in the media type %s",
+	"en.messages.request-body-encoding-allow-reserved-added-description":              "request body part reserved characters allowed",
+	"en.messages.request-body-encoding-allow-reserved-removed":                        "reserved characters are no longer allowed without percent-encoding in the request body part %s in the media type %s",
+	"en.messages.request-body-encoding-allow-reserved-removed-description":            "request body part reserved characters disallowed",
+	"en.messages.request-body-encoding-content-type-changed":                          "the content type of the request body part %s was changed from %s to %s in the media type %s",
+	"en.messages.request-body-encoding-content-type-changed-description":              "request body part content type changed",
+	"en.messages.request-body-encoding-explode-changed":                               "the explode of the request body part %s was changed from %s to %s in the media type %s",
+	"en.messages.request-body-encoding-explode-changed-description":                   "request body part explode changed",
+	"en.messages.request-body-encoding-header-became-required":                        "the request body part %s header %s became required in the media type %s",
+	"en.messages.request-body-encoding-header-became-required-description":            "request body part header became required",
+	"en.messages.request-body-encoding-new-required-header":                           "the request body part %s has the new required header %s in the media type %s",
+	"en.messages.request-body-encoding-new-required-header-description":               "request body part new required header",
+	"en.messages.request-body-encoding-style-changed":                                 "the style of the request body part %s was changed from %s to %s in the media type %s",
+	"en.messages.request-body-encoding-style-changed-description":                     "request body part style changed",
 	"en.messages.request-body-enum-value-removed":                                     "request body enum value removed %s",
 	"en.messages.request-body-enum-value-removed-description":                         "request body enum value deleted",
+	"en.messages.request-body-example-invalid":                                        "the request body media type %s example %s doesn't conform to its schema (%s)",
+	"en.messages.request-body-example-invalid-description":                            "request body example doesn't conform to the schema",
+	"en.messages.request-body-exclusive-max-set":                                      "the request's body max %s became exclusive",
+	"en.messages.request-body-exclusive-max-set-description":                          "request body max became exclusive",
+	"en.messages.request-body-exclusive-min-set":                                      "the request's body min %s became exclusive",
+	"en.messages.request-body-exclusive-min-set-description":                          "request body min became exclusive",
 	"en.messages.request-body-max-decreased":                                          "the request's body max was decreased to %s",
 	"en.messages.request-body-max-decreased-description":                              "request body max decreased",
 	"en.messages.request-body-max-increased":                                          "the request's body max was increased from %s to %s",
@@ -150,11 +251,24 @@ var localizations = map[string]string{
 	"en.messages.request-body-max-length-set":                                         "the request's body maxLength was set to %s",
 	"en.messages.request-body-max-length-set-comment":                                 "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
 	"en.messages.request-body-max-length-set-description":                             "request body max length set",
+	"en.messages.request-body-max-properties-decreased":                               "the request's body maxProperties was decreased from %s to %s",
+	"en.messages.request-body-max-properties-decreased-description":                   "request body max properties decreased",
+	"en.messages.request-body-max-properties-increased":                               "the request's body maxProperties was increased from %s to %s",
+	"en.messages.request-body-max-properties-increased-description":                   "request body max properties increased",
+	"en.messages.request-body-max-properties-set":                                     "the request's body maxProperties was set to %s",
+	"en.messages.request-body-max-properties-set-comment":                             "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-body-max-properties-set-description":                         "request body max properties set",
 	"en.messages.request-body-max-set":                                                "the request's body max was set to %s",
 	"en.messages.request-body-max-set-comment":                                        "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
 	"en.messages.request-body-max-set-description":                                    "request body max set",
 	"en.messages.request-body-media-type-added":                                       "added the media type %s to the request body",
 	"en.messages.request-body-media-type-added-description":                           "request body media-type added",
+	"en.messages.request-body-media-type-broadened":                                   "the request body media type %s was broadened to %s",
+	"en.messages.request-body-media-type-broadened-description":                       "request body media type replaced by a broader media type",
+	"en.messages.request-body-media-type-changed":                                     "the request body media type %s was changed to %s",
+	"en.messages.request-body-media-type-changed-description":                         "request body media type replaced by a related media type",
+	"en.messages.request-body-media-type-narrowed":                                    "the request body media type %s was narrowed to %s",
+	"en.messages.request-body-media-type-narrowed-description":                        "request body media type replaced by a narrower media type",
 	"en.messages.request-body-media-type-removed":                                     "removed the media type %s from the request body",
 	"en.messages.request-body-media-type-removed-description":                         "request body media-type deleted",
 	"en.messages.request-body-min-decreased":                                          "the request's body min was decreased to from %s to %s",
@@ -171,15 +285,34 @@ var localizations = map[string]string{
 	"en.messages.request-body-min-length-decreased-description":                       "request body min length decreased",
 	"en.messages.request-body-min-length-increased":                                   "the request's body minLength was increased from %s to %s",
 	"en.messages.request-body-min-length-increased-description":                       "request body min length increased",
+	"en.messages.request-body-min-properties-decreased":                               "the request's body minProperties was decreased from %s to %s",
+	"en.messages.request-body-min-properties-decreased-description":                   "request body min properties decreased",
+	"en.messages.request-body-min-properties-increased":                               "the request's body minProperties was increased from %s to %s",
+	"en.messages.request-body-min-properties-increased-description":                   "request body min properties increased",
 	"en.messages.request-body-min-set":                                                "the request's body min was set to %s",
 	"en.messages.request-body-min-set-comment":                                        "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
 	"en.messages.request-body-min-set-description":                                    "request body min set",
+	"en.messages.request-body-multiple-of-changed":                                    "the request's body multipleOf was changed from %s to %s",
+	"en.messages.request-body-multiple-of-changed-description":                        "request body multiple of changed",
+	"en.messages.request-body-multiple-of-set":                                        "the request's body multipleOf was set to %s",
+	"en.messages.request-body-multiple-of-set-comment":                                "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-body-multiple-of-set-description":                            "request body multiple of set",
+	"en.messages.request-body-not-schema-added":                                       "added a 'not' schema to the request's body",
+	"en.messages.request-body-not-schema-added-description":                           "not schema added to request body",
+	"en.messages.request-body-not-schema-broadened":                                   "broadened the 'not' schema of the request's body",
+	"en.messages.request-body-not-schema-broadened-description":                       "not schema broadened in request body",
 	"en.messages.request-body-one-of-added":                                           "added %s to the request body 'oneOf' list",
 	"en.messages.request-body-one-of-added-description":                               "sub-schema added to oneOf in request body",
 	"en.messages.request-body-one-of-removed":                                         "removed %s from the request body 'oneOf' list",
 	"en.messages.request-body-one-of-removed-description":                             "sub-schema deleted from oneOf in request body",
 	"en.messages.request-body-type-changed":                                           "the request's body type/format changed from %s/%s to %s/%s",
 	"en.messages.request-body-type-changed-description":                               "request body type changed",
+	"en.messages.request-body-unique-items-set":                                       "the request's body uniqueItems was set",
+	"en.messages.request-body-unique-items-set-description":                           "request body unique items set",
+	"en.messages.request-cookie-property-became-enum":                                 "the %s request cookie's property %s was restricted to a list of enum values",
+	"en.messages.request-cookie-property-became-enum-description":                     "request cookie property restricted to enum",
+	"en.messages.request-cookie-property-became-required":                             "the %s request cookie's property %s became required",
+	"en.messages.request-cookie-property-became-required-description":                 "request cookie property became required",
 	"en.messages.request-header-property-became-enum":                                 "the %s request header's property %s was restricted to a list of enum values",
 	"en.messages.request-header-property-became-enum-description":                     "request header property restricted to enum",
 	"en.messages.request-header-property-became-required":                             "the %s request header's property %s became required",
@@ -192,6 +325,14 @@ var localizations = map[string]string{
 	"en.messages.request-optional-property-became-read-only-description":              "request optional property became read-only",
 	"en.messages.request-optional-property-became-write-only":                         "the request optional property %s became write-only",
 	"en.messages.request-optional-property-became-write-only-description":             "request optional property became write-only",
+	"en.messages.request-parameter-allow-empty-value-added":                           "for the %s request parameter %s, empty values are now allowed",
+	"en.messages.request-parameter-allow-empty-value-added-description":               "request parameter empty value allowed",
+	"en.messages.request-parameter-allow-empty-value-removed":                         "for the %s request parameter %s, empty values are no longer allowed",
+	"en.messages.request-parameter-allow-empty-value-removed-description":             "request parameter empty value disallowed",
+	"en.messages.request-parameter-allow-reserved-added":                              "for the %s request parameter %s, reserved characters are now allowed without percent-encoding",
+	"en.messages.request-parameter-allow-reserved-added-description":                  "request parameter reserved characters allowed",
+	"en.messages.request-parameter-allow-reserved-removed":                            "for the %s request parameter %s, reserved characters are no longer allowed without percent-encoding",
+	"en.messages.request-parameter-allow-reserved-removed-description":                "request parameter reserved characters disallowed",
 	"en.messages.request-parameter-became-enum":                                       "the %s request parameter %s was restricted to a list of enum values",
 	"en.messages.request-parameter-became-enum-description":                           "request parameter restricted to enum",
 	"en.messages.request-parameter-became-optional":                                   "the %s request parameter %s became optional",
@@ -208,6 +349,14 @@ var localizations = map[string]string{
 	"en.messages.request-parameter-enum-value-added-description":                      "request parameter enum value added",
 	"en.messages.request-parameter-enum-value-removed":                                "removed the enum value %s from the %s request parameter %s",
 	"en.messages.request-parameter-enum-value-removed-description":                    "request parameter enum value deleted",
+	"en.messages.request-parameter-example-invalid":                                   "the %s request parameter %s example %s doesn't conform to its schema (%s)",
+	"en.messages.request-parameter-example-invalid-description":                       "request parameter example doesn't conform to the schema",
+	"en.messages.request-parameter-exclusive-max-set":                                 "for the %s request parameter %s, the max %s became exclusive",
+	"en.messages.request-parameter-exclusive-max-set-description":                     "request parameter max became exclusive",
+	"en.messages.request-parameter-exclusive-min-set":                                 "for the %s request parameter %s, the min %s became exclusive",
+	"en.messages.request-parameter-exclusive-min-set-description":                     "request parameter min became exclusive",
+	"en.messages.request-parameter-explode-changed":                                   "for the %s request parameter %s, the explode was changed from %s to %s",
+	"en.messages.request-parameter-explode-changed-description":                       "request parameter explode changed",
 	"en.messages.request-parameter-max-decreased":                                     "for the %s request parameter %s, the max was decreased from %s to %s",
 	"en.messages.request-parameter-max-decreased-description":                         "request parameter max decreased",
 	"en.messages.request-parameter-max-increased":                                     "for the %s request parameter %s, the max was increased from %s to %s",
@@ -223,6 +372,13 @@ var localizations = map[string]string{
 	"en.messages.request-parameter-max-length-set":                                    "for the %s request parameter %s, the maxLength was set to %s",
 	"en.messages.request-parameter-max-length-set-comment":                            "This is a warning because sometimes it is required to be set because of security reasons or current error in specification. But good clients should be checked to support this restriction before such change in specification.",
 	"en.messages.request-parameter-max-length-set-description":                        "request parameter max length set",
+	"en.messages.request-parameter-max-properties-decreased":                          "for the %s request parameter %s, the maxProperties was decreased from %s to %s",
+	"en.messages.request-parameter-max-properties-decreased-description":              "request parameter max properties decreased",
+	"en.messages.request-parameter-max-properties-increased":                          "for the %s request parameter %s, the maxProperties was increased from %s to %s",
+	"en.messages.request-parameter-max-properties-increased-description":              "request parameter max properties increased",
+	"en.messages.request-parameter-max-properties-set":                                "for the %s request parameter %s, the maxProperties was set to %s",
+	"en.messages.request-parameter-max-properties-set-comment":                        "This is a warning because sometimes it is required to be set because of security reasons or current error in specification. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-parameter-max-properties-set-description":                    "request parameter max properties set",
 	"en.messages.request-parameter-max-set":                                           "for the %s request parameter %s, the max was set to %s",
 	"en.messages.request-parameter-max-set-comment":                                   "This is a warning because sometimes it is required to be set because of security reasons or current error in specification. But good clients should be checked to support this restriction before such change in specification.",
 	"en.messages.request-parameter-max-set-description":                               "request parameter max set",
@@ -241,21 +397,48 @@ var localizations = map[string]string{
 	"en.messages.request-parameter-min-length-decreased-description":                  "request parameter min length decreased",
 	"en.messages.request-parameter-min-length-increased":                              "for the %s request parameter %s, the minLength was increased from %s to %s",
 	"en.messages.request-parameter-min-length-increased-description":                  "request parameter min length increased",
+	"en.messages.request-parameter-min-properties-decreased":                          "for the %s request parameter %s, the minProperties was decreased from %s to %s",
+	"en.messages.request-parameter-min-properties-decreased-description":              "request parameter min properties decreased",
+	"en.messages.request-parameter-min-properties-increased":                          "for the %s request parameter %s, the minProperties was increased from %s to %s",
+	"en.messages.request-parameter-min-properties-increased-description":              "request parameter min properties increased",
 	"en.messages.request-parameter-min-set":                                           "for the %s request parameter %s, the min was set to %s",
 	"en.messages.request-parameter-min-set-comment":                                   "This is a warning because sometimes it is required to be set because of security reasons or current error in specification. But good clients should be checked to support this restriction before such change in specification.",
 	"en.messages.request-parameter-min-set-description":                               "request parameter min set",
+	"en.messages.request-parameter-multiple-of-changed":                               "for the %s request parameter %s, the multipleOf was changed from %s to %s",
+	"en.messages.request-parameter-multiple-of-changed-description":                   "request parameter multiple of changed",
+	"en.messages.request-parameter-multiple-of-set":                                   "for the %s request parameter %s, the multipleOf was set to %s",
+	"en.messages.request-parameter-multiple-of-set-comment":                           "This is a warning because sometimes it is required to be set because of security reasons or current error in specification. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-parameter-multiple-of-set-description":                       "request parameter multiple of set",
+	"en.messages.request-parameter-not-schema-added":                                  "added a 'not' schema to the %s request parameter %s",
+	"en.messages.request-parameter-not-schema-added-description":                      "not schema added to request parameter",
+	"en.messages.request-parameter-not-schema-broadened":                              "broadened the 'not' schema of the %s request parameter %s",
+	"en.messages.request-parameter-not-schema-broadened-description":                  "not schema broadened in request parameter",
 	"en.messages.request-parameter-pattern-added":                                     "added the pattern %s to the %s request parameter %s",
 	"en.messages.request-parameter-pattern-added-description":                         "request parameter pattern set",
+	"en.messages.request-parameter-pattern-broadened":                                 "broadened the pattern of the %s request parameter %s from %s to %s",
+	"en.messages.request-parameter-pattern-broadened-description":                     "request parameter pattern broadened",
 	"en.messages.request-parameter-pattern-changed":                                   "changed the pattern of the %s request parameter %s from %s to %s",
 	"en.messages.request-parameter-pattern-changed-description":                       "request parameter pattern changed",
+	"en.messages.request-parameter-pattern-incomparable":                              "changed the pattern of the %s request parameter %s from %s to %s",
+	"en.messages.request-parameter-pattern-incomparable-description":                  "request parameter pattern replaced by a pattern that matches different values",
+	"en.messages.request-parameter-pattern-narrowed":                                  "narrowed the pattern of the %s request parameter %s from %s to %s",
+	"en.messages.request-parameter-pattern-narrowed-description":                      "request parameter pattern narrowed",
 	"en.messages.request-parameter-pattern-removed":                                   "removed the pattern %s from the %s request parameter %s",
 	"en.messages.request-parameter-pattern-removed-description":                       "request parameter pattern unset",
 	"en.messages.request-parameter-removed":                                           "deleted the %s request parameter %s",
 	"en.messages.request-parameter-removed-description":                               "request parameter deleted",
+	"en.messages.request-parameter-style-changed":                                     "for the %s request parameter %s, the style was changed from %s to %s",
+	"en.messages.request-parameter-style-changed-description":                         "request parameter style changed",
 	"en.messages.request-parameter-type-changed":                                      "for the %s request parameter %s, the type/format was changed from %s/%s to %s/%s",
 	"en.messages.request-parameter-type-changed-description":                          "request parameter type changed",
+	"en.messages.request-parameter-unique-items-set":                                  "for the %s request parameter %s, the uniqueItems was set",
+	"en.messages.request-parameter-unique-items-set-description":                      "request parameter unique items set",
 	"en.messages.request-parameter-x-extensible-enum-value-removed":                   "removed the x-extensible-enum value %s from the %s request parameter %s",
 	"en.messages.request-parameter-x-extensible-enum-value-removed-description":       "request parameter-x-extensible-enum value deleted",
+	"en.messages.request-property-additional-properties-disallowed":                   "the request property %s no longer allows additional properties",
+	"en.messages.request-property-additional-properties-disallowed-description":       "additional properties disallowed in request property",
+	"en.messages.request-property-additional-properties-narrowed":                     "the request property %s now restricts additional properties with a schema",
+	"en.messages.request-property-additional-properties-narrowed-description":         "additional properties restricted with a schema in request property",
 	"en.messages.request-property-all-of-added":                                       "added %s to the %s request property 'allOf' list",
 	"en.messages.request-property-all-of-added-description":                           "sub-schema added to allOf in request property",
 	"en.messages.request-property-all-of-removed":                                     "removed %s from the %s request property 'allOf' list",
@@ -296,6 +479,10 @@ var localizations = map[string]string{
 	"en.messages.request-property-enum-value-added-description":                       "request property enum value added",
 	"en.messages.request-property-enum-value-removed":                                 "removed the enum value %s of the request property %s",
 	"en.messages.request-property-enum-value-removed-description":                     "request property enum value removed",
+	"en.messages.request-property-exclusive-max-set":                                  "the %s request property's max %s became exclusive",
+	"en.messages.request-property-exclusive-max-set-description":                      "request property max became exclusive",
+	"en.messages.request-property-exclusive-min-set":                                  "the %s request property's min %s became exclusive",
+	"en.messages.request-property-exclusive-min-set-description":                      "request property min became exclusive",
 	"en.messages.request-property-max-decreased":                                      "the %s request property's max was decreased to %s",
 	"en.messages.request-property-max-decreased-description":                          "request property max decreased",
 	"en.messages.request-property-max-increased":                                      "the %s request property's max was increased from %s to %s",
@@ -307,6 +494,13 @@ var localizations = map[string]string{
 	"en.messages.request-property-max-length-set":                                     "the %s request property's maxLength was set to %s",
 	"en.messages.request-property-max-length-set-comment":                             "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
 	"en.messages.request-property-max-length-set-description":                         "request property max length set",
+	"en.messages.request-property-max-properties-decreased":                           "the %s request property's maxProperties was decreased from %s to %s",
+	"en.messages.request-property-max-properties-decreased-description":               "request property max properties decreased",
+	"en.messages.request-property-max-properties-increased":                           "the %s request property's maxProperties was increased from %s to %s",
+	"en.messages.request-property-max-properties-increased-description":               "request property max properties increased",
+	"en.messages.request-property-max-properties-set":                                 "the %s request property's maxProperties was set to %s",
+	"en.messages.request-property-max-properties-set-comment":                         "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-property-max-properties-set-description":                     "request property max properties set",
 	"en.messages.request-property-max-set":                                            "the %s request property's max was set to %s",
 	"en.messages.request-property-max-set-comment":                                    "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
 	"en.messages.request-property-max-set-description":                                "request property max set",
@@ -324,23 +518,44 @@ var localizations = map[string]string{
 	"en.messages.request-property-min-length-decreased-description":                   "request property min length decreased",
 	"en.messages.request-property-min-length-increased":                               "the %s request property's minLength was increased from %s to %s",
 	"en.messages.request-property-min-length-increased-description":                   "request property min length increased",
+	"en.messages.request-property-min-properties-decreased":                           "the %s request property's minProperties was decreased from %s to %s",
+	"en.messages.request-property-min-properties-decreased-description":               "request property min properties decreased",
+	"en.messages.request-property-min-properties-increased":                           "the %s request property's minProperties was increased from %s to %s",
+	"en.messages.request-property-min-properties-increased-description":               "request property min properties increased",
 	"en.messages.request-property-min-set":                                            "the %s request property's min was set to %s",
 	"en.messages.request-property-min-set-comment":                                    "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
 	"en.messages.request-property-min-set-description":                                "request property min set",
+	"en.messages.request-property-multiple-of-changed":                                "the %s request property's multipleOf was changed from %s to %s",
+	"en.messages.request-property-multiple-of-changed-description":                    "request property multiple of changed",
+	"en.messages.request-property-multiple-of-set":                                    "the %s request property's multipleOf was set to %s",
+	"en.messages.request-property-multiple-of-set-comment":                            "This is a warning because sometimes it is required to be set. But good clients should be checked to support this restriction before such change in specification.",
+	"en.messages.request-property-multiple-of-set-description":                        "request property multiple of set",
+	"en.messages.request-property-not-schema-added":                                   "added a 'not' schema to the request property %s",
+	"en.messages.request-property-not-schema-added-description":                       "not schema added to request property",
+	"en.messages.request-property-not-schema-broadened":                               "broadened the 'not' schema of the request property %s",
+	"en.messages.request-property-not-schema-broadened-description":                   "not schema broadened in request property",
 	"en.messages.request-property-one-of-added":                                       "added %s to the %s request property 'oneOf' list",
 	"en.messages.request-property-one-of-added-description":                           "sub-schema added to oneOf in request property",
 	"en.messages.request-property-one-of-removed":                                     "removed %s from the %s request property 'oneOf' list",
 	"en.messages.request-property-one-of-removed-description":                         "sub-schema deleted from oneOf in request property",
 	"en.messages.request-property-pattern-added":                                      "added the pattern %s to the request property %s",
 	"en.messages.request-property-pattern-added-description":                          "request property pattern set",
+	"en.messages.request-property-pattern-broadened":                                  "broadened the pattern of the request property %s from %s to %s",
+	"en.messages.request-property-pattern-broadened-description":                      "request property pattern broadened",
 	"en.messages.request-property-pattern-changed":                                    "changed the pattern of the request property %s from %s to %s",
 	"en.messages.request-property-pattern-changed-description":                        "request property pattern changed",
+	"en.messages.request-property-pattern-incomparable":                               "changed the pattern of the request property %s from %s to %s",
+	"en.messages.request-property-pattern-incomparable-description":                   "request property pattern replaced by a pattern that matches different values",
+	"en.messages.request-property-pattern-narrowed":                                   "narrowed the pattern of the request property %s from %s to %s",
+	"en.messages.request-property-pattern-narrowed-description":                       "request property pattern narrowed",
 	"en.messages.request-property-pattern-removed":                                    "removed the pattern %s from the request property %s",
 	"en.messages.request-property-pattern-removed-description":                        "request property pattern unset",
 	"en.messages.request-property-removed":                                            "removed the request property %s",
 	"en.messages.request-property-removed-description":                                "request property removed",
 	"en.messages.request-property-type-changed":                                       "the %s request property type/format changed from %s/%s to %s/%s",
 	"en.messages.request-property-type-changed-description":                           "request property type changed",
+	"en.messages.request-property-unique-items-set":                                   "the %s request property's uniqueItems was set",
+	"en.messages.request-property-unique-items-set-description":                       "request property unique items set",
 	"en.messages.request-property-x-extensible-enum-value-removed":                    "removed the x-extensible-enum value %s of the request property %s",
 	"en.messages.request-property-x-extensible-enum-value-removed-description":        "request property x-extensible-enum value removed",
 	"en.messages.request-required-property-became-not-read-only":                      "the request required property %s became not read-only",
@@ -353,6 +568,10 @@ var localizations = map[string]string{
 	"en.messages.request-required-property-became-write-only-description":             "request required property became write-only",
 	"en.messages.required-response-header-removed":                                    "the mandatory response header %s removed for the status %s",
 	"en.messages.required-response-header-removed-description":                        "required response header removed",
+	"en.messages.response-body-additional-properties-allowed":                         "the response's body now allows additional properties for the status %s",
+	"en.messages.response-body-additional-properties-allowed-description":             "additional properties allowed in response body",
+	"en.messages.response-body-additional-properties-widened":                         "the response's body no longer restricts additional properties with a schema for the status %s",
+	"en.messages.response-body-additional-properties-widened-description":             "additional properties no longer restricted with a schema in response body",
 	"en.messages.response-body-all-of-added":                                          "added %s to the response body 'allOf' list for the response status %s",
 	"en.messages.response-body-all-of-added-description":                              "sub-schema added to allOf in response body",
 	"en.messages.response-body-all-of-removed":                                        "removed %s from the response body 'allOf' list for the response status %s",
@@ -381,12 +600,22 @@ var localizations = map[string]string{
 	"en.messages.response-body-discriminator-property-name-changed-description":       "response body discriminator property name changed",
 	"en.messages.response-body-discriminator-removed":                                 "removed response discriminator for the response status %s",
 	"en.messages.response-body-discriminator-removed-description":                     "response body discriminator removed",
+	"en.messages.response-body-example-invalid":                                       "the response media type %s example %s doesn't conform to its schema (%s) for the status %s",
+	"en.messages.response-body-example-invalid-description":                           "response body example doesn't conform to the schema",
+	"en.messages.response-body-exclusive-max-unset":                                   "the response's body max %s is no longer exclusive",
+	"en.messages.response-body-exclusive-max-unset-description":                       "response body max no longer exclusive",
+	"en.messages.response-body-exclusive-min-unset":                                   "the response's body min %s is no longer exclusive",
+	"en.messages.response-body-exclusive-min-unset-description":                       "response body min no longer exclusive",
 	"en.messages.response-body-max-increased":                                         "the response's body max was increased from %s to %s",
 	"en.messages.response-body-max-increased-description":                             "response body max increased",
 	"en.messages.response-body-max-length-increased":                                  "the response's body maxLength was increased from %s to %s",
 	"en.messages.response-body-max-length-increased-description":                      "response body max length increased",
 	"en.messages.response-body-max-length-unset":                                      "the response's body maxLength was unset from %s",
 	"en.messages.response-body-max-length-unset-description":                          "response body max length unset",
+	"en.messages.response-body-max-properties-increased":                              "the response's body maxProperties was increased from %s to %s",
+	"en.messages.response-body-max-properties-increased-description":                  "response body max properties increased",
+	"en.messages.response-body-max-properties-unset":                                  "the response's body maxProperties was unset from %s",
+	"en.messages.response-body-max-properties-unset-description":                      "response body max properties unset",
 	"en.messages.response-body-min-decreased":                                         "the response's body min was decreased from %s to %s",
 	"en.messages.response-body-min-decreased-description":                             "response body min decreased",
 	"en.messages.response-body-min-items-decreased":                                   "the response's body minItems was decreased from %s to %s",
@@ -395,22 +624,52 @@ var localizations = map[string]string{
 	"en.messages.response-body-min-items-unset-description":                           "response body min items unset",
 	"en.messages.response-body-min-length-decreased":                                  "the response's body minLength was decreased from %s to %s",
 	"en.messages.response-body-min-length-decreased-description":                      "response body min length decreased",
+	"en.messages.response-body-min-properties-decreased":                              "the response's body minProperties was decreased from %s to %s",
+	"en.messages.response-body-min-properties-decreased-description":                  "response body min properties decreased",
+	"en.messages.response-body-multiple-of-changed":                                   "the response's body multipleOf was changed from %s to %s",
+	"en.messages.response-body-multiple-of-changed-description":                       "response body multiple of changed",
+	"en.messages.response-body-multiple-of-unset":                                     "the response's body multipleOf was unset from %s",
+	"en.messages.response-body-multiple-of-unset-description":                         "response body multiple of unset",
+	"en.messages.response-body-not-schema-removed":                                    "removed the 'not' schema from the response's body for the status %s",
+	"en.messages.response-body-not-schema-removed-description":                        "not schema removed from response body",
 	"en.messages.response-body-one-of-added":                                          "added %s to the response body 'oneOf' list for the response status %s",
 	"en.messages.response-body-one-of-added-description":                              "sub-schema added to oneOf in response body",
 	"en.messages.response-body-one-of-removed":                                        "removed %s from the response body 'oneOf' list for the response status %s",
 	"en.messages.response-body-one-of-removed-description":                            "sub-schema removed from oneOf in response body",
 	"en.messages.response-body-type-changed":                                          "the response's body type/format changed from %s/%s to %s/%s for status %s",
 	"en.messages.response-body-type-changed-description":                              "response body type changed",
+	"en.messages.response-body-unique-items-unset":                                    "the response's body uniqueItems was unset",
+	"en.messages.response-body-unique-items-unset-description":                        "response body unique items unset",
 	"en.messages.response-header-became-optional":                                     "the response header %s became optional for the status %s",
 	"en.messages.response-header-became-optional-description":                         "response header became optional",
+	"en.messages.response-link-added":                                                 "added the link %s to the response with the status %s",
+	"en.messages.response-link-added-description":                                     "response link added",
+	"en.messages.response-link-parameter-added":                                       "added the parameter %s to the link %s in the response with the status %s",
+	"en.messages.response-link-parameter-added-description":                           "response link parameter added",
+	"en.messages.response-link-parameter-changed":                                     "the parameter %s of the link %s was changed from %s to %s in the response with the status %s",
+	"en.messages.response-link-parameter-changed-description":                         "response link parameter changed",
+	"en.messages.response-link-parameter-removed":                                     "removed the parameter %s from the link %s in the response with the status %s",
+	"en.messages.response-link-parameter-removed-description":                         "response link parameter removed",
+	"en.messages.response-link-removed":                                               "removed the link %s from the response with the status %s",
+	"en.messages.response-link-removed-description":                                   "response link removed",
+	"en.messages.response-link-target-changed":                                        "the target of the link %s was changed from %s to %s in the response with the status %s",
+	"en.messages.response-link-target-changed-description":                            "response link target changed",
 	"en.messages.response-media-type-added":                                           "added the media type %s for the response with the status %s",
 	"en.messages.response-media-type-added-description":                               "response media type added",
+	"en.messages.response-media-type-broadened":                                       "the media type %s was broadened to %s for the response with the status %s",
+	"en.messages.response-media-type-broadened-description":                           "response media type replaced by a broader media type",
+	"en.messages.response-media-type-changed":                                         "the media type %s was changed to %s for the response with the status %s",
+	"en.messages.response-media-type-changed-description":                             "response media type replaced by a related media type",
+	"en.messages.response-media-type-narrowed":                                        "the media type %s was narrowed to %s for the response with the status %s",
+	"en.messages.response-media-type-narrowed-description":                            "response media type replaced by a narrower media type",
 	"en.messages.response-media-type-removed":                                         "removed the media type %s for the response with the status %s",
 	"en.messages.response-media-type-removed-description":                             "response media type removed",
 	"en.messages.response-mediatype-enum-value-removed":                               "response schema %s enum value removed %s",
 	"en.messages.response-mediatype-enum-value-removed-description":                   "response mediatype enum value removed",
 	"en.messages.response-non-success-status-added":                                   "added the non-success response with the status %s",
 	"en.messages.response-non-success-status-added-description":                       "response non-success status added",
+	"en.messages.response-non-success-status-covered":                                 "the non-success response with the status %s was removed, but the status is still covered by the response %s",
+	"en.messages.response-non-success-status-covered-description":                     "non-success status replaced by a status range or by the default response",
 	"en.messages.response-non-success-status-removed":                                 "removed the non-success response with the status %s",
 	"en.messages.response-non-success-status-removed-description":                     "response non-success status removed",
 	"en.messages.response-optional-property-added":                                    "added the optional property %s to the response with the %s status",
@@ -429,6 +688,10 @@ var localizations = map[string]string{
 	"en.messages.response-optional-write-only-property-added-description":             "response optional write-only property added",
 	"en.messages.response-optional-write-only-property-removed":                       "removed the optional write-only property %s from the response with the %s status",
 	"en.messages.response-optional-write-only-property-removed-description":           "response optional write-only property removed",
+	"en.messages.response-property-additional-properties-allowed":                     "the response property %s now allows additional properties for the status %s",
+	"en.messages.response-property-additional-properties-allowed-description":         "additional properties allowed in response property",
+	"en.messages.response-property-additional-properties-widened":                     "the response property %s no longer restricts additional properties with a schema for the status %s",
+	"en.messages.response-property-additional-properties-widened-description":         "additional properties no longer restricted with a schema in response property",
 	"en.messages.response-property-all-of-added":                                      "added %s to the %s response property 'allOf' list for the response status %s",
 	"en.messages.response-property-all-of-added-description":                          "sub-schema added to allOf in response property",
 	"en.messages.response-property-all-of-removed":                                    "removed %s from the %s response property 'allOf' list for the response status %s",
@@ -466,12 +729,20 @@ var localizations = map[string]string{
 	"en.messages.response-property-enum-value-added-description":                      "response property enum value added",
 	"en.messages.response-property-enum-value-removed":                                "removed the %s enum value from the %s response property for the response status %s",
 	"en.messages.response-property-enum-value-removed-description":                    "response property enum value removed",
+	"en.messages.response-property-exclusive-max-unset":                               "the %s response property's max %s is no longer exclusive for the response status %s",
+	"en.messages.response-property-exclusive-max-unset-description":                   "response property max no longer exclusive",
+	"en.messages.response-property-exclusive-min-unset":                               "the %s response property's min %s is no longer exclusive for the response status %s",
+	"en.messages.response-property-exclusive-min-unset-description":                   "response property min no longer exclusive",
 	"en.messages.response-property-max-increased":                                     "the %s response property's max was increased from %s to %s for the response status %s",
 	"en.messages.response-property-max-increased-description":                         "response property max increased",
 	"en.messages.response-property-max-length-increased":                              "the %s response property's maxLength was increased from %s to %s for the response status %s",
 	"en.messages.response-property-max-length-increased-description":                  "response property max length increased",
 	"en.messages.response-property-max-length-unset":                                  "the %s response property's maxLength was unset from %s for the response status %s",
 	"en.messages.response-property-max-length-unset-description":                      "response property max length unset",
+	"en.messages.response-property-max-properties-increased":                          "the %s response property's maxProperties was increased from %s to %s for the response status %s",
+	"en.messages.response-property-max-properties-increased-description":              "response property max properties increased",
+	"en.messages.response-property-max-properties-unset":                              "the %s response property's maxProperties was unset from %s for the response status %s",
+	"en.messages.response-property-max-properties-unset-description":                  "response property max properties unset",
 	"en.messages.response-property-min-decreased":                                     "the %s response property's min was decreased from %s to %s for the response status %s",
 	"en.messages.response-property-min-decreased-description":                         "response property min decreased",
 	"en.messages.response-property-min-items-decreased":                               "the %s response property's minItems was decreased from %s to %s for the response status %s",
@@ -480,18 +751,34 @@ var localizations = map[string]string{
 	"en.messages.response-property-min-items-unset-description":                       "response property min items unset",
 	"en.messages.response-property-min-length-decreased":                              "the %s response property's minLength was decreased from %s to %s for the response status %s",
 	"en.messages.response-property-min-length-decreased-description":                  "response property min length decreased",
+	"en.messages.response-property-min-properties-decreased":                          "the %s response property's minProperties was decreased from %s to %s for the response status %s",
+	"en.messages.response-property-min-properties-decreased-description":              "response property min properties decreased",
+	"en.messages.response-property-multiple-of-changed":                               "the %s response property's multipleOf was changed from %s to %s for the response status %s",
+	"en.messages.response-property-multiple-of-changed-description":                   "response property multiple of changed",
+	"en.messages.response-property-multiple-of-unset":                                 "the %s response property's multipleOf was unset from %s for the response status %s",
+	"en.messages.response-property-multiple-of-unset-description":                     "response property multiple of unset",
+	"en.messages.response-property-not-schema-removed":                                "removed the 'not' schema from the response property %s for the status %s",
+	"en.messages.response-property-not-schema-removed-description":                    "not schema removed from response property",
 	"en.messages.response-property-one-of-added":                                      "added %s to the %s response property 'oneOf' list for the response status %s",
 	"en.messages.response-property-one-of-added-description":                          "sub-schema added to oneOf in response property",
 	"en.messages.response-property-one-of-removed":                                    "removed %s from the %s response property 'oneOf' list for the response status %s",
 	"en.messages.response-property-one-of-removed-description":                        "sub-schema removed from oneOf in response property",
 	"en.messages.response-property-pattern-added":                                     "the %s response's property pattern %s was added for the status %s",
 	"en.messages.response-property-pattern-added-description":                         "response property pattern set",
+	"en.messages.response-property-pattern-broadened":                                 "the %s response's property pattern was broadened from %s to %s for the status %s",
+	"en.messages.response-property-pattern-broadened-description":                     "response property pattern broadened",
 	"en.messages.response-property-pattern-changed":                                   "the %s response's property pattern was changed from %s to %s for the status %s",
 	"en.messages.response-property-pattern-changed-description":                       "response property pattern changed",
+	"en.messages.response-property-pattern-incomparable":                              "the %s response's property pattern was changed from %s to %s for the status %s",
+	"en.messages.response-property-pattern-incomparable-description":                  "response property pattern replaced by a pattern that matches different values",
+	"en.messages.response-property-pattern-narrowed":                                  "the %s response's property pattern was narrowed from %s to %s for the status %s",
+	"en.messages.response-property-pattern-narrowed-description":                      "response property pattern narrowed",
 	"en.messages.response-property-pattern-removed":                                   "the %s response's property pattern %s was removed for the status %s",
 	"en.messages.response-property-pattern-removed-description":                       "response property pattern unset",
 	"en.messages.response-property-type-changed":                                      "the response's property type/format changed from %s/%s to %s/%s for status %s",
 	"en.messages.response-property-type-changed-description":                          "response property type changed",
+	"en.messages.response-property-unique-items-unset":                                "the %s response property's uniqueItems was unset for the response status %s",
+	"en.messages.response-property-unique-items-unset-description":                    "response property unique items unset",
 	"en.messages.response-required-property-added":                                    "added the required property %s to the response with the %s status",
 	"en.messages.response-required-property-added-description":                        "response required property added",
 	"en.messages.response-required-property-became-not-read-only":                     "the response required property %s became not read-only for the status %s",
@@ -511,6 +798,8 @@ var localizations = map[string]string{
 	"en.messages.response-required-write-only-property-removed-description":           "response required write-only property removed",
 	"en.messages.response-success-status-added":                                       "added the success response with the status %s",
 	"en.messages.response-success-status-added-description":                           "response success status added",
+	"en.messages.response-success-status-covered":                                     "the success response with the status %s was removed, but the status is still covered by the response %s",
+	"en.messages.response-success-status-covered-description":                         "success status replaced by a status range or by the default response",
 	"en.messages.response-success-status-removed":                                     "removed the success response with the status %s",
 	"en.messages.response-success-status-removed-description":                         "response success status removed",
 	"en.messages.response-write-only-property-became-optional":                        "the response write-only property %s became optional for the status %s",
@@ -527,19 +816,64 @@ var localizations = map[string]string{
 	"en.messages.unparseable-parameter-to-x-extensible-enum-description":              "unparseable x-extensible-enum in revised request parameter",
 	"en.messages.unparseable-property-from-x-extensible-enum-description":             "unparseable x-extensible-enum in original request property",
 	"en.messages.unparseable-property-to-x-extensible-enum-description":               "unparseable x-extensible-enum in revised request property",
+	"en.messages.webhook-added":                                                       "added the webhook %s",
+	"en.messages.webhook-added-description":                                           "webhook added",
+	"en.messages.webhook-operation-added":                                             "added the operation to the webhook %s",
+	"en.messages.webhook-operation-added-description":                                 "operation added to a webhook",
+	"en.messages.webhook-operation-removed":                                           "removed the operation from the webhook %s",
+	"en.messages.webhook-operation-removed-description":                               "operation removed from a webhook",
+	"en.messages.webhook-removed":                                                     "removed the webhook %s",
+	"en.messages.webhook-removed-description":                                         "webhook removed",
+	"en.messages.webhook-request-new-optional-property":                               "added the new optional request property %s to the webhook %s",
+	"en.messages.webhook-request-new-optional-property-description":                   "new optional property added to a webhook request",
+	"en.messages.webhook-request-new-required-property":                               "added the new required request property %s to the webhook %s",
+	"en.messages.webhook-request-new-required-property-description":                   "new required property added to a webhook request",
+	"en.messages.webhook-request-optional-property-removed":                           "removed the optional request property %s from the webhook %s",
+	"en.messages.webhook-request-optional-property-removed-description":               "optional property removed from a webhook request",
+	"en.messages.webhook-request-property-type-changed":                               "changed the type of the request property %s from %s to %s in the webhook %s",
+	"en.messages.webhook-request-property-type-changed-description":                   "webhook request property type changed",
+	"en.messages.webhook-request-required-property-removed":                           "removed the required request property %s from the webhook %s",
+	"en.messages.webhook-request-required-property-removed-description":               "required property removed from a webhook request",
+	"en.messages.webhook-response-new-required-property":                              "added the new required response property %s with the status %s to the webhook %s",
+	"en.messages.webhook-response-new-required-property-description":                  "new required property added to a webhook response",
+	"en.messages.webhook-response-property-became-required":                           "the response property %s with the status %s became required in the webhook %s",
+	"en.messages.webhook-response-property-became-required-description":               "webhook response property became required",
+	"en.messages.webhook-response-success-status-added":                               "added the success response with the status %s to the webhook %s",
+	"en.messages.webhook-response-success-status-added-description":                   "success status added to a webhook response",
+	"en.messages.webhook-response-success-status-removed":                             "removed the success response with the status %s from the webhook %s",
+	"en.messages.webhook-response-success-status-removed-description":                 "success status removed from a webhook response",
 	"ru.messages.added-required-request-body":                                         "добавлено обязательное тело запроса",
 	"ru.messages.api-deprecated-sunset-parse":                                         "API deprecated без валидно парсящейся %s даты sunset: %v",
+	"ru.messages.api-example-component-added":                                         "компонент примера %s был добавлен",
+	"ru.messages.api-example-component-removed":                                       "компонент примера %s был удален",
+	"ru.messages.api-example-component-value-changed":                                 "значение компонента примера %s было изменено",
 	"ru.messages.api-global-security-added":                                           "схема безопасности %s была добавлена к API",
 	"ru.messages.api-global-security-removed":                                         "схема безопасности %s была удалена из API",
 	"ru.messages.api-global-security-scope-added":                                     "к глобальной схеме безопасности %s была добавлена область безопасности %s",
 	"ru.messages.api-global-security-scope-removed":                                   "из глобальной схемы безопасности %s была удалена область безопасности %s",
+	"ru.messages.api-link-component-added":                                            "компонент ссылки %s был добавлен",
+	"ru.messages.api-link-component-parameter-added":                                  "добавлен параметр %s в компонент ссылки %s",
+	"ru.messages.api-link-component-parameter-changed":                                "параметр %s компонента ссылки %s изменён с %s на %s",
+	"ru.messages.api-link-component-parameter-removed":                                "удалён параметр %s из компонента ссылки %s",
+	"ru.messages.api-link-component-removed":                                          "компонент ссылки %s был удален",
+	"ru.messages.api-link-component-target-changed":                                   "цель компонента ссылки %s изменена с %s на %s",
 	"ru.messages.api-operation-id-added":                                              "добавлен идентификатор операции API %s",
 	"ru.messages.api-operation-id-removed":                                            "Идентификатор операции API %s удален и заменен на %s",
+	"ru.messages.api-operation-server-added":                                          "добавлен сервер %s операции",
+	"ru.messages.api-operation-server-removed":                                        "удалён сервер %s операции",
+	"ru.messages.api-operation-server-url-changed":                                    "URL сервера операции изменён с %s на %s",
+	"ru.messages.api-operation-server-variable-default-changed":                       "в сервере %s операции значение по умолчанию переменной %s изменено с %s на %s",
+	"ru.messages.api-operation-server-variable-enum-value-removed":                    "в сервере %s операции у переменной %s удалено значение перечисления %s",
 	"ru.messages.api-path-added":                                                      "API path добавлено",
 	"ru.messages.api-path-deprecated":                                                 "API path deprecated",
 	"ru.messages.api-path-reactivated":                                                "API path реактивирован",
 	"ru.messages.api-path-removed-before-sunset":                                      "API path удалён до даты sunset %s",
 	"ru.messages.api-path-removed-without-deprecation":                                "api path удалён без процедуры deprecation",
+	"ru.messages.api-path-server-added":                                               "добавлен сервер %s пути",
+	"ru.messages.api-path-server-removed":                                             "удалён сервер %s пути",
+	"ru.messages.api-path-server-url-changed":                                         "URL сервера пути изменён с %s на %s",
+	"ru.messages.api-path-server-variable-default-changed":                            "в сервере %s пути значение по умолчанию переменной %s изменено с %s на %s",
+	"ru.messages.api-path-server-variable-enum-value-removed":                         "в сервере %s пути у переменной %s удалено значение перечисления %s",
 	"ru.messages.api-removed-before-sunset":                                           "API удалёг до даты sunset %s",
 	"ru.messages.api-removed-without-deprecation":                                     "API удалён без deprecation",
 	"ru.messages.api-schema-removed":                                                  "удалена схема %s",
@@ -556,22 +890,44 @@ var localizations = map[string]string{
 	"ru.messages.api-security-scope-added":                                            "к схеме безопасности эндпоинта %s была добавлена область безопасности %s",
 	"ru.messages.api-security-scope-removed":                                          "из схемы безопасности эндпоинта %s была удалена область безопасности %s",
 	"ru.messages.api-security-updated":                                                "схема безопасности точки доступа %s была обновлена с %s на %s",
+	"ru.messages.api-server-added":                                                    "добавлен сервер %s документа",
+	"ru.messages.api-server-removed":                                                  "удалён сервер %s документа",
+	"ru.messages.api-server-url-changed":                                              "URL сервера документа изменён с %s на %s",
+	"ru.messages.api-server-variable-default-changed":                                 "в сервере %s документа значение по умолчанию переменной %s изменено с %s на %s",
+	"ru.messages.api-server-variable-enum-value-removed":                              "в сервере %s документа у переменной %s удалено значение перечисления %s",
 	"ru.messages.api-sunset-date-changed-too-small":                                   "дата sunset у API изменена на более раннюю с %s на %s, новая дата sunset должна быть либо не раньше %s, либо, как минимум, %s дней от текущего дня",
 	"ru.messages.api-sunset-date-too-small":                                           "дата API sunset date %s слишком ранняя, должно быть как минимум %s дней от текущего дня",
 	"ru.messages.api-tag-added":                                                       "тег API %s добавлен",
 	"ru.messages.api-tag-removed":                                                     "Тег API %s удален",
 	"ru.messages.at":                                                                  "в",
+	"ru.messages.callback-added":                                                      "добавлен обратный вызов %s",
+	"ru.messages.callback-operation-added":                                            "в обратный вызов %s добавлена операция %s %s",
+	"ru.messages.callback-operation-removed":                                          "в обратном вызове %s удалена операция %s %s",
+	"ru.messages.callback-removed":                                                    "удален обратный вызов %s",
+	"ru.messages.callback-request-new-optional-property":                              "добавлено новое необязательное поле запроса %s в обратный вызов %s %s %s",
+	"ru.messages.callback-request-new-required-property":                              "добавлено новое обязательное поле запроса %s в обратный вызов %s %s %s",
+	"ru.messages.callback-request-optional-property-removed":                          "удалено необязательное поле запроса %s из обратного вызова %s %s %s",
+	"ru.messages.callback-request-property-type-changed":                              "изменен тип поля запроса %s с %s на %s в обратном вызове %s %s %s",
+	"ru.messages.callback-request-required-property-removed":                          "удалено обязательное поле запроса %s из обратного вызова %s %s %s",
+	"ru.messages.callback-response-new-required-property":                             "добавлено новое обязательное поле ответа %s со статусом %s в обратный вызов %s %s %s",
+	"ru.messages.callback-response-property-became-required":                          "поле ответа %s со статусом %s стало обязательным в обратном вызове %s %s %s",
+	"ru.messages.callback-response-success-status-added":                              "добавлен успешный статус ответа %s в обратный вызов %s %s %s",
+	"ru.messages.callback-response-success-status-removed":                            "удален успешный статус ответа %s из обратного вызова %s %s %s",
 	"ru.messages.in":                                                                  "в",
 	"ru.messages.new-optional-request-default-parameter-to-existing-path":             "добавлен новый необязательный %s параметр запроса %s ко всем операциям пути",
 	"ru.messages.new-optional-request-parameter":                                      "добавлен новый необязательный %s параметр зароса %s",
 	"ru.messages.new-optional-request-property":                                       "добавлено новое необязательное поле запроса %s",
 	"ru.messages.new-request-path-parameter":                                          "добален новый path параметр запроса %s",
+	"ru.messages.new-required-request-cookie-property":                                "в cookie запроса %s добавлено новое обязательное поле %s",
 	"ru.messages.new-required-request-default-parameter-to-existing-path":             "добавлен новый обязательный %s параметр запроса %s для всех операций пути",
 	"ru.messages.new-required-request-header-property":                                "в заголовке запроса %s добавлено новое обязательное поле %s",
 	"ru.messages.new-required-request-parameter":                                      "добавлен новый обязательный %s параметр зароса %s",
 	"ru.messages.new-required-request-property":                                       "добавлено новое обязательное поле запроса %s",
 	"ru.messages.optional-response-header-removed":                                    "удалён ранее необязательный заголовок ответа %s для ответа со статусом %s",
 	"ru.messages.pattern-changed-warn-comment":                                        "Это предупреждение, потому что сложно автоматически проанализировать, является ли новый шаблон надмножеством предыдущего шаблона (например, изменен с '[0-9]+' на '[0-9]*').",
+	"ru.messages.pattern-incomparable-comment":                                        "Каждый из шаблонов допускает значения, которые не допускает другой шаблон.",
+	"ru.messages.request-body-additional-properties-disallowed":                       "тело запроса больше не допускает дополнительные поля",
+	"ru.messages.request-body-additional-properties-narrowed":                         "тело запроса теперь ограничивает дополнительные поля схемой",
 	"ru.messages.request-body-all-of-added":                                           "добавлено %s в список 'allOf' тела запроса",
 	"ru.messages.request-body-all-of-removed":                                         "удалён %s из списка 'allOf' тела запроса",
 	"ru.messages.request-body-any-of-added":                                           "добавлено %s в список 'anyOf' тела запроса",
@@ -590,16 +946,33 @@ var localizations = map[string]string{
 	"ru.messages.request-body-discriminator-mapping-deleted":                          "удалены ключи сопоставления %s из дискриминатора запроса",
 	"ru.messages.request-body-discriminator-property-name-changed":                    "имя свойства дискриминатора запроса изменено с %s на %s",
 	"ru.messages.request-body-discriminator-removed":                                  "удален дискриминатор запроса",
+	"ru.messages.request-body-encoding-allow-reserved-added":                          "зарезервированные символы теперь допускаются без percent-encoding в части %s тела запроса в типе медиа %s",
+	"ru.messages.request-body-encoding-allow-reserved-removed":                        "зарезервированные символы больше не допускаются без percent-encoding в части %s тела запроса в типе медиа %s",
+	"ru.messages.request-body-encoding-content-type-changed":                          "тип содержимого части %s тела запроса изменён с %s на %s в типе медиа %s",
+	"ru.messages.request-body-encoding-explode-changed":                               "explode части %s тела запроса изменён с %s на %s в типе медиа %s",
+	"ru.messages.request-body-encoding-header-became-required":                        "в части %s тела запроса заголовок %s стал обязательным в типе медиа %s",
+	"ru.messages.request-body-encoding-new-required-header":                           "в часть %s тела запроса добавлен новый обязательный заголовок %s в типе медиа %s",
+	"ru.messages.request-body-encoding-style-changed":                                 "style части %s тела запроса изменён с %s на %s в типе медиа %s",
 	"ru.messages.request-body-enum-value-removed":                                     "значение перечисления тела запроса удалено %s",
+	"ru.messages.request-body-example-invalid":                                        "в типе медиа %s тела запроса пример %s не соответствует схеме (%s)",
+	"ru.messages.request-body-exclusive-max-set":                                      "у тела запроса max %s стал исключающим",
+	"ru.messages.request-body-exclusive-min-set":                                      "у тела запроса min %s стал исключающим",
 	"ru.messages.request-body-max-decreased":                                          "значение max у тела запроса уменьшено до %s",
 	"ru.messages.request-body-max-increased":                                          "максимум тела запроса был увеличен с %s до %s",
 	"ru.messages.request-body-max-length-decreased":                                   "значение maxLength у тела запроса уменьшено до %s",
 	"ru.messages.request-body-max-length-increased":                                   "максимальная длина тела запроса была увеличена с %s до %s",
 	"ru.messages.request-body-max-length-set":                                         "у тела запроса задано значение maxLength в %s",
 	"ru.messages.request-body-max-length-set-comment":                                 "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-body-max-properties-decreased":                               "значение maxProperties у тела запроса уменьшено с %s до %s",
+	"ru.messages.request-body-max-properties-increased":                               "значение maxProperties у тела запроса увеличено с %s до %s",
+	"ru.messages.request-body-max-properties-set":                                     "у тела запроса задано значение maxProperties в %s",
+	"ru.messages.request-body-max-properties-set-comment":                             "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
 	"ru.messages.request-body-max-set":                                                "у тела запроса задано значение max в %s",
 	"ru.messages.request-body-max-set-comment":                                        "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
 	"ru.messages.request-body-media-type-added":                                       "добавлен тип медиа для тела запроса %s",
+	"ru.messages.request-body-media-type-broadened":                                   "тип медиа тела запроса %s расширен до %s",
+	"ru.messages.request-body-media-type-changed":                                     "тип медиа тела запроса %s изменён на %s",
+	"ru.messages.request-body-media-type-narrowed":                                    "тип медиа тела запроса %s сужен до %s",
 	"ru.messages.request-body-media-type-removed":                                     "удален тип медиа для тела запроса %s",
 	"ru.messages.request-body-min-decreased":                                          "минимум тела запроса был уменьшен с %s до %s",
 	"ru.messages.request-body-min-increased":                                          "значение min у тела запроса увеличено до %s",
@@ -609,17 +982,31 @@ var localizations = map[string]string{
 	"ru.messages.request-body-min-items-set-comment":                                  "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
 	"ru.messages.request-body-min-length-decreased":                                   "минимальная длина тела запроса была уменьшена с %s до %s",
 	"ru.messages.request-body-min-length-increased":                                   "минимальная длина тела запроса была увеличена с %s до %s",
+	"ru.messages.request-body-min-properties-decreased":                               "значение minProperties у тела запроса уменьшено с %s до %s",
+	"ru.messages.request-body-min-properties-increased":                               "значение minProperties у тела запроса увеличено с %s до %s",
 	"ru.messages.request-body-min-set":                                                "задано значение min у тела запроса в %s",
 	"ru.messages.request-body-min-set-comment":                                        "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-body-multiple-of-changed":                                    "значение multipleOf у тела запроса изменено с %s на %s",
+	"ru.messages.request-body-multiple-of-set":                                        "у тела запроса задано значение multipleOf в %s",
+	"ru.messages.request-body-multiple-of-set-comment":                                "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-body-not-schema-added":                                       "в тело запроса добавлена схема 'not'",
+	"ru.messages.request-body-not-schema-broadened":                                   "расширена схема 'not' тела запроса",
 	"ru.messages.request-body-one-of-added":                                           "добавлено %s в список 'oneOf' тела запроса",
 	"ru.messages.request-body-one-of-removed":                                         "удалён %s из списка 'oneOf' тела запроса",
 	"ru.messages.request-body-type-changed":                                           "изменился type/format тела запроса с %s/%s на %s/%s",
+	"ru.messages.request-body-unique-items-set":                                       "у тела запроса установлен uniqueItems",
+	"ru.messages.request-cookie-property-became-enum":                                 "в cookie запроса %s поле %s было ограничено списком значений перечисления",
+	"ru.messages.request-cookie-property-became-required":                             "в cookie запроса %s поле %s стало обязательным",
 	"ru.messages.request-header-property-became-enum":                                 "свойство %s заголовка запроса %s было ограничено списком значений перечисления",
 	"ru.messages.request-header-property-became-required":                             "в заголовке запроса %s поле %s стало обязательным",
 	"ru.messages.request-optional-property-became-not-read-only":                      "необязательное поле запроса %s перестало быть только для чтения",
 	"ru.messages.request-optional-property-became-not-write-only":                     "необязательное поле запроса %s перестало быть только для записи",
 	"ru.messages.request-optional-property-became-read-only":                          "необязательное поле запроса %s стало только для чтения",
 	"ru.messages.request-optional-property-became-write-only":                         "необязательное поле запроса %s стало только для записи",
+	"ru.messages.request-parameter-allow-empty-value-added":                           "в %s параметре запроса %s, пустые значения теперь допускаются",
+	"ru.messages.request-parameter-allow-empty-value-removed":                         "в %s параметре запроса %s, пустые значения больше не допускаются",
+	"ru.messages.request-parameter-allow-reserved-added":                              "в %s параметре запроса %s, зарезервированные символы теперь допускаются без percent-encoding",
+	"ru.messages.request-parameter-allow-reserved-removed":                            "в %s параметре запроса %s, зарезервированные символы больше не допускаются без percent-encoding",
 	"ru.messages.request-parameter-became-enum":                                       "заголовок запроса %s поле %s было ограничено списком значений перечисления",
 	"ru.messages.request-parameter-became-optional":                                   "ранее необязательный параметр запроса %s %s теперь является необязательным",
 	"ru.messages.request-parameter-became-required":                                   "ранее необязательный %s параметр запроса %s стал обязательным",
@@ -628,6 +1015,10 @@ var localizations = map[string]string{
 	"ru.messages.request-parameter-default-value-removed":                             "для параметра запроса %s удалено значение по умолчанию %s",
 	"ru.messages.request-parameter-enum-value-added":                                  "добавлено значение enum %s у %s параметра запроса %s",
 	"ru.messages.request-parameter-enum-value-removed":                                "удалено значение enum %s у %s параметра запроса %s",
+	"ru.messages.request-parameter-example-invalid":                                   "в %s параметре запроса %s пример %s не соответствует схеме (%s)",
+	"ru.messages.request-parameter-exclusive-max-set":                                 "в %s параметре запроса %s, max %s стал исключающим",
+	"ru.messages.request-parameter-exclusive-min-set":                                 "в %s параметре запроса %s, min %s стал исключающим",
+	"ru.messages.request-parameter-explode-changed":                                   "в %s параметре запроса %s, explode изменён с %s на %s",
 	"ru.messages.request-parameter-max-decreased":                                     "в %s параметре запроса %s, max уменьшен с %s до %s",
 	"ru.messages.request-parameter-max-increased":                                     "в %s параметре запроса %s, max увеличен с %s до %s",
 	"ru.messages.request-parameter-max-items-decreased":                               "в %s параметре запроса %s, maxItems уменьшен с %s до %s",
@@ -636,6 +1027,10 @@ var localizations = map[string]string{
 	"ru.messages.request-parameter-max-length-increased":                              "в %s параметре запроса %s, maxLength увеличен с %s до %s",
 	"ru.messages.request-parameter-max-length-set":                                    "в %s параметре запроса %s, maxLength установлен в %s",
 	"ru.messages.request-parameter-max-length-set-comment":                            "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-parameter-max-properties-decreased":                          "в %s параметре запроса %s, maxProperties уменьшен с %s до %s",
+	"ru.messages.request-parameter-max-properties-increased":                          "в %s параметре запроса %s, maxProperties увеличен с %s до %s",
+	"ru.messages.request-parameter-max-properties-set":                                "в %s параметре запроса %s, maxProperties установлен в %s",
+	"ru.messages.request-parameter-max-properties-set-comment":                        "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
 	"ru.messages.request-parameter-max-set":                                           "в %s параметре запроса %s, max установлен в %s",
 	"ru.messages.request-parameter-max-set-comment":                                   "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
 	"ru.messages.request-parameter-min-decreased":                                     "в %s параметре запроса %s, min уменьшен с %s до %s",
@@ -646,14 +1041,28 @@ var localizations = map[string]string{
 	"ru.messages.request-parameter-min-items-set-comment":                             "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
 	"ru.messages.request-parameter-min-length-decreased":                              "в %s параметре запроса %s, minLength уменьшен с %s до %s",
 	"ru.messages.request-parameter-min-length-increased":                              "в %s параметре запроса %s, minLength увеличен с %s до %s",
+	"ru.messages.request-parameter-min-properties-decreased":                          "в %s параметре запроса %s, minProperties уменьшен с %s до %s",
+	"ru.messages.request-parameter-min-properties-increased":                          "в %s параметре запроса %s, minProperties увеличен с %s до %s",
 	"ru.messages.request-parameter-min-set":                                           "в %s параметре запроса %s, min установлен в %s",
 	"ru.messages.request-parameter-min-set-comment":                                   "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-parameter-multiple-of-changed":                               "в %s параметре запроса %s, multipleOf изменён с %s на %s",
+	"ru.messages.request-parameter-multiple-of-set":                                   "в %s параметре запроса %s, multipleOf установлен в %s",
+	"ru.messages.request-parameter-multiple-of-set-comment":                           "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-parameter-not-schema-added":                                  "в %s параметр запроса %s добавлена схема 'not'",
+	"ru.messages.request-parameter-not-schema-broadened":                              "расширена схема 'not' %s параметра запроса %s",
 	"ru.messages.request-parameter-pattern-added":                                     "добавлен pattern %s у %s параметра запроса %s",
+	"ru.messages.request-parameter-pattern-broadened":                                 "расширен pattern у %s параметра запроса %s со значения %s на значение %s",
 	"ru.messages.request-parameter-pattern-changed":                                   "изменён pattern у %s параметра запроса %s со значения %s на значение %s",
+	"ru.messages.request-parameter-pattern-incomparable":                              "изменён pattern у %s параметра запроса %s со значения %s на значение %s",
+	"ru.messages.request-parameter-pattern-narrowed":                                  "сужен pattern у %s параметра запроса %s со значения %s на значение %s",
 	"ru.messages.request-parameter-pattern-removed":                                   "удалён pattern %s у %s параметра запроса %s",
 	"ru.messages.request-parameter-removed":                                           "удалён %s параметр запроса %s",
+	"ru.messages.request-parameter-style-changed":                                     "в %s параметре запроса %s, style изменён с %s на %s",
 	"ru.messages.request-parameter-type-changed":                                      "в %s параметре запроса %s, type/format изменился с %s/%s на %s/%s",
+	"ru.messages.request-parameter-unique-items-set":                                  "в %s параметре запроса %s, установлен uniqueItems",
 	"ru.messages.request-parameter-x-extensible-enum-value-removed":                   "удалено из x-extensible-enum значение %s у %s параметра запроса %s",
+	"ru.messages.request-property-additional-properties-disallowed":                   "поле запроса %s больше не допускает дополнительные поля",
+	"ru.messages.request-property-additional-properties-narrowed":                     "поле запроса %s теперь ограничивает дополнительные поля схемой",
 	"ru.messages.request-property-all-of-added":                                       "добавлено %s в список 'allOf' свойства запроса %s",
 	"ru.messages.request-property-all-of-removed":                                     "удалён %s из списка 'allOf' свойства запроса %s",
 	"ru.messages.request-property-any-of-added":                                       "добавлено %s в список 'anyOf' свойства запроса %s",
@@ -674,12 +1083,18 @@ var localizations = map[string]string{
 	"ru.messages.request-property-discriminator-removed":                              "удален дискриминатор из свойства запроса %s",
 	"ru.messages.request-property-enum-value-added":                                   "добавлено enum значение %s у поля запроса %s",
 	"ru.messages.request-property-enum-value-removed":                                 "удалено enum значение %s у поля запроса %s",
+	"ru.messages.request-property-exclusive-max-set":                                  "у поля запроса %s max %s стал исключающим",
+	"ru.messages.request-property-exclusive-min-set":                                  "у поля запроса %s min %s стал исключающим",
 	"ru.messages.request-property-max-decreased":                                      "значение max у поля запроса %s уменьшено до %s",
 	"ru.messages.request-property-max-increased":                                      "максимум свойства запроса %s был увеличен с %s до %s",
 	"ru.messages.request-property-max-length-decreased":                               "значение maxLength у поля запроса %s уменьшено до %s",
 	"ru.messages.request-property-max-length-increased":                               "максимальная длина свойства запроса %s была увеличена с %s до %s",
 	"ru.messages.request-property-max-length-set":                                     "у поля запроса %s задано значение maxLength в %s",
 	"ru.messages.request-property-max-length-set-comment":                             "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-property-max-properties-decreased":                           "значение maxProperties у поля запроса %s уменьшено с %s до %s",
+	"ru.messages.request-property-max-properties-increased":                           "значение maxProperties у поля запроса %s увеличено с %s до %s",
+	"ru.messages.request-property-max-properties-set":                                 "у поля запроса %s задано значение maxProperties в %s",
+	"ru.messages.request-property-max-properties-set-comment":                         "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
 	"ru.messages.request-property-max-set":                                            "у поля запроса %s задано значение max в %s",
 	"ru.messages.request-property-max-set-comment":                                    "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
 	"ru.messages.request-property-min-decreased":                                      "минимум свойства запроса %s был уменьшен с %s до %s",
@@ -690,21 +1105,34 @@ var localizations = map[string]string{
 	"ru.messages.request-property-min-items-set-comment":                              "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
 	"ru.messages.request-property-min-length-decreased":                               "минимальная длина свойства запроса %s была уменьшена с %s до %s",
 	"ru.messages.request-property-min-length-increased":                               "минимальная длина свойства запроса %s была увеличена с %s до %s",
+	"ru.messages.request-property-min-properties-decreased":                           "значение minProperties у поля запроса %s уменьшено с %s до %s",
+	"ru.messages.request-property-min-properties-increased":                           "значение minProperties у поля запроса %s увеличено с %s до %s",
 	"ru.messages.request-property-min-set":                                            "у поля запроса %s задано значение min в %s",
 	"ru.messages.request-property-min-set-comment":                                    "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-property-multiple-of-changed":                                "значение multipleOf у поля запроса %s изменено с %s на %s",
+	"ru.messages.request-property-multiple-of-set":                                    "у поля запроса %s задано значение multipleOf в %s",
+	"ru.messages.request-property-multiple-of-set-comment":                            "Это предупреждение, потому что иногда его требуется установить из соображений безопасности или из-за текущей ошибки в спецификации. Но хорошие клиенты должны быть проверены на поддержку этого ограничения перед внесением таких изменений в спецификацию.",
+	"ru.messages.request-property-not-schema-added":                                   "в поле запроса %s добавлена схема 'not'",
+	"ru.messages.request-property-not-schema-broadened":                               "расширена схема 'not' поля запроса %s",
 	"ru.messages.request-property-one-of-added":                                       "добавлено %s в список 'oneOf' свойства запроса %s",
 	"ru.messages.request-property-one-of-removed":                                     "удалён %s из списка 'oneOf' свойства запроса %s",
 	"ru.messages.request-property-pattern-added":                                      "добавлен pattern %s у поля запроса %s",
+	"ru.messages.request-property-pattern-broadened":                                  "расширен pattern у поля запроса %s со значения %s на значение %s",
 	"ru.messages.request-property-pattern-changed":                                    "изменён pattern у поля запроса %s со значения %s на значение %s",
+	"ru.messages.request-property-pattern-incomparable":                               "изменён pattern у поля запроса %s со значения %s на значение %s",
+	"ru.messages.request-property-pattern-narrowed":                                   "сужен pattern у поля запроса %s со значения %s на значение %s",
 	"ru.messages.request-property-pattern-removed":                                    "удалён pattern %s у поля запроса %s",
 	"ru.messages.request-property-removed":                                            "удалено поле запроса %s",
 	"ru.messages.request-property-type-changed":                                       "у поля запроса %s изменился type/format с %s/%s на %s/%s",
+	"ru.messages.request-property-unique-items-set":                                   "у поля запроса %s установлен uniqueItems",
 	"ru.messages.request-property-x-extensible-enum-value-removed":                    "удалено значение x-extensible-enum %s в поле запроса %s",
 	"ru.messages.request-required-property-became-not-read-only":                      "обязательное поле запроса %s перестало быть только для чтения",
 	"ru.messages.request-required-property-became-not-write-only":                     "обязательное поле запроса %s перестало быть только для записи",
 	"ru.messages.request-required-property-became-read-only":                          "обязательное поле запроса %s стало только для чтения",
 	"ru.messages.request-required-property-became-write-only":                         "обязательное поле запроса %s стало только для записи",
 	"ru.messages.required-response-header-removed":                                    "удалён ранее обязательный заголовок ответа %s для ответа со статусом %s",
+	"ru.messages.response-body-additional-properties-allowed":                         "тело ответа теперь допускает дополнительные поля для статуса %s",
+	"ru.messages.response-body-additional-properties-widened":                         "тело ответа больше не ограничивает дополнительные поля схемой для статуса %s",
 	"ru.messages.response-body-all-of-added":                                          "добавлено %s в список 'allOf' тела ответа для статуса ответа %s",
 	"ru.messages.response-body-all-of-removed":                                        "удалён %s из списка 'allOf' тела ответа для статуса ответа %s",
 	"ru.messages.response-body-any-of-added":                                          "добавлено %s в список 'anyOf' тела ответа для статуса ответа %s",
@@ -719,21 +1147,41 @@ var localizations = map[string]string{
 	"ru.messages.response-body-discriminator-mapping-deleted":                         "удалены ключи сопоставления %s из дискриминатора ответа для статуса ответа %s",
 	"ru.messages.response-body-discriminator-property-name-changed":                   "имя свойства дискриминатора ответа изменено с %s на %s для статуса ответа %s",
 	"ru.messages.response-body-discriminator-removed":                                 "удален дискриминатор ответа для статуса ответа %s",
+	"ru.messages.response-body-example-invalid":                                       "в типе медиа %s ответа пример %s не соответствует схеме (%s) для статуса %s",
+	"ru.messages.response-body-exclusive-max-unset":                                   "у тела ответа max %s больше не исключающий",
+	"ru.messages.response-body-exclusive-min-unset":                                   "у тела ответа min %s больше не исключающий",
 	"ru.messages.response-body-max-increased":                                         "у тела ответа max увеличен с %s до %s",
 	"ru.messages.response-body-max-length-increased":                                  "у тела ответа maxLength увеличен с %s до %s",
 	"ru.messages.response-body-max-length-unset":                                      "у тела ответа maxLength был удалён, предыдущее значение - %s",
+	"ru.messages.response-body-max-properties-increased":                              "у тела ответа maxProperties увеличен с %s до %s",
+	"ru.messages.response-body-max-properties-unset":                                  "у тела ответа maxProperties был удалён, предыдущее значение - %s",
 	"ru.messages.response-body-min-decreased":                                         "у тела ответа min уменьшено с %s до %s",
 	"ru.messages.response-body-min-items-decreased":                                   "у тела ответа minItems уменьшено с %s до %s",
 	"ru.messages.response-body-min-items-unset":                                       "удалено значение minItems для тела ответа, предыдущее значение - %s",
 	"ru.messages.response-body-min-length-decreased":                                  "значение minLength для тела ответа уменьшено с %s до %s",
+	"ru.messages.response-body-min-properties-decreased":                              "у тела ответа minProperties уменьшен с %s до %s",
+	"ru.messages.response-body-multiple-of-changed":                                   "у тела ответа multipleOf изменён с %s на %s",
+	"ru.messages.response-body-multiple-of-unset":                                     "у тела ответа multipleOf был удалён, предыдущее значение - %s",
+	"ru.messages.response-body-not-schema-removed":                                    "из тела ответа удалена схема 'not' для статуса %s",
 	"ru.messages.response-body-one-of-added":                                          "добавлено %s в список 'oneOf' тела ответа для статуса ответа %s",
 	"ru.messages.response-body-one-of-removed":                                        "удалён %s из списка 'oneOf' тела ответа для статуса ответа %s",
 	"ru.messages.response-body-type-changed":                                          "у тела ответа type/format изменился с %s/%s на %s/%s для ответа со статусом %s",
+	"ru.messages.response-body-unique-items-unset":                                    "у тела ответа удалён uniqueItems",
 	"ru.messages.response-header-became-optional":                                     "заголовок ответа %s стал необязательным для ответа со статусом %s",
+	"ru.messages.response-link-added":                                                 "добавлена ссылка %s в ответ со статусом %s",
+	"ru.messages.response-link-parameter-added":                                       "добавлен параметр %s в ссылку %s в ответе со статусом %s",
+	"ru.messages.response-link-parameter-changed":                                     "параметр %s ссылки %s изменён с %s на %s в ответе со статусом %s",
+	"ru.messages.response-link-parameter-removed":                                     "удалён параметр %s из ссылки %s в ответе со статусом %s",
+	"ru.messages.response-link-removed":                                               "удалена ссылка %s из ответа со статусом %s",
+	"ru.messages.response-link-target-changed":                                        "цель ссылки %s изменена с %s на %s в ответе со статусом %s",
 	"ru.messages.response-media-type-added":                                           "добавлен тип медиа %s для ответа со статусом %s",
+	"ru.messages.response-media-type-broadened":                                       "тип медиа %s расширен до %s для ответа со статусом %s",
+	"ru.messages.response-media-type-changed":                                         "тип медиа %s изменён на %s для ответа со статусом %s",
+	"ru.messages.response-media-type-narrowed":                                        "тип медиа %s сужен до %s для ответа со статусом %s",
 	"ru.messages.response-media-type-removed":                                         "удалён media type %s для ответа со статусом %s",
 	"ru.messages.response-mediatype-enum-value-removed":                               "значение перечисления схемы ответа %s удалено %s",
 	"ru.messages.response-non-success-status-added":                                   "добавлен ответ об отсутствии успеха со статусом %s",
+	"ru.messages.response-non-success-status-covered":                                 "удален неуспешный ответ со статусом %s, но статус по-прежнему описан ответом %s",
 	"ru.messages.response-non-success-status-removed":                                 "удален неуспешный (не 2xx) статус ответа %s",
 	"ru.messages.response-optional-property-added":                                    "добавлено необязательное свойство %s в ответе со статусом %s",
 	"ru.messages.response-optional-property-became-not-read-only":                     "необязательное свойство %s перестало быть только для чтения для ответа со статусом %s",
@@ -743,6 +1191,8 @@ var localizations = map[string]string{
 	"ru.messages.response-optional-property-removed":                                  "удалено необязательное поле %s из ответа со статусом %s",
 	"ru.messages.response-optional-write-only-property-added":                         "добавлено необязательное свойство только для записи %s в ответе со статусом %s",
 	"ru.messages.response-optional-write-only-property-removed":                       "удалено необязательное свойство только для записи %s из ответа со статусом %s",
+	"ru.messages.response-property-additional-properties-allowed":                     "поле ответа %s теперь допускает дополнительные поля для статуса %s",
+	"ru.messages.response-property-additional-properties-widened":                     "поле ответа %s больше не ограничивает дополнительные поля схемой для статуса %s",
 	"ru.messages.response-property-all-of-added":                                      "добавлено %s в список 'allOf' свойства ответа %s для статуса ответа %s",
 	"ru.messages.response-property-all-of-removed":                                    "удалён %s из списка 'allOf' свойства ответа %s для статуса ответа %s",
 	"ru.messages.response-property-any-of-added":                                      "добавлено %s в список 'anyOf' свойства ответа %s для статуса ответа %s",
@@ -763,19 +1213,31 @@ var localizations = map[string]string{
 	"ru.messages.response-property-enum-value-added":                                  "добавлено новое enum значение %s в поле ответа %s для ответа со статусом %s",
 	"ru.messages.response-property-enum-value-added-comment":                          "Добавление новых значений перечисления в ответ может быть неожиданным для клиентов, вместо этого используйте x-extensible-enum.",
 	"ru.messages.response-property-enum-value-removed":                                "удалено значение перечисления %s из свойства ответа %s для статуса ответа %s.",
+	"ru.messages.response-property-exclusive-max-unset":                               "у поля ответа %s max %s больше не исключающий для ответа со статусом %s",
+	"ru.messages.response-property-exclusive-min-unset":                               "у поля ответа %s min %s больше не исключающий для ответа со статусом %s",
 	"ru.messages.response-property-max-increased":                                     "у поля ответа %s max увеличен с %s до %s для ответа со статусом %s",
 	"ru.messages.response-property-max-length-increased":                              "у поля ответа %s maxLength увеличен с %s до %s для ответа со статусом %s",
 	"ru.messages.response-property-max-length-unset":                                  "у поля ответа %s maxLength был удалён, предыдущее значение - %s, для ответа со статусом %s",
+	"ru.messages.response-property-max-properties-increased":                          "у поля ответа %s maxProperties увеличен с %s до %s для ответа со статусом %s",
+	"ru.messages.response-property-max-properties-unset":                              "у поля ответа %s maxProperties был удалён, предыдущее значение - %s, для ответа со статусом %s",
 	"ru.messages.response-property-min-decreased":                                     "для поля ответа %s min уменьшен с %s до %s для ответа со статусом %s",
 	"ru.messages.response-property-min-items-decreased":                               "у поля ответа %s уменьшено minItems с %s до %s для ответа со статусом %s",
 	"ru.messages.response-property-min-items-unset":                                   "у поля ответа %s удалено значение minItems, предыдущее значение - %s, для ответа со статусом %s",
 	"ru.messages.response-property-min-length-decreased":                              "для поля ответа %s minLength уменьшен с %s до %s для ответа со статусом %s",
+	"ru.messages.response-property-min-properties-decreased":                          "у поля ответа %s minProperties уменьшен с %s до %s для ответа со статусом %s",
+	"ru.messages.response-property-multiple-of-changed":                               "у поля ответа %s multipleOf изменён с %s на %s для ответа со статусом %s",
+	"ru.messages.response-property-multiple-of-unset":                                 "у поля ответа %s multipleOf был удалён, предыдущее значение - %s, для ответа со статусом %s",
+	"ru.messages.response-property-not-schema-removed":                                "из поля ответа %s удалена схема 'not' для статуса %s",
 	"ru.messages.response-property-one-of-added":                                      "добавлено %s в список 'oneOf' свойства ответа %s для статуса ответа %s",
 	"ru.messages.response-property-one-of-removed":                                    "удалён %s из списка 'oneOf' свойства ответа %s для статуса ответа %s",
 	"ru.messages.response-property-pattern-added":                                     "у свойства %s для ответа со статусом %s добавлен паттерн %s",
+	"ru.messages.response-property-pattern-broadened":                                 "у свойства %s ответа расширен паттерн с %s на %s для статуса %s",
 	"ru.messages.response-property-pattern-changed":                                   "у свойства %s для ответа со статусом %s изменился паттерн с %s на %s",
+	"ru.messages.response-property-pattern-incomparable":                              "у свойства %s ответа изменился паттерн с %s на %s для статуса %s",
+	"ru.messages.response-property-pattern-narrowed":                                  "у свойства %s ответа сужен паттерн с %s на %s для статуса %s",
 	"ru.messages.response-property-pattern-removed":                                   "у свойства %s для ответа со статусом %s удален паттерн %s",
 	"ru.messages.response-property-type-changed":                                      "у поля type/format изменился с %s/%s на %s/%s для ответа со статусом %s",
+	"ru.messages.response-property-unique-items-unset":                                "у поля ответа %s удалён uniqueItems для ответа со статусом %s",
 	"ru.messages.response-required-property-added":                                    "добавил требуемое свойство %s в ответ со статусом %s",
 	"ru.messages.response-required-property-became-not-read-only":                     "обязательное свойство %s перестало быть только для чтения для ответа со статусом %s",
 	"ru.messages.response-required-property-became-not-write-only":                    "обязательное поле ответа %s перестало быть write-only для ответа со статусом %s",
@@ -785,6 +1247,7 @@ var localizations = map[string]string{
 	"ru.messages.response-required-write-only-property-added":                         "добавлено обязательное свойство только для записи %s в ответе со статусом %s",
 	"ru.messages.response-required-write-only-property-removed":                       "удалено обязательное свойство только для записи %s из ответа со статусом %s",
 	"ru.messages.response-success-status-added":                                       "добавлен ответ об успехе со статусом %s",
+	"ru.messages.response-success-status-covered":                                     "удален успешный ответ со статусом %s, но статус по-прежнему описан ответом %s",
 	"ru.messages.response-success-status-removed":                                     "удален успешный (2xx) статус ответа %s",
 	"ru.messages.response-write-only-property-became-optional":                        "свойство только для записи %s перестало быть обязательным для ответа со статусом %s",
 	"ru.messages.response-write-only-property-became-required":                        "свойство только для записи %s перестало быть необязательным для ответа со статусом %s",
@@ -792,6 +1255,19 @@ var localizations = map[string]string{
 	"ru.messages.sunset-deleted":                                                      "удалена дата sunset date у API, но сохранён deprecated=true",
 	"ru.messages.total-changes":                                                       "%s изменений: %s %s, %s %s, %s %s\n",
 	"ru.messages.total-errors":                                                        "%s критические изменения: %s %s, %s %s\n",
+	"ru.messages.webhook-added":                                                       "добавлен вебхук %s",
+	"ru.messages.webhook-operation-added":                                             "добавлена операция в вебхук %s",
+	"ru.messages.webhook-operation-removed":                                           "удалена операция из вебхука %s",
+	"ru.messages.webhook-removed":                                                     "удален вебхук %s",
+	"ru.messages.webhook-request-new-optional-property":                               "добавлено новое необязательное поле запроса %s в вебхук %s",
+	"ru.messages.webhook-request-new-required-property":                               "добавлено новое обязательное поле запроса %s в вебхук %s",
+	"ru.messages.webhook-request-optional-property-removed":                           "удалено необязательное поле запроса %s из вебхука %s",
+	"ru.messages.webhook-request-property-type-changed":                               "изменен тип поля запроса %s с %s на %s в вебхуке %s",
+	"ru.messages.webhook-request-required-property-removed":                           "удалено обязательное поле запроса %s из вебхука %s",
+	"ru.messages.webhook-response-new-required-property":                              "добавлено новое обязательное поле ответа %s со статусом %s в вебхук %s",
+	"ru.messages.webhook-response-property-became-required":                           "поле ответа %s со статусом %s стало обязательным в вебхуке %s",
+	"ru.messages.webhook-response-success-status-added":                               "добавлен успешный статус ответа %s в вебхук %s",
+	"ru.messages.webhook-response-success-status-removed":                             "удален успешный статус ответа %s из вебхука %s",
 }
 
 type Replacements map[string]interface{}
@@ -0,0 +1,61 @@
+package checker
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/load"
+)
+
+// withLocation sets the source location of the change to that of the first element which has one
+func (c ApiChange) withLocation(config *Config, elements ...any) ApiChange {
+	if location := config.getLocation(elements...); location != nil {
+		c.SourceFile, c.SourceLine, c.SourceLineEnd, c.SourceColumn, c.SourceColumnEnd = unpackLocation(location)
+	}
+	return c
+}
+
+// withLocation sets the source location of the change to that of the first element which has one
+func (c ComponentChange) withLocation(config *Config, elements ...any) ComponentChange {
+	if location := config.getLocation(elements...); location != nil {
+		c.SourceFile, c.SourceLine, c.SourceLineEnd, c.SourceColumn, c.SourceColumnEnd = unpackLocation(location)
+	}
+	return c
+}
+
+// withLocation sets the source location of the change to that of the first element which has one
+func (c SecurityChange) withLocation(config *Config, elements ...any) SecurityChange {
+	if location := config.getLocation(elements...); location != nil {
+		c.SourceFile, c.SourceLine, c.SourceLineEnd, c.SourceColumn, c.SourceColumnEnd = unpackLocation(location)
+	}
+	return c
+}
+
+// withLocation sets the source location of the change to that of the first element which has one
+func (c ServerChange) withLocation(config *Config, elements ...any) ServerChange {
+	if location := config.getLocation(elements...); location != nil {
+		c.SourceFile, c.SourceLine, c.SourceLineEnd, c.SourceColumn, c.SourceColumnEnd = unpackLocation(location)
+	}
+	return c
+}
+
+func unpackLocation(location *load.Location) (string, int, int, int, int) {
+	return location.File, location.Line, location.LineEnd, location.Column, location.ColumnEnd
+}
+
+// requestBodyContent returns the request body content of an operation or nil if there is none
+func requestBodyContent(operation *openapi3.Operation) openapi3.Content {
+	if operation == nil || operation.RequestBody == nil || operation.RequestBody.Value == nil {
+		return nil
+	}
+	return operation.RequestBody.Value.Content
+}
+
+// operationResponse returns the response of an operation for the given status or nil if there is none
+func operationResponse(operation *openapi3.Operation, status string) *openapi3.Response {
+	if operation == nil {
+		return nil
+	}
+	if responseRef := operation.Responses.Value(status); responseRef != nil {
+		return responseRef.Value
+	}
+	return nil
+}
@@ -54,6 +54,22 @@ func GetAllRules() []BackwardCompatibilityRule {
 		newBackwardCompatibilityRule(APIPathRemovedBeforeSunsetId, ERR, true, APIRemovedCheck),
 		newBackwardCompatibilityRule(APIRemovedWithoutDeprecationId, ERR, true, APIRemovedCheck),
 		newBackwardCompatibilityRule(APIRemovedBeforeSunsetId, ERR, true, APIRemovedCheck),
+		// APIServersUpdatedCheck
+		newBackwardCompatibilityRule(APIServerRemovedId, ERR, true, APIServersUpdatedCheck),
+		newBackwardCompatibilityRule(APIServerAddedId, INFO, true, APIServersUpdatedCheck),
+		newBackwardCompatibilityRule(APIServerURLChangedId, ERR, true, APIServersUpdatedCheck),
+		newBackwardCompatibilityRule(APIServerVariableEnumValueRemovedId, ERR, true, APIServersUpdatedCheck),
+		newBackwardCompatibilityRule(APIServerVariableDefaultChangedId, WARN, true, APIServersUpdatedCheck),
+		newBackwardCompatibilityRule(APIPathServerRemovedId, ERR, true, APIServersUpdatedCheck),
+		newBackwardCompatibilityRule(APIPathServerAddedId, INFO, true, APIServersUpdatedCheck),
+		newBackwardCompatibilityRule(APIPathServerURLChangedId, ERR, true, APIServersUpdatedCheck),
+		newBackwardCompatibilityRule(APIPathServerVariableEnumValueRemovedId, ERR, true, APIServersUpdatedCheck),
+		newBackwardCompatibilityRule(APIPathServerVariableDefaultChangedId, WARN, true, APIServersUpdatedCheck),
+		newBackwardCompatibilityRule(APIOperationServerRemovedId, ERR, true, APIServersUpdatedCheck),
+		newBackwardCompatibilityRule(APIOperationServerAddedId, INFO, true, APIServersUpdatedCheck),
+		newBackwardCompatibilityRule(APIOperationServerURLChangedId, ERR, true, APIServersUpdatedCheck),
+		newBackwardCompatibilityRule(APIOperationServerVariableEnumValueRemovedId, ERR, true, APIServersUpdatedCheck),
+		newBackwardCompatibilityRule(APIOperationServerVariableDefaultChangedId, WARN, true, APIServersUpdatedCheck),
 		// APISunsetChangedCheck
 		newBackwardCompatibilityRule(APISunsetDeletedId, ERR, true, APISunsetChangedCheck),
 		newBackwardCompatibilityRule(APISunsetDateChangedTooSmallId, ERR, true, APISunsetChangedCheck),
@@ -69,11 +85,23 @@ func GetAllRules() []BackwardCompatibilityRule {
 		newBackwardCompatibilityRule(NewRequestPathParameterId, ERR, true, NewRequestPathParameterCheck),
 		// NewRequiredRequestHeaderPropertyCheck
 		newBackwardCompatibilityRule(NewRequiredRequestHeaderPropertyId, ERR, true, NewRequiredRequestHeaderPropertyCheck),
+		newBackwardCompatibilityRule(NewRequiredRequestCookiePropertyId, ERR, true, NewRequiredRequestHeaderPropertyCheck),
 		// RequestBodyBecameEnumCheck
 		newBackwardCompatibilityRule(RequestBodyBecameEnumId, ERR, true, RequestBodyBecameEnumCheck),
+		// RequestBodyEncodingUpdatedCheck
+		newBackwardCompatibilityRule(RequestBodyEncodingContentTypeChangedId, ERR, true, RequestBodyEncodingUpdatedCheck),
+		newBackwardCompatibilityRule(RequestBodyEncodingNewRequiredHeaderId, ERR, true, RequestBodyEncodingUpdatedCheck),
+		newBackwardCompatibilityRule(RequestBodyEncodingHeaderBecameRequiredId, ERR, true, RequestBodyEncodingUpdatedCheck),
+		newBackwardCompatibilityRule(RequestBodyEncodingStyleChangedId, ERR, true, RequestBodyEncodingUpdatedCheck),
+		newBackwardCompatibilityRule(RequestBodyEncodingExplodeChangedId, ERR, true, RequestBodyEncodingUpdatedCheck),
+		newBackwardCompatibilityRule(RequestBodyEncodingAllowReservedRemovedId, ERR, true, RequestBodyEncodingUpdatedCheck),
+		newBackwardCompatibilityRule(RequestBodyEncodingAllowReservedAddedId, INFO, true, RequestBodyEncodingUpdatedCheck),
 		// RequestBodyMediaTypeChangedCheck
 		newBackwardCompatibilityRule(RequestBodyMediaTypeAddedId, INFO, true, RequestBodyMediaTypeChangedCheck),
 		newBackwardCompatibilityRule(RequestBodyMediaTypeRemovedId, ERR, true, RequestBodyMediaTypeChangedCheck),
+		newBackwardCompatibilityRule(RequestBodyMediaTypeBroadenedId, INFO, true, RequestBodyMediaTypeChangedCheck),
+		newBackwardCompatibilityRule(RequestBodyMediaTypeNarrowedId, ERR, true, RequestBodyMediaTypeChangedCheck),
+		newBackwardCompatibilityRule(RequestBodyMediaTypeChangedId, ERR, true, RequestBodyMediaTypeChangedCheck),
 		// RequestBodyRequiredUpdatedCheck
 		newBackwardCompatibilityRule(RequestBodyBecameOptionalId, INFO, true, RequestBodyRequiredUpdatedCheck),
 		newBackwardCompatibilityRule(RequestBodyBecameRequiredId, ERR, true, RequestBodyRequiredUpdatedCheck),
@@ -92,8 +120,10 @@ func GetAllRules() []BackwardCompatibilityRule {
 		newBackwardCompatibilityRule(RequestPropertyDiscriminatorMappingChangedId, INFO, true, RequestDiscriminatorUpdatedCheck),
 		// RequestHeaderPropertyBecameEnumCheck
 		newBackwardCompatibilityRule(RequestHeaderPropertyBecameEnumId, ERR, true, RequestHeaderPropertyBecameEnumCheck),
+		newBackwardCompatibilityRule(RequestCookiePropertyBecameEnumId, ERR, true, RequestHeaderPropertyBecameEnumCheck),
 		// RequestHeaderPropertyBecameRequiredCheck
 		newBackwardCompatibilityRule(RequestHeaderPropertyBecameRequiredId, ERR, true, RequestHeaderPropertyBecameRequiredCheck),
+		newBackwardCompatibilityRule(RequestCookiePropertyBecameRequiredId, ERR, true, RequestHeaderPropertyBecameRequiredCheck),
 		// RequestParameterBecameEnumCheck
 		newBackwardCompatibilityRule(RequestParameterBecameEnumId, ERR, true, RequestParameterBecameEnumCheck),
 		// RequestParameterDefaultValueChangedCheck
@@ -103,6 +133,9 @@ func GetAllRules() []BackwardCompatibilityRule {
 		// RequestParameterEnumValueUpdatedCheck
 		newBackwardCompatibilityRule(RequestParameterEnumValueAddedId, INFO, true, RequestParameterEnumValueUpdatedCheck),
 		newBackwardCompatibilityRule(RequestParameterEnumValueRemovedId, ERR, true, RequestParameterEnumValueUpdatedCheck),
+		// RequestParameterExclusiveBoundsUpdatedCheck
+		newBackwardCompatibilityRule(RequestParameterExclusiveMinSetId, ERR, true, RequestParameterExclusiveBoundsUpdatedCheck),
+		newBackwardCompatibilityRule(RequestParameterExclusiveMaxSetId, ERR, true, RequestParameterExclusiveBoundsUpdatedCheck),
 		// RequestParameterMaxItemsUpdatedCheck
 		newBackwardCompatibilityRule(RequestParameterMaxItemsIncreasedId, INFO, true, RequestParameterMaxItemsUpdatedCheck),
 		newBackwardCompatibilityRule(RequestParameterMaxItemsDecreasedId, ERR, true, RequestParameterMaxItemsUpdatedCheck),
@@ -111,6 +144,10 @@ func GetAllRules() []BackwardCompatibilityRule {
 		// RequestParameterMaxLengthUpdatedCheck
 		newBackwardCompatibilityRule(RequestParameterMaxLengthIncreasedId, INFO, true, RequestParameterMaxLengthUpdatedCheck),
 		newBackwardCompatibilityRule(RequestParameterMaxLengthDecreasedId, ERR, true, RequestParameterMaxLengthUpdatedCheck),
+		// RequestParameterMaxPropertiesUpdatedCheck
+		newBackwardCompatibilityRule(RequestParameterMaxPropertiesSetId, WARN, true, RequestParameterMaxPropertiesUpdatedCheck),
+		newBackwardCompatibilityRule(RequestParameterMaxPropertiesDecreasedId, ERR, true, RequestParameterMaxPropertiesUpdatedCheck),
+		newBackwardCompatibilityRule(RequestParameterMaxPropertiesIncreasedId, INFO, true, RequestParameterMaxPropertiesUpdatedCheck),
 		// RequestParameterMaxSetCheck
 		newBackwardCompatibilityRule(RequestParameterMaxSetId, WARN, true, RequestParameterMaxSetCheck),
 		// RequestParameterMaxUpdatedCheck
@@ -124,15 +161,24 @@ func GetAllRules() []BackwardCompatibilityRule {
 		// RequestParameterMinLengthUpdatedCheck
 		newBackwardCompatibilityRule(RequestParameterMinLengthIncreasedId, ERR, true, RequestParameterMinLengthUpdatedCheck),
 		newBackwardCompatibilityRule(RequestParameterMinLengthDecreasedId, INFO, true, RequestParameterMinLengthUpdatedCheck),
+		// RequestParameterMinPropertiesUpdatedCheck
+		newBackwardCompatibilityRule(RequestParameterMinPropertiesIncreasedId, ERR, true, RequestParameterMinPropertiesUpdatedCheck),
+		newBackwardCompatibilityRule(RequestParameterMinPropertiesDecreasedId, INFO, true, RequestParameterMinPropertiesUpdatedCheck),
 		// RequestParameterMinSetCheck
 		newBackwardCompatibilityRule(RequestParameterMinSetId, WARN, true, RequestParameterMinSetCheck),
 		// RequestParameterMinUpdatedCheck
 		newBackwardCompatibilityRule(RequestParameterMinIncreasedId, ERR, true, RequestParameterMinUpdatedCheck),
 		newBackwardCompatibilityRule(RequestParameterMinDecreasedId, INFO, true, RequestParameterMinUpdatedCheck),
+		// RequestParameterMultipleOfUpdatedCheck
+		newBackwardCompatibilityRule(RequestParameterMultipleOfSetId, WARN, true, RequestParameterMultipleOfUpdatedCheck),
+		newBackwardCompatibilityRule(RequestParameterMultipleOfChangedId, ERR, true, RequestParameterMultipleOfUpdatedCheck),
 		// RequestParameterPatternAddedOrChangedCheck
 		newBackwardCompatibilityRule(RequestParameterPatternAddedId, WARN, true, RequestParameterPatternAddedOrChangedCheck),
 		newBackwardCompatibilityRule(RequestParameterPatternRemovedId, INFO, true, RequestParameterPatternAddedOrChangedCheck),
 		newBackwardCompatibilityRule(RequestParameterPatternChangedId, WARN, true, RequestParameterPatternAddedOrChangedCheck),
+		newBackwardCompatibilityRule(RequestParameterPatternBroadenedId, INFO, true, RequestParameterPatternAddedOrChangedCheck),
+		newBackwardCompatibilityRule(RequestParameterPatternNarrowedId, ERR, true, RequestParameterPatternAddedOrChangedCheck),
+		newBackwardCompatibilityRule(RequestParameterPatternIncomparableId, ERR, true, RequestParameterPatternAddedOrChangedCheck),
 		// RequestParameterRemovedCheck
 		newBackwardCompatibilityRule(RequestParameterRemovedId, WARN, true, RequestParameterRemovedCheck),
 		// RequestParameterRequiredValueUpdatedCheck
@@ -161,6 +207,28 @@ func GetAllRules() []BackwardCompatibilityRule {
 		newBackwardCompatibilityRule(RequestBodyBecomeNullableId, INFO, true, RequestPropertyBecameNotNullableCheck),
 		newBackwardCompatibilityRule(RequestPropertyBecomeNotNullableId, ERR, true, RequestPropertyBecameNotNullableCheck),
 		newBackwardCompatibilityRule(RequestPropertyBecomeNullableId, INFO, true, RequestPropertyBecameNotNullableCheck),
+		// RequestPropertyAdditionalPropertiesUpdatedCheck
+		newBackwardCompatibilityRule(RequestBodyAdditionalPropertiesDisallowedId, ERR, true, RequestPropertyAdditionalPropertiesUpdatedCheck),
+		newBackwardCompatibilityRule(RequestBodyAdditionalPropertiesNarrowedId, ERR, true, RequestPropertyAdditionalPropertiesUpdatedCheck),
+		newBackwardCompatibilityRule(RequestPropertyAdditionalPropertiesDisallowedId, ERR, true, RequestPropertyAdditionalPropertiesUpdatedCheck),
+		newBackwardCompatibilityRule(RequestPropertyAdditionalPropertiesNarrowedId, ERR, true, RequestPropertyAdditionalPropertiesUpdatedCheck),
+		// RequestPropertyNotUpdatedCheck
+		newBackwardCompatibilityRule(RequestBodyNotSchemaAddedId, ERR, true, RequestPropertyNotUpdatedCheck),
+		newBackwardCompatibilityRule(RequestBodyNotSchemaBroadenedId, ERR, true, RequestPropertyNotUpdatedCheck),
+		newBackwardCompatibilityRule(RequestPropertyNotSchemaAddedId, ERR, true, RequestPropertyNotUpdatedCheck),
+		newBackwardCompatibilityRule(RequestPropertyNotSchemaBroadenedId, ERR, true, RequestPropertyNotUpdatedCheck),
+		// RequestParameterNotUpdatedCheck
+		newBackwardCompatibilityRule(RequestParameterNotSchemaAddedId, ERR, true, RequestParameterNotUpdatedCheck),
+		newBackwardCompatibilityRule(RequestParameterNotSchemaBroadenedId, ERR, true, RequestParameterNotUpdatedCheck),
+		// RequestParameterSerializationUpdatedCheck
+		newBackwardCompatibilityRule(RequestParameterStyleChangedId, ERR, true, RequestParameterSerializationUpdatedCheck),
+		newBackwardCompatibilityRule(RequestParameterExplodeChangedId, ERR, true, RequestParameterSerializationUpdatedCheck),
+		newBackwardCompatibilityRule(RequestParameterAllowReservedRemovedId, ERR, true, RequestParameterSerializationUpdatedCheck),
+		newBackwardCompatibilityRule(RequestParameterAllowReservedAddedId, INFO, true, RequestParameterSerializationUpdatedCheck),
+		newBackwardCompatibilityRule(RequestParameterAllowEmptyValueRemovedId, ERR, true, RequestParameterSerializationUpdatedCheck),
+		newBackwardCompatibilityRule(RequestParameterAllowEmptyValueAddedId, INFO, true, RequestParameterSerializationUpdatedCheck),
+		// RequestParameterUniqueItemsUpdatedCheck
+		newBackwardCompatibilityRule(RequestParameterUniqueItemsSetId, ERR, true, RequestParameterUniqueItemsUpdatedCheck),
 		// RequestPropertyDefaultValueChangedCheck
 		newBackwardCompatibilityRule(RequestBodyDefaultValueAddedId, INFO, true, RequestPropertyDefaultValueChangedCheck),
 		newBackwardCompatibilityRule(RequestBodyDefaultValueRemovedId, INFO, true, RequestPropertyDefaultValueChangedCheck),
@@ -171,6 +239,11 @@ func GetAllRules() []BackwardCompatibilityRule {
 		// RequestPropertyEnumValueUpdatedCheck
 		newBackwardCompatibilityRule(RequestPropertyEnumValueRemovedId, ERR, true, RequestPropertyEnumValueUpdatedCheck),
 		newBackwardCompatibilityRule(RequestPropertyEnumValueAddedId, INFO, true, RequestPropertyEnumValueUpdatedCheck),
+		// RequestPropertyExclusiveBoundsUpdatedCheck
+		newBackwardCompatibilityRule(RequestBodyExclusiveMinSetId, ERR, true, RequestPropertyExclusiveBoundsUpdatedCheck),
+		newBackwardCompatibilityRule(RequestBodyExclusiveMaxSetId, ERR, true, RequestPropertyExclusiveBoundsUpdatedCheck),
+		newBackwardCompatibilityRule(RequestPropertyExclusiveMinSetId, ERR, true, RequestPropertyExclusiveBoundsUpdatedCheck),
+		newBackwardCompatibilityRule(RequestPropertyExclusiveMaxSetId, ERR, true, RequestPropertyExclusiveBoundsUpdatedCheck),
 		// RequestPropertyMaxDecreasedCheck
 		newBackwardCompatibilityRule(RequestBodyMaxDecreasedId, ERR, true, RequestPropertyMaxDecreasedCheck),
 		newBackwardCompatibilityRule(RequestBodyMaxIncreasedId, INFO, true, RequestPropertyMaxDecreasedCheck),
@@ -184,6 +257,13 @@ func GetAllRules() []BackwardCompatibilityRule {
 		newBackwardCompatibilityRule(RequestBodyMaxLengthIncreasedId, INFO, true, RequestPropertyMaxLengthUpdatedCheck),
 		newBackwardCompatibilityRule(RequestPropertyMaxLengthDecreasedId, ERR, true, RequestPropertyMaxLengthUpdatedCheck),
 		newBackwardCompatibilityRule(RequestPropertyMaxLengthIncreasedId, INFO, true, RequestPropertyMaxLengthUpdatedCheck),
+		// RequestPropertyMaxPropertiesUpdatedCheck
+		newBackwardCompatibilityRule(RequestBodyMaxPropertiesSetId, WARN, true, RequestPropertyMaxPropertiesUpdatedCheck),
+		newBackwardCompatibilityRule(RequestBodyMaxPropertiesDecreasedId, ERR, true, RequestPropertyMaxPropertiesUpdatedCheck),
+		newBackwardCompatibilityRule(RequestBodyMaxPropertiesIncreasedId, INFO, true, RequestPropertyMaxPropertiesUpdatedCheck),
+		newBackwardCompatibilityRule(RequestPropertyMaxPropertiesSetId, WARN, true, RequestPropertyMaxPropertiesUpdatedCheck),
+		newBackwardCompatibilityRule(RequestPropertyMaxPropertiesDecreasedId, ERR, true, RequestPropertyMaxPropertiesUpdatedCheck),
+		newBackwardCompatibilityRule(RequestPropertyMaxPropertiesIncreasedId, INFO, true, RequestPropertyMaxPropertiesUpdatedCheck),
 		// RequestPropertyMaxSetCheck
 		newBackwardCompatibilityRule(RequestBodyMaxSetId, WARN, true, RequestPropertyMaxSetCheck),
 		newBackwardCompatibilityRule(RequestPropertyMaxSetId, WARN, true, RequestPropertyMaxSetCheck),
@@ -203,9 +283,19 @@ func GetAllRules() []BackwardCompatibilityRule {
 		newBackwardCompatibilityRule(RequestBodyMinLengthDecreasedId, INFO, true, RequestPropertyMinLengthUpdatedCheck),
 		newBackwardCompatibilityRule(RequestPropertyMinLengthIncreasedId, ERR, true, RequestPropertyMinLengthUpdatedCheck),
 		newBackwardCompatibilityRule(RequestPropertyMinLengthDecreasedId, INFO, true, RequestPropertyMinLengthUpdatedCheck),
+		// RequestPropertyMinPropertiesUpdatedCheck
+		newBackwardCompatibilityRule(RequestBodyMinPropertiesIncreasedId, ERR, true, RequestPropertyMinPropertiesUpdatedCheck),
+		newBackwardCompatibilityRule(RequestBodyMinPropertiesDecreasedId, INFO, true, RequestPropertyMinPropertiesUpdatedCheck),
+		newBackwardCompatibilityRule(RequestPropertyMinPropertiesIncreasedId, ERR, true, RequestPropertyMinPropertiesUpdatedCheck),
+		newBackwardCompatibilityRule(RequestPropertyMinPropertiesDecreasedId, INFO, true, RequestPropertyMinPropertiesUpdatedCheck),
 		// RequestPropertyMinSetCheck
 		newBackwardCompatibilityRule(RequestBodyMinSetId, WARN, true, RequestPropertyMinSetCheck),
 		newBackwardCompatibilityRule(RequestPropertyMinSetId, WARN, true, RequestPropertyMinSetCheck),
+		// RequestPropertyMultipleOfUpdatedCheck
+		newBackwardCompatibilityRule(RequestBodyMultipleOfSetId, WARN, true, RequestPropertyMultipleOfUpdatedCheck),
+		newBackwardCompatibilityRule(RequestBodyMultipleOfChangedId, ERR, true, RequestPropertyMultipleOfUpdatedCheck),
+		newBackwardCompatibilityRule(RequestPropertyMultipleOfSetId, WARN, true, RequestPropertyMultipleOfUpdatedCheck),
+		newBackwardCompatibilityRule(RequestPropertyMultipleOfChangedId, ERR, true, RequestPropertyMultipleOfUpdatedCheck),
 		// RequestPropertyOneOfUpdatedCheck
 		newBackwardCompatibilityRule(RequestBodyOneOfAddedId, INFO, true, RequestPropertyOneOfUpdatedCheck),
 		newBackwardCompatibilityRule(RequestBodyOneOfRemovedId, ERR, true, RequestPropertyOneOfUpdatedCheck),
@@ -215,12 +305,18 @@ func GetAllRules() []BackwardCompatibilityRule {
 		newBackwardCompatibilityRule(RequestPropertyPatternRemovedId, INFO, true, RequestPropertyPatternUpdatedCheck),
 		newBackwardCompatibilityRule(RequestPropertyPatternAddedId, WARN, true, RequestPropertyPatternUpdatedCheck),
 		newBackwardCompatibilityRule(RequestPropertyPatternChangedId, WARN, true, RequestPropertyPatternUpdatedCheck),
+		newBackwardCompatibilityRule(RequestPropertyPatternBroadenedId, INFO, true, RequestPropertyPatternUpdatedCheck),
+		newBackwardCompatibilityRule(RequestPropertyPatternNarrowedId, ERR, true, RequestPropertyPatternUpdatedCheck),
+		newBackwardCompatibilityRule(RequestPropertyPatternIncomparableId, ERR, true, RequestPropertyPatternUpdatedCheck),
 		// RequestPropertyRequiredUpdatedCheck
 		newBackwardCompatibilityRule(RequestPropertyBecameRequiredId, ERR, true, RequestPropertyRequiredUpdatedCheck),
 		newBackwardCompatibilityRule(RequestPropertyBecameOptionalId, INFO, true, RequestPropertyRequiredUpdatedCheck),
 		// RequestPropertyTypeChangedCheck
 		newBackwardCompatibilityRule(RequestBodyTypeChangedId, ERR, true, RequestPropertyTypeChangedCheck),
 		newBackwardCompatibilityRule(RequestPropertyTypeChangedId, INFO, true, RequestPropertyTypeChangedCheck),
+		// RequestPropertyUniqueItemsUpdatedCheck
+		newBackwardCompatibilityRule(RequestBodyUniqueItemsSetId, ERR, true, RequestPropertyUniqueItemsUpdatedCheck),
+		newBackwardCompatibilityRule(RequestPropertyUniqueItemsSetId, ERR, true, RequestPropertyUniqueItemsUpdatedCheck),
 		// RequestPropertyUpdatedCheck
 		newBackwardCompatibilityRule(RequestPropertyRemovedId, WARN, true, RequestPropertyUpdatedCheck),
 		newBackwardCompatibilityRule(NewRequiredRequestPropertyId, ERR, true, RequestPropertyUpdatedCheck),
@@ -256,9 +352,19 @@ func GetAllRules() []BackwardCompatibilityRule {
 		// ResponseHeaderRemovedCheck
 		newBackwardCompatibilityRule(RequiredResponseHeaderRemovedId, ERR, true, ResponseHeaderRemovedCheck),
 		newBackwardCompatibilityRule(OptionalResponseHeaderRemovedId, WARN, true, ResponseHeaderRemovedCheck),
+		// ResponseLinksUpdatedCheck
+		newBackwardCompatibilityRule(ResponseLinkRemovedId, WARN, true, ResponseLinksUpdatedCheck),
+		newBackwardCompatibilityRule(ResponseLinkAddedId, INFO, true, ResponseLinksUpdatedCheck),
+		newBackwardCompatibilityRule(ResponseLinkTargetChangedId, WARN, true, ResponseLinksUpdatedCheck),
+		newBackwardCompatibilityRule(ResponseLinkParameterRemovedId, WARN, true, ResponseLinksUpdatedCheck),
+		newBackwardCompatibilityRule(ResponseLinkParameterAddedId, INFO, true, ResponseLinksUpdatedCheck),
+		newBackwardCompatibilityRule(ResponseLinkParameterChangedId, WARN, true, ResponseLinksUpdatedCheck),
 		// ResponseMediaTypeUpdatedCheck
 		newBackwardCompatibilityRule(ResponseMediaTypeRemovedId, ERR, true, ResponseMediaTypeUpdatedCheck),
 		newBackwardCompatibilityRule(ResponseMediaTypeAddedId, INFO, true, ResponseMediaTypeUpdatedCheck),
+		newBackwardCompatibilityRule(ResponseMediaTypeBroadenedId, WARN, true, ResponseMediaTypeUpdatedCheck),
+		newBackwardCompatibilityRule(ResponseMediaTypeNarrowedId, INFO, true, ResponseMediaTypeUpdatedCheck),
+		newBackwardCompatibilityRule(ResponseMediaTypeChangedId, ERR, true, ResponseMediaTypeUpdatedCheck),
 		// ResponseOptionalPropertyUpdatedCheck
 		newBackwardCompatibilityRule(ResponseOptionalPropertyRemovedId, WARN, true, ResponseOptionalPropertyUpdatedCheck),
 		newBackwardCompatibilityRule(ResponseOptionalWriteOnlyPropertyRemovedId, INFO, true, ResponseOptionalPropertyUpdatedCheck),
@@ -273,6 +379,9 @@ func GetAllRules() []BackwardCompatibilityRule {
 		newBackwardCompatibilityRule(ResponsePropertyPatternAddedId, INFO, true, ResponsePatternAddedOrChangedCheck),
 		newBackwardCompatibilityRule(ResponsePropertyPatternChangedId, INFO, true, ResponsePatternAddedOrChangedCheck),
 		newBackwardCompatibilityRule(ResponsePropertyPatternRemovedId, INFO, true, ResponsePatternAddedOrChangedCheck),
+		newBackwardCompatibilityRule(ResponsePropertyPatternBroadenedId, ERR, true, ResponsePatternAddedOrChangedCheck),
+		newBackwardCompatibilityRule(ResponsePropertyPatternNarrowedId, INFO, true, ResponsePatternAddedOrChangedCheck),
+		newBackwardCompatibilityRule(ResponsePropertyPatternIncomparableId, ERR, true, ResponsePatternAddedOrChangedCheck),
 		// ResponsePropertyAllOfUpdatedCheck
 		newBackwardCompatibilityRule(ResponseBodyAllOfAddedId, INFO, true, ResponsePropertyAllOfUpdatedCheck),
 		newBackwardCompatibilityRule(ResponseBodyAllOfRemovedId, INFO, true, ResponsePropertyAllOfUpdatedCheck),
@@ -286,6 +395,14 @@ func GetAllRules() []BackwardCompatibilityRule {
 		// ResponsePropertyBecameNullableCheck
 		newBackwardCompatibilityRule(ResponsePropertyBecameNullableId, ERR, true, ResponsePropertyBecameNullableCheck),
 		newBackwardCompatibilityRule(ResponseBodyBecameNullableId, ERR, true, ResponsePropertyBecameNullableCheck),
+		// ResponsePropertyAdditionalPropertiesUpdatedCheck
+		newBackwardCompatibilityRule(ResponseBodyAdditionalPropertiesAllowedId, WARN, true, ResponsePropertyAdditionalPropertiesUpdatedCheck),
+		newBackwardCompatibilityRule(ResponseBodyAdditionalPropertiesWidenedId, WARN, true, ResponsePropertyAdditionalPropertiesUpdatedCheck),
+		newBackwardCompatibilityRule(ResponsePropertyAdditionalPropertiesAllowedId, WARN, true, ResponsePropertyAdditionalPropertiesUpdatedCheck),
+		newBackwardCompatibilityRule(ResponsePropertyAdditionalPropertiesWidenedId, WARN, true, ResponsePropertyAdditionalPropertiesUpdatedCheck),
+		// ResponsePropertyNotUpdatedCheck
+		newBackwardCompatibilityRule(ResponseBodyNotSchemaRemovedId, WARN, true, ResponsePropertyNotUpdatedCheck),
+		newBackwardCompatibilityRule(ResponsePropertyNotSchemaRemovedId, WARN, true, ResponsePropertyNotUpdatedCheck),
 		// ResponsePropertyBecameOptionalCheck
 		newBackwardCompatibilityRule(ResponsePropertyBecameOptionalId, ERR, true, ResponsePropertyBecameOptionalCheck),
 		newBackwardCompatibilityRule(ResponseWriteOnlyPropertyBecameOptionalId, ERR, true, ResponsePropertyBecameOptionalCheck),
@@ -302,6 +419,11 @@ func GetAllRules() []BackwardCompatibilityRule {
 		// ResponsePropertyEnumValueAddedCheck
 		newBackwardCompatibilityRule(ResponsePropertyEnumValueAddedId, WARN, true, ResponsePropertyEnumValueAddedCheck),
 		newBackwardCompatibilityRule(ResponseWriteOnlyPropertyEnumValueAddedId, INFO, true, ResponsePropertyEnumValueAddedCheck),
+		// ResponsePropertyExclusiveBoundsUnsetCheck
+		newBackwardCompatibilityRule(ResponseBodyExclusiveMinUnsetId, ERR, true, ResponsePropertyExclusiveBoundsUnsetCheck),
+		newBackwardCompatibilityRule(ResponseBodyExclusiveMaxUnsetId, ERR, true, ResponsePropertyExclusiveBoundsUnsetCheck),
+		newBackwardCompatibilityRule(ResponsePropertyExclusiveMinUnsetId, ERR, true, ResponsePropertyExclusiveBoundsUnsetCheck),
+		newBackwardCompatibilityRule(ResponsePropertyExclusiveMaxUnsetId, ERR, true, ResponsePropertyExclusiveBoundsUnsetCheck),
 		// ResponsePropertyMaxIncreasedCheck
 		newBackwardCompatibilityRule(ResponseBodyMaxIncreasedId, ERR, true, ResponsePropertyMaxIncreasedCheck),
 		newBackwardCompatibilityRule(ResponsePropertyMaxIncreasedId, ERR, true, ResponsePropertyMaxIncreasedCheck),
@@ -311,6 +433,11 @@ func GetAllRules() []BackwardCompatibilityRule {
 		// ResponsePropertyMaxLengthUnsetCheck
 		newBackwardCompatibilityRule(ResponseBodyMaxLengthUnsetId, ERR, true, ResponsePropertyMaxLengthUnsetCheck),
 		newBackwardCompatibilityRule(ResponsePropertyMaxLengthUnsetId, ERR, true, ResponsePropertyMaxLengthUnsetCheck),
+		// ResponsePropertyMaxPropertiesUpdatedCheck
+		newBackwardCompatibilityRule(ResponseBodyMaxPropertiesIncreasedId, ERR, true, ResponsePropertyMaxPropertiesUpdatedCheck),
+		newBackwardCompatibilityRule(ResponseBodyMaxPropertiesUnsetId, ERR, true, ResponsePropertyMaxPropertiesUpdatedCheck),
+		newBackwardCompatibilityRule(ResponsePropertyMaxPropertiesIncreasedId, ERR, true, ResponsePropertyMaxPropertiesUpdatedCheck),
+		newBackwardCompatibilityRule(ResponsePropertyMaxPropertiesUnsetId, ERR, true, ResponsePropertyMaxPropertiesUpdatedCheck),
 		// ResponsePropertyMinDecreasedCheck
 		newBackwardCompatibilityRule(ResponseBodyMinDecreasedId, ERR, true, ResponsePropertyMinDecreasedCheck),
 		newBackwardCompatibilityRule(ResponsePropertyMinDecreasedId, ERR, true, ResponsePropertyMinDecreasedCheck),
@@ -323,6 +450,14 @@ func GetAllRules() []BackwardCompatibilityRule {
 		// ResponsePropertyMinLengthDecreasedCheck
 		newBackwardCompatibilityRule(ResponseBodyMinLengthDecreasedId, ERR, true, ResponsePropertyMinLengthDecreasedCheck),
 		newBackwardCompatibilityRule(ResponsePropertyMinLengthDecreasedId, ERR, true, ResponsePropertyMinLengthDecreasedCheck),
+		// ResponsePropertyMinPropertiesDecreasedCheck
+		newBackwardCompatibilityRule(ResponseBodyMinPropertiesDecreasedId, ERR, true, ResponsePropertyMinPropertiesDecreasedCheck),
+		newBackwardCompatibilityRule(ResponsePropertyMinPropertiesDecreasedId, ERR, true, ResponsePropertyMinPropertiesDecreasedCheck),
+		// ResponsePropertyMultipleOfUpdatedCheck
+		newBackwardCompatibilityRule(ResponseBodyMultipleOfChangedId, ERR, true, ResponsePropertyMultipleOfUpdatedCheck),
+		newBackwardCompatibilityRule(ResponseBodyMultipleOfUnsetId, ERR, true, ResponsePropertyMultipleOfUpdatedCheck),
+		newBackwardCompatibilityRule(ResponsePropertyMultipleOfChangedId, ERR, true, ResponsePropertyMultipleOfUpdatedCheck),
+		newBackwardCompatibilityRule(ResponsePropertyMultipleOfUnsetId, ERR, true, ResponsePropertyMultipleOfUpdatedCheck),
 		// ResponsePropertyOneOfUpdated
 		newBackwardCompatibilityRule(ResponseBodyOneOfAddedId, INFO, true, ResponsePropertyOneOfUpdated),
 		newBackwardCompatibilityRule(ResponseBodyOneOfRemovedId, INFO, true, ResponsePropertyOneOfUpdated),
@@ -331,6 +466,9 @@ func GetAllRules() []BackwardCompatibilityRule {
 		// ResponsePropertyTypeChangedCheck
 		newBackwardCompatibilityRule(ResponseBodyTypeChangedId, ERR, true, ResponsePropertyTypeChangedCheck),
 		newBackwardCompatibilityRule(ResponsePropertyTypeChangedId, ERR, true, ResponsePropertyTypeChangedCheck),
+		// ResponsePropertyUniqueItemsUnsetCheck
+		newBackwardCompatibilityRule(ResponseBodyUniqueItemsUnsetId, ERR, true, ResponsePropertyUniqueItemsUnsetCheck),
+		newBackwardCompatibilityRule(ResponsePropertyUniqueItemsUnsetId, ERR, true, ResponsePropertyUniqueItemsUnsetCheck),
 		// ResponseRequiredPropertyUpdatedCheck
 		newBackwardCompatibilityRule(ResponseRequiredPropertyRemovedId, ERR, true, ResponseRequiredPropertyUpdatedCheck),
 		newBackwardCompatibilityRule(ResponseRequiredWriteOnlyPropertyRemovedId, INFO, true, ResponseRequiredPropertyUpdatedCheck),
@@ -344,9 +482,43 @@ func GetAllRules() []BackwardCompatibilityRule {
 		// ResponseSuccessStatusUpdatedCheck
 		newBackwardCompatibilityRule(ResponseSuccessStatusRemovedId, ERR, true, ResponseSuccessStatusUpdatedCheck),
 		newBackwardCompatibilityRule(ResponseSuccessStatusAddedId, ERR, true, ResponseSuccessStatusUpdatedCheck),
+		newBackwardCompatibilityRule(ResponseSuccessStatusCoveredId, INFO, true, ResponseSuccessStatusUpdatedCheck),
+		// CallbackUpdatedCheck
+		newBackwardCompatibilityRule(CallbackRemovedId, ERR, true, CallbackUpdatedCheck),
+		newBackwardCompatibilityRule(CallbackAddedId, INFO, true, CallbackUpdatedCheck),
+		newBackwardCompatibilityRule(CallbackOperationRemovedId, ERR, true, CallbackUpdatedCheck),
+		newBackwardCompatibilityRule(CallbackOperationAddedId, INFO, true, CallbackUpdatedCheck),
+		// CallbackRequestPropertyUpdatedCheck
+		newBackwardCompatibilityRule(CallbackRequestRequiredPropertyRemovedId, ERR, true, CallbackRequestPropertyUpdatedCheck),
+		newBackwardCompatibilityRule(CallbackRequestOptionalPropertyRemovedId, WARN, true, CallbackRequestPropertyUpdatedCheck),
+		newBackwardCompatibilityRule(CallbackRequestNewRequiredPropertyId, WARN, true, CallbackRequestPropertyUpdatedCheck),
+		newBackwardCompatibilityRule(CallbackRequestNewOptionalPropertyId, INFO, true, CallbackRequestPropertyUpdatedCheck),
+		newBackwardCompatibilityRule(CallbackRequestPropertyTypeChangedId, ERR, true, CallbackRequestPropertyUpdatedCheck),
+		// CallbackResponseUpdatedCheck
+		newBackwardCompatibilityRule(CallbackResponseSuccessStatusRemovedId, ERR, true, CallbackResponseUpdatedCheck),
+		newBackwardCompatibilityRule(CallbackResponseSuccessStatusAddedId, INFO, true, CallbackResponseUpdatedCheck),
+		newBackwardCompatibilityRule(CallbackResponseNewRequiredPropertyId, ERR, true, CallbackResponseUpdatedCheck),
+		newBackwardCompatibilityRule(CallbackResponsePropertyBecameRequiredId, ERR, true, CallbackResponseUpdatedCheck),
+		// WebhookUpdatedCheck
+		newBackwardCompatibilityRule(WebhookRemovedId, ERR, true, WebhookUpdatedCheck),
+		newBackwardCompatibilityRule(WebhookAddedId, INFO, true, WebhookUpdatedCheck),
+		newBackwardCompatibilityRule(WebhookOperationRemovedId, ERR, true, WebhookUpdatedCheck),
+		newBackwardCompatibilityRule(WebhookOperationAddedId, INFO, true, WebhookUpdatedCheck),
+		// WebhookRequestPropertyUpdatedCheck
+		newBackwardCompatibilityRule(WebhookRequestRequiredPropertyRemovedId, ERR, true, WebhookRequestPropertyUpdatedCheck),
+		newBackwardCompatibilityRule(WebhookRequestOptionalPropertyRemovedId, WARN, true, WebhookRequestPropertyUpdatedCheck),
+		newBackwardCompatibilityRule(WebhookRequestNewRequiredPropertyId, WARN, true, WebhookRequestPropertyUpdatedCheck),
+		newBackwardCompatibilityRule(WebhookRequestNewOptionalPropertyId, INFO, true, WebhookRequestPropertyUpdatedCheck),
+		newBackwardCompatibilityRule(WebhookRequestPropertyTypeChangedId, ERR, true, WebhookRequestPropertyUpdatedCheck),
+		// WebhookResponseUpdatedCheck
+		newBackwardCompatibilityRule(WebhookResponseSuccessStatusRemovedId, ERR, true, WebhookResponseUpdatedCheck),
+		newBackwardCompatibilityRule(WebhookResponseSuccessStatusAddedId, INFO, true, WebhookResponseUpdatedCheck),
+		newBackwardCompatibilityRule(WebhookResponseNewRequiredPropertyId, ERR, true, WebhookResponseUpdatedCheck),
+		newBackwardCompatibilityRule(WebhookResponsePropertyBecameRequiredId, ERR, true, WebhookResponseUpdatedCheck),
 		// ResponseNonSuccessStatusUpdatedCheck
 		newBackwardCompatibilityRule(ResponseNonSuccessStatusRemovedId, ERR, false, ResponseNonSuccessStatusUpdatedCheck),
 		newBackwardCompatibilityRule(ResponseNonSuccessStatusAddedId, INFO, false, ResponseNonSuccessStatusUpdatedCheck),
+		newBackwardCompatibilityRule(ResponseNonSuccessStatusCoveredId, INFO, false, ResponseNonSuccessStatusUpdatedCheck),
 		// APIOperationIdUpdatedCheck
 		newBackwardCompatibilityRule(APIOperationIdRemovedId, ERR, false, APIOperationIdUpdatedCheck),
 		newBackwardCompatibilityRule(APIOperationIdAddId, INFO, false, APIOperationIdUpdatedCheck),
@@ -355,12 +527,27 @@ func GetAllRules() []BackwardCompatibilityRule {
 		newBackwardCompatibilityRule(APITagAddedId, INFO, false, APITagUpdatedCheck),
 		// APIComponentsSchemaRemovedCheck
 		newBackwardCompatibilityRule(APISchemasRemovedId, ERR, false, APIComponentsSchemaRemovedCheck),
+		// APIComponentsLinksUpdatedCheck
+		newBackwardCompatibilityRule(APIComponentsLinkRemovedId, INFO, true, APIComponentsLinksUpdatedCheck),
+		newBackwardCompatibilityRule(APIComponentsLinkAddedId, INFO, true, APIComponentsLinksUpdatedCheck),
+		newBackwardCompatibilityRule(APIComponentsLinkTargetChangedId, INFO, true, APIComponentsLinksUpdatedCheck),
+		newBackwardCompatibilityRule(APIComponentsLinkParameterRemovedId, INFO, true, APIComponentsLinksUpdatedCheck),
+		newBackwardCompatibilityRule(APIComponentsLinkParameterAddedId, INFO, true, APIComponentsLinksUpdatedCheck),
+		newBackwardCompatibilityRule(APIComponentsLinkParameterChangedId, INFO, true, APIComponentsLinksUpdatedCheck),
+		// APIComponentsExamplesUpdatedCheck
+		newBackwardCompatibilityRule(APIComponentsExampleRemovedId, INFO, true, APIComponentsExamplesUpdatedCheck),
+		newBackwardCompatibilityRule(APIComponentsExampleAddedId, INFO, true, APIComponentsExamplesUpdatedCheck),
+		newBackwardCompatibilityRule(APIComponentsExampleValueChangedId, INFO, true, APIComponentsExamplesUpdatedCheck),
 		// ResponseParameterEnumValueRemovedCheck
 		newBackwardCompatibilityRule(ResponsePropertyEnumValueRemovedId, ERR, false, ResponseParameterEnumValueRemovedCheck),
 		// ResponseMediaTypeEnumValueRemovedCheck
 		newBackwardCompatibilityRule(ResponseMediaTypeEnumValueRemovedId, ERR, false, ResponseMediaTypeEnumValueRemovedCheck),
 		// RequestBodyEnumValueRemovedCheck
 		newBackwardCompatibilityRule(RequestBodyEnumValueRemovedId, ERR, false, RequestBodyEnumValueRemovedCheck),
+		// ExamplesInvalidCheck
+		newBackwardCompatibilityRule(RequestParameterExampleInvalidId, WARN, false, ExamplesInvalidCheck),
+		newBackwardCompatibilityRule(RequestBodyExampleInvalidId, WARN, false, ExamplesInvalidCheck),
+		newBackwardCompatibilityRule(ResponseBodyExampleInvalidId, WARN, false, ExamplesInvalidCheck),
 	}
 }
 
@@ -94,6 +94,11 @@ func (c SecurityChange) GetSourceColumnEnd() int {
 	return c.SourceColumnEnd
 }
 
+// HasSourceLocation returns true if the change was located in the spec, so that its line and column are meaningful
+func (c SecurityChange) HasSourceLocation() bool {
+	return c.SourceFile != ""
+}
+
 func (c SecurityChange) SingleLineError(l Localizer, colorMode ColorMode) string {
 	const format = "%s, %s security %s [%s]. %s"
 
@@ -0,0 +1,119 @@
+package checker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TwiN/go-color"
+)
+
+// ServerChange represents a change in the top-level Servers Section, which applies to all the paths and operations that don't override it
+type ServerChange struct {
+	Id      string
+	Args    []any
+	Comment string
+	Level   Level
+
+	SourceFile      string
+	SourceLine      int
+	SourceLineEnd   int
+	SourceColumn    int
+	SourceColumnEnd int
+}
+
+func (c ServerChange) GetSection() string {
+	return "servers"
+}
+
+func (c ServerChange) IsBreaking() bool {
+	return c.GetLevel().IsBreaking()
+}
+
+func (c ServerChange) MatchIgnore(ignorePath, ignoreLine string, l Localizer) bool {
+	return strings.Contains(ignoreLine, strings.ToLower(c.GetUncolorizedText(l))) &&
+		strings.Contains(ignoreLine, "servers")
+}
+
+func (c ServerChange) GetId() string {
+	return c.Id
+}
+
+func (c ServerChange) GetText(l Localizer) string {
+	return l(c.Id, colorizedValues(c.Args)...)
+}
+
+func (c ServerChange) GetArgs() []any {
+	return c.Args
+}
+
+func (c ServerChange) GetUncolorizedText(l Localizer) string {
+	return l(c.Id, quotedValues(c.Args)...)
+}
+
+func (c ServerChange) GetComment(l Localizer) string {
+	return l(c.Comment)
+}
+
+func (c ServerChange) GetLevel() Level {
+	return c.Level
+}
+
+func (r ServerChange) GetOperation() string {
+	return ""
+}
+
+func (ServerChange) GetOperationId() string {
+	return ""
+}
+
+func (ServerChange) GetPath() string {
+	return ""
+}
+
+func (c ServerChange) GetSource() string {
+	return ""
+}
+
+func (c ServerChange) GetSourceFile() string {
+	return c.SourceFile
+}
+
+func (c ServerChange) GetSourceLine() int {
+	return c.SourceLine
+}
+
+func (c ServerChange) GetSourceLineEnd() int {
+	return c.SourceLineEnd
+}
+
+func (c ServerChange) GetSourceColumn() int {
+	return c.SourceColumn
+}
+
+func (c ServerChange) GetSourceColumnEnd() int {
+	return c.SourceColumnEnd
+}
+
+// HasSourceLocation returns true if the change was located in the spec, so that its line and column are meaningful
+func (c ServerChange) HasSourceLocation() bool {
+	return c.SourceFile != ""
+}
+
+func (c ServerChange) SingleLineError(l Localizer, colorMode ColorMode) string {
+	const format = "%s, %s servers %s [%s]. %s"
+
+	if isColorEnabled(colorMode) {
+		return fmt.Sprintf(format, c.Level.PrettyString(), l("in"), c.GetText(l), color.InYellow(c.Id), c.GetComment(l))
+	}
+	return fmt.Sprintf(format, c.Level.String(), l("in"), c.GetUncolorizedText(l), c.Id, c.GetComment(l))
+}
+
+func (c ServerChange) MultiLineError(l Localizer, colorMode ColorMode) string {
+	const format = "%s\t[%s] \t\n\t%s servers\n\t\t%s%s"
+
+	if isColorEnabled(colorMode) {
+		return fmt.Sprintf(format, c.Level.PrettyString(), color.InYellow(c.Id), l("in"), c.GetText(l), multiLineComment(c.GetComment(l)))
+	}
+
+	return fmt.Sprintf(format, c.Level.String(), c.Id, l("in"), c.GetUncolorizedText(l), multiLineComment(c.GetComment(l)))
+}
@@ -0,0 +1,46 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+)
+
+var serverChange = checker.ServerChange{
+	Id:              "change_id",
+	Comment:         "comment",
+	Level:           checker.ERR,
+	Args:            []any{1},
+	SourceFile:      "sourceFile",
+	SourceLine:      1,
+	SourceLineEnd:   2,
+	SourceColumn:    3,
+	SourceColumnEnd: 4,
+}
+
+func TestServerChange(t *testing.T) {
+	require.Equal(t, "servers", serverChange.GetSection())
+	require.Equal(t, "comment", serverChange.GetComment(MockLocalizer))
+	require.Equal(t, "", serverChange.GetOperationId())
+	require.Equal(t, "", serverChange.GetSource())
+	require.Equal(t, []any{1}, serverChange.GetArgs())
+	require.Equal(t, "sourceFile", serverChange.GetSourceFile())
+	require.Equal(t, 1, serverChange.GetSourceLine())
+	require.Equal(t, 2, serverChange.GetSourceLineEnd())
+	require.Equal(t, 3, serverChange.GetSourceColumn())
+	require.Equal(t, 4, serverChange.GetSourceColumnEnd())
+	require.Equal(t, "error, in servers This is a breaking change. [change_id]. comment", serverChange.SingleLineError(MockLocalizer, checker.ColorNever))
+}
+
+func TestServerChange_MatchIgnore(t *testing.T) {
+	require.True(t, serverChange.MatchIgnore("", "error, in servers this is a breaking change. [change_id]. comment", MockLocalizer))
+}
+
+func TestServerChange_SingleLineError(t *testing.T) {
+	require.Equal(t, "error, in servers This is a breaking change. [change_id]. comment", serverChange.SingleLineError(MockLocalizer, checker.ColorNever))
+}
+
+func TestServerChange_MultiLineError_NoColor(t *testing.T) {
+	require.Equal(t, "error\t[change_id] \t\n\tin servers\n\t\tThis is a breaking change.\n\t\tcomment", serverChange.MultiLineError(MockLocalizer, checker.ColorNever))
+}
@@ -0,0 +1,21 @@
+//go:build unix
+
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+)
+
+func TestServerChange_PrettyNotPipedUnix(t *testing.T) {
+	piped := false
+	save := checker.SetPipedOutput(&piped)
+	defer checker.SetPipedOutput(save)
+	require.Equal(t, "\x1b[31merror\x1b[0m\t[\x1b[33mchange_id\x1b[0m] \t\n\tin servers\n\t\tThis is a breaking change.\n\t\tcomment", serverChange.MultiLineError(MockLocalizer, checker.ColorAuto))
+}
+
+func TestServerChange_SingleLineError_WithColor(t *testing.T) {
+	require.Equal(t, "\x1b[31merror\x1b[0m, in servers This is a breaking change. [\x1b[33mchange_id\x1b[0m]. comment", serverChange.SingleLineError(MockLocalizer, checker.ColorAlways))
+}
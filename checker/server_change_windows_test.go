@@ -0,0 +1,15 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+)
+
+func TestServerChange_PrettyNotPipedWindows(t *testing.T) {
+	piped := false
+	save := checker.SetPipedOutput(&piped)
+	defer checker.SetPipedOutput(save)
+	require.Equal(t, "error\t[change_id] \t\n\tin servers\n\t\tThis is a breaking change.\n\t\tcomment", serverChange.MultiLineError(MockLocalizer, checker.ColorAuto))
+}
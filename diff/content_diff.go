@@ -72,5 +72,22 @@ func getContentDiffInternal(config *Config, state *state, content1, content2 ope
 		}
 	}
 
+	// media types that were replaced by related media types are compared rather than reported as deleted and added
+	matches := matchMediaTypes(result.MediaTypeDeleted, result.MediaTypeAdded)
+	matched := utils.StringList{}
+	for name1, name2 := range matches {
+		diff, err := getMediaTypeDiffInternal(config, state, content1[name1], content2[name2])
+		if err != nil {
+			return nil, err
+		}
+		diff.NameDiff = getValueDiff(name1, name2)
+		result.MediaTypeModified[name1] = diff
+		matched = append(matched, name1, name2)
+	}
+	if len(matched) > 0 {
+		result.MediaTypeDeleted = result.MediaTypeDeleted.Minus(matched)
+		result.MediaTypeAdded = result.MediaTypeAdded.Minus(matched)
+	}
+
 	return result, nil
 }
@@ -23,6 +23,7 @@ type Diff struct {
 	InfoDiff         *InfoDiff                 `json:"info,omitempty" yaml:"info,omitempty"`
 	PathsDiff        *PathsDiff                `json:"paths,omitempty" yaml:"paths,omitempty"`
 	EndpointsDiff    *EndpointsDiff            `json:"endpoints,omitempty" yaml:"endpoints,omitempty"`
+	WebhooksDiff     *PathsDiff                `json:"webhooks,omitempty" yaml:"webhooks,omitempty"`
 	SecurityDiff     *SecurityRequirementsDiff `json:"security,omitempty" yaml:"security,omitempty"`
 	ServersDiff      *ServersDiff              `json:"servers,omitempty" yaml:"servers,omitempty"`
 	TagsDiff         *TagsDiff                 `json:"tags,omitempty" yaml:"tags,omitempty"`
@@ -65,7 +66,11 @@ References are normally resolved automatically when you load the spec.
 In other cases you can resolve refs using https://pkg.go.dev/github.com/getkin/kin-openapi/openapi3#Loader.ResolveRefsIn.
 */
 func GetWithOperationsSourcesMap(config *Config, s1, s2 *load.SpecInfo) (*Diff, *OperationsSourcesMap, error) {
-	diff, err := getDiff(config, newState(), s1.Spec, s2.Spec)
+	state := newState()
+	state.setWebhooks(s1)
+	state.setWebhooks(s2)
+
+	diff, err := getDiff(config, state, s1.Spec, s2.Spec)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -83,9 +88,29 @@ func GetWithOperationsSourcesMap(config *Config, s1, s2 *load.SpecInfo) (*Diff,
 	for k, v := range *operationsSources2 {
 		operationsSources[k] = v
 	}
+
+	for _, s := range []*load.SpecInfo{s1, s2} {
+		if err := addWebhooksSources(state, operationsSources, s); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	return diff, &operationsSources, nil
 }
 
+func addWebhooksSources(state *state, operationsSources OperationsSourcesMap, s *load.SpecInfo) error {
+	webhooks, err := state.getWebhooks(s.Spec)
+	if err != nil {
+		return err
+	}
+	for _, pathItem := range webhooks.Map() {
+		for _, opItem := range pathItem.Operations() {
+			operationsSources[opItem] = s.Url
+		}
+	}
+	return nil
+}
+
 /*
 GetPathsDiff calculates the diff between a pair of slice of OpenAPI objects.
 It is helpful when you want to find diff and check for breaking changes for API divided into multiple files.
@@ -253,11 +278,27 @@ func getDiffInternal(config *Config, state *state, s1, s2 *openapi3.T) (*Diff, e
 	if result.PathsDiff, err = getPathsDiff(config, state, s1.Paths, s2.Paths); err != nil {
 		return nil, err
 	}
+	if result.PathsDiff != nil {
+		result.PathsDiff.BaseServers = s1.Servers
+		result.PathsDiff.RevisionServers = s2.Servers
+	}
 
 	if result.EndpointsDiff, err = getEndpointsDiff(config, state, s1.Paths, s2.Paths); err != nil {
 		return nil, err
 	}
 
+	webhooks1, err := state.getWebhooks(s1)
+	if err != nil {
+		return nil, err
+	}
+	webhooks2, err := state.getWebhooks(s2)
+	if err != nil {
+		return nil, err
+	}
+	if result.WebhooksDiff, err = getWebhooksDiff(config, state, webhooks1, webhooks2); err != nil {
+		return nil, err
+	}
+
 	result.SecurityDiff = getSecurityRequirementsDiff(config, state, &s1.Security, &s2.Security)
 	result.ServersDiff = getServersDiff(config, state, &s1.Servers, &s2.Servers)
 	result.TagsDiff = getTagsDiff(config, state, s1.Tags, s2.Tags)
@@ -283,6 +324,7 @@ func (diff *Diff) GetSummary() *Summary {
 
 	// swagger
 	summary.add(diff.PathsDiff, PathsDetail)
+	summary.add(diff.WebhooksDiff, WebhooksDetail)
 	summary.add(diff.SecurityDiff, SecurityDetail)
 	summary.add(diff.ServersDiff, ServersDetail)
 	summary.add(diff.TagsDiff, TagsDetail)
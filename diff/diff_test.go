@@ -644,12 +644,51 @@ func TestOAS31(t *testing.T) {
 	d, err := diff.Get(diff.NewConfig(), s1, s2)
 	require.NoError(t, err)
 
-	// while specific 3.1 features, such as webhooks, are not yet supported by kin-openapi, the diff still works
+	// while specific 3.1 features are not yet supported by kin-openapi, the diff still works
 	require.Contains(t,
 		d.ComponentsDiff.SchemasDiff.Modified["Pet"].RequiredDiff.Added,
 		"tag")
 }
 
+func TestOAS31_Webhooks(t *testing.T) {
+	loader := openapi3.NewLoader()
+
+	s1, err := loader.LoadFromFile("../data/openapi31-test1.yaml")
+	require.NoError(t, err)
+
+	s2, err := loader.LoadFromFile("../data/openapi31-test2.yaml")
+	require.NoError(t, err)
+
+	d, err := diff.Get(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+
+	// the webhook refers to the modified schema
+	require.Contains(t,
+		d.WebhooksDiff.Modified["newPet"].OperationsDiff.Modified["POST"].RequestBodyDiff.ContentDiff.MediaTypeModified["application/json"].SchemaDiff.RequiredDiff.Added,
+		"tag")
+	require.Equal(t, diff.SummaryDetails{Modified: 1}, d.GetSummary().GetSummaryDetails(diff.WebhooksDetail))
+}
+
+func TestOAS31_WebhooksAddedAndDeleted(t *testing.T) {
+	s1, err := openapi3.NewLoader().LoadFromFile("../data/openapi31-test1.yaml")
+	require.NoError(t, err)
+
+	s2, err := openapi3.NewLoader().LoadFromFile("../data/openapi31-test1.yaml")
+	require.NoError(t, err)
+
+	webhooks, err := load.GetWebhooks(s2)
+	require.NoError(t, err)
+	webhooks.Set("updatedPet", webhooks.Value("newPet"))
+	delete(webhooks.Map(), "newPet")
+	s2.Extensions["webhooks"] = webhooks
+
+	d, err := diff.Get(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	require.Equal(t, utils.StringList{"updatedPet"}, d.WebhooksDiff.Added)
+	require.Equal(t, utils.StringList{"newPet"}, d.WebhooksDiff.Deleted)
+	require.Empty(t, d.WebhooksDiff.Modified)
+}
+
 func TestCircularSchema_Diff(t *testing.T) {
 	loader := openapi3.NewLoader()
 
@@ -699,6 +738,28 @@ func TestCallbacks(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestDiff_LinkParameters(t *testing.T) {
+	getSpec := func(parameters map[string]any) *openapi3.T {
+		return &openapi3.T{
+			Components: &openapi3.Components{
+				Links: openapi3.Links{
+					"GetGroup": &openapi3.LinkRef{Value: &openapi3.Link{OperationID: "getGroup", Parameters: parameters}},
+				},
+			},
+		}
+	}
+
+	d, err := diff.Get(diff.NewConfig(),
+		getSpec(map[string]any{"groupId": "$response.body#/id", "tenant": "$request.header.tenant"}),
+		getSpec(map[string]any{"groupId": "$response.body#/groupId", "limit": 10}))
+	require.NoError(t, err)
+
+	parametersDiff := d.LinksDiff.Modified["GetGroup"].ParametersDiff
+	require.Equal(t, utils.StringList{"limit"}, parametersDiff.Added)
+	require.Equal(t, utils.StringList{"tenant"}, parametersDiff.Deleted)
+	require.Equal(t, &diff.ValueDiff{From: "$response.body#/id", To: "$response.body#/groupId"}, parametersDiff.Modified["groupId"])
+}
+
 func TestDiff_InfoNil(t *testing.T) {
 	s1 := &openapi3.T{}
 	d, err := diff.Get(diff.NewConfig(), s1, s1)
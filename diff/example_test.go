@@ -115,8 +115,12 @@ func ExampleGetPathsDiff() {
 	}
 
 	// Output:
-	// 4 breaking changes: 1 error, 3 warning
-	// error at ../data/openapi-test3.yaml, in API GET /api/{domain}/{project}/badges/security-score removed the success response with the status '201' [response-success-status-removed].
+	// 6 breaking changes: 3 error, 3 warning
+	// error at ../data/openapi-test3.yaml, in API GET /api/{domain}/{project}/badges/security-score added a 'not' schema to the 'query' request parameter 'image' [request-parameter-not-schema-added].
+	//
+	// error at ../data/openapi-test3.yaml, in API GET /api/{domain}/{project}/badges/security-score removed the media type 'application/json' for the response with the status '400' [response-media-type-removed].
+	//
+	// error at ../data/openapi-test3.yaml, in API POST /subscribe changed the type of the request property 'message' from 'number' to 'string' in the callback 'myEvent' 'POST' 'hi' [callback-request-property-type-changed].
 	//
 	// warning at ../data/openapi-test3.yaml, in API GET /api/{domain}/{project}/badges/security-score deleted the 'cookie' request parameter 'test' [request-parameter-removed].
 	//
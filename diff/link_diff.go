@@ -41,9 +41,21 @@ func getLinkDiffInternal(config *Config, state *state, link1, link2 *openapi3.Li
 	result.OperationIDDiff = getValueDiff(link1.OperationID, link2.OperationID)
 	result.OperationRefDiff = getValueDiff(link1.OperationRef, link2.OperationRef)
 	result.DescriptionDiff = getValueDiffConditional(config.IsExcludeDescription(), link1.Description, link2.Description)
-	result.ParametersDiff = getInterfaceMapDiff(link1.Parameters, link2.Parameters, utils.StringSet{})
+	result.ParametersDiff = getLinkParametersDiff(link1.Parameters, link2.Parameters)
 	result.ServerDiff = getServerDiff(config, state, link1.Server, link2.Server)
 	result.RequestBodyDiff = getValueDiff(link1.RequestBody, link2.RequestBody)
 
 	return &result, nil
 }
+
+// getLinkParametersDiff compares all the link parameters, unlike extensions which are only compared if they are included
+func getLinkParametersDiff(parameters1, parameters2 map[string]any) *InterfaceMapDiff {
+	names := utils.StringSet{}
+	for name := range parameters1 {
+		names.Add(name)
+	}
+	for name := range parameters2 {
+		names.Add(name)
+	}
+	return getInterfaceMapDiff(parameters1, parameters2, names)
+}
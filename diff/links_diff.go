@@ -12,6 +12,8 @@ type LinksDiff struct {
 	Added    utils.StringList `json:"added,omitempty" yaml:"added,omitempty"`
 	Deleted  utils.StringList `json:"deleted,omitempty" yaml:"deleted,omitempty"`
 	Modified ModifiedLinks    `json:"modified,omitempty" yaml:"modified,omitempty"`
+	Base     openapi3.Links   `json:"-" yaml:"-"`
+	Revision openapi3.Links   `json:"-" yaml:"-"`
 }
 
 // Empty indicates whether a change was found in this element
@@ -83,6 +85,9 @@ func getLinksDiffsInternal(config *Config, state *state, links1, links2 openapi3
 		}
 	}
 
+	result.Base = links1
+	result.Revision = links2
+
 	return result, nil
 }
 
@@ -8,6 +8,7 @@ import (
 
 // MediaTypeDiff describes the changes between a pair of media type objects
 type MediaTypeDiff struct {
+	NameDiff       *ValueDiff      `json:"name,omitempty" yaml:"name,omitempty"`
 	ExtensionsDiff *ExtensionsDiff `json:"extensions,omitempty" yaml:"extensions,omitempty"`
 	SchemaDiff     *SchemaDiff     `json:"schema,omitempty" yaml:"schema,omitempty"`
 	ExampleDiff    *ValueDiff      `json:"example,omitempty" yaml:"example,omitempty"`
@@ -20,6 +21,15 @@ func (diff *MediaTypeDiff) Empty() bool {
 	return diff == nil || *diff == MediaTypeDiff{}
 }
 
+// RevisionName returns the name of the media type in the revision, given its name in the base
+// The names differ when the media type was replaced by a related media type, like application/json; charset=utf-8 replacing application/json
+func (diff *MediaTypeDiff) RevisionName(baseName string) string {
+	if diff == nil || diff.NameDiff == nil {
+		return baseName
+	}
+	return fmt.Sprint(diff.NameDiff.To)
+}
+
 func getMediaTypeDiff(config *Config, state *state, mediaType1 *openapi3.MediaType, mediaType2 *openapi3.MediaType) (*MediaTypeDiff, error) {
 	diff, err := getMediaTypeDiffInternal(config, state, mediaType1, mediaType2)
 	if err != nil {
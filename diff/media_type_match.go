@@ -0,0 +1,159 @@
+package diff
+
+import (
+	"mime"
+	"sort"
+	"strings"
+)
+
+const (
+	mediaTypeWildcard        = "*"
+	mediaTypeSuffixSeparator = "+"
+)
+
+// MediaType is a parsed media type, like application/vnd.acme.v2+json; charset=utf-8: https://www.rfc-editor.org/rfc/rfc6838
+type MediaType struct {
+	Type    string
+	Subtype string
+	Suffix  string // structured syntax suffix, like json in application/vnd.acme.v2+json
+	Params  map[string]string
+}
+
+// ParseMediaType parses a media type, including wildcards and parameters
+func ParseMediaType(mediaType string) (*MediaType, error) {
+	value, params, err := mime.ParseMediaType(mediaType)
+	if err != nil {
+		return nil, err
+	}
+
+	result := MediaType{
+		Type:   value,
+		Params: params,
+	}
+	if mainType, subtype, ok := strings.Cut(value, "/"); ok {
+		result.Type = mainType
+		result.Subtype = subtype
+	}
+	if i := strings.LastIndex(result.Subtype, mediaTypeSuffixSeparator); i >= 0 {
+		result.Suffix = result.Subtype[i+1:]
+	}
+	return &result, nil
+}
+
+// Covers returns true if every media type matched by other is also matched by this media type
+// A wildcard covers the types that it matches, and a media type without a parameter covers the same media type with any value of the parameter
+func (mediaType *MediaType) Covers(other *MediaType) bool {
+	if mediaType.Type != mediaTypeWildcard && mediaType.Type != other.Type {
+		return false
+	}
+	if mediaType.Subtype != mediaTypeWildcard && (mediaType.Type == mediaTypeWildcard || mediaType.Subtype != other.Subtype) {
+		return false
+	}
+	for name, value := range mediaType.Params {
+		if !strings.EqualFold(other.Params[name], value) {
+			return false
+		}
+	}
+	return true
+}
+
+// getMediaTypeMatchScore returns how closely two media types are related, or zero if they are unrelated
+func getMediaTypeMatchScore(mediaType1, mediaType2 *MediaType) int {
+	switch {
+	case mediaType1.Type == mediaType2.Type && mediaType1.Subtype == mediaType2.Subtype:
+		// only the parameters differ
+		return 3
+	case mediaType1.Covers(mediaType2) || mediaType2.Covers(mediaType1):
+		// a wildcard
+		return 2
+	case mediaType1.Type == mediaType2.Type && isStructuredSuffixMatch(mediaType1, mediaType2):
+		// application/json and application/vnd.acme.v2+json, or two vendor types with the same suffix
+		return 1
+	default:
+		return 0
+	}
+}
+
+func isStructuredSuffixMatch(mediaType1, mediaType2 *MediaType) bool {
+	return mediaType1.Suffix != "" && (mediaType1.Suffix == mediaType2.Suffix || mediaType1.Suffix == mediaType2.Subtype) ||
+		mediaType2.Suffix != "" && mediaType2.Suffix == mediaType1.Subtype
+}
+
+// matchMediaTypes pairs media types that were deleted with related media types that were added, like a media type that was replaced by a wildcard or by a vendor type
+// An added media type that covers deleted media types, like application/*, matches all of them
+// Other media types are paired one-to-one, by how closely they are related
+// It returns a map of deleted media types to their matching added media types
+func matchMediaTypes(deleted, added []string) map[string]string {
+	result := map[string]string{}
+	if len(deleted) == 0 || len(added) == 0 {
+		return result
+	}
+
+	deletedTypes := parseMediaTypes(deleted)
+	addedTypes := parseMediaTypes(added)
+
+	// added media types that cover deleted media types
+	matched := map[string]bool{}
+	for _, name1 := range deletedTypes.names {
+		bestScore, bestMatch := 0, ""
+		for _, name2 := range addedTypes.names {
+			if !addedTypes.types[name2].Covers(deletedTypes.types[name1]) {
+				continue
+			}
+			if score := getMediaTypeMatchScore(deletedTypes.types[name1], addedTypes.types[name2]); score > bestScore {
+				bestScore, bestMatch = score, name2
+			}
+		}
+
+		if bestScore > 0 {
+			matched[bestMatch] = true
+			result[name1] = bestMatch
+		}
+	}
+
+	// other related media types
+	for _, name1 := range deletedTypes.names {
+		if _, ok := result[name1]; ok {
+			continue
+		}
+
+		bestScore, bestMatch := 0, ""
+		for _, name2 := range addedTypes.names {
+			if matched[name2] {
+				continue
+			}
+			if score := getMediaTypeMatchScore(deletedTypes.types[name1], addedTypes.types[name2]); score > bestScore {
+				bestScore, bestMatch = score, name2
+			}
+		}
+
+		if bestScore > 0 {
+			matched[bestMatch] = true
+			result[name1] = bestMatch
+		}
+	}
+	return result
+}
+
+// parsedMediaTypes are the valid media types of a list, sorted by name
+type parsedMediaTypes struct {
+	names []string
+	types map[string]*MediaType
+}
+
+func parseMediaTypes(names []string) parsedMediaTypes {
+	result := parsedMediaTypes{
+		names: []string{},
+		types: map[string]*MediaType{},
+	}
+	for _, name := range names {
+		mediaType, err := ParseMediaType(name)
+		if err != nil {
+			continue
+		}
+		result.names = append(result.names, name)
+		result.types[name] = mediaType
+	}
+	sort.Strings(result.names)
+	return result
+}
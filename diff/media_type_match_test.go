@@ -0,0 +1,106 @@
+package diff_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+func TestParseMediaType(t *testing.T) {
+	mediaType, err := diff.ParseMediaType("application/vnd.acme.v2+json; charset=UTF-8")
+	require.NoError(t, err)
+	require.Equal(t, &diff.MediaType{
+		Type:    "application",
+		Subtype: "vnd.acme.v2+json",
+		Suffix:  "json",
+		Params:  map[string]string{"charset": "UTF-8"},
+	}, mediaType)
+}
+
+func TestParseMediaType_Invalid(t *testing.T) {
+	_, err := diff.ParseMediaType("application/json; charset")
+	require.Error(t, err)
+}
+
+func TestMediaTypeCovers(t *testing.T) {
+	tests := []struct {
+		mediaType1 string
+		mediaType2 string
+		covers     bool
+	}{
+		{"*/*", "application/json", true},
+		{"application/*", "application/json", true},
+		{"application/*", "text/plain", false},
+		{"application/json", "application/*", false},
+		{"application/json", "application/json; charset=utf-8", true},
+		{"application/json; charset=utf-8", "application/json; charset=UTF-8", true},
+		{"application/json; charset=utf-8", "application/json", false},
+		{"application/json", "application/vnd.acme+json", false},
+	}
+
+	for _, test := range tests {
+		mediaType1, err := diff.ParseMediaType(test.mediaType1)
+		require.NoError(t, err)
+		mediaType2, err := diff.ParseMediaType(test.mediaType2)
+		require.NoError(t, err)
+		require.Equal(t, test.covers, mediaType1.Covers(mediaType2), "%s covers %s", test.mediaType1, test.mediaType2)
+	}
+}
+
+func TestDiff_MediaTypeMatched(t *testing.T) {
+	s1, err := openapi3.NewLoader().LoadFromFile("../data/checker/media_type_match_base.yaml")
+	require.NoError(t, err)
+	s2, err := openapi3.NewLoader().LoadFromFile("../data/checker/media_type_match_base.yaml")
+	require.NoError(t, err)
+
+	content := s2.Paths.Value("/orders").Post.RequestBody.Value.Content
+	content["application/vnd.acme.v2+json; charset=utf-8"] = content["application/json"]
+	content["text/plain"] = content["application/json"]
+	delete(content, "application/json")
+
+	d, _, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(),
+		&load.SpecInfo{
+			Spec: s1,
+		},
+		&load.SpecInfo{
+			Spec: s2,
+		})
+	require.NoError(t, err)
+
+	contentDiff := d.PathsDiff.Modified["/orders"].OperationsDiff.Modified["POST"].RequestBodyDiff.ContentDiff
+	require.Empty(t, contentDiff.MediaTypeDeleted)
+	require.Equal(t, []string{"text/plain"}, []string(contentDiff.MediaTypeAdded))
+	require.Equal(t, &diff.ValueDiff{From: "application/json", To: "application/vnd.acme.v2+json; charset=utf-8"}, contentDiff.MediaTypeModified["application/json"].NameDiff)
+	require.Equal(t, "application/vnd.acme.v2+json; charset=utf-8", contentDiff.MediaTypeModified["application/json"].RevisionName("application/json"))
+}
+
+func TestDiff_MediaTypeMatchedByWildcard(t *testing.T) {
+	s1, err := openapi3.NewLoader().LoadFromFile("../data/checker/media_type_match_base.yaml")
+	require.NoError(t, err)
+	s2, err := openapi3.NewLoader().LoadFromFile("../data/checker/media_type_match_base.yaml")
+	require.NoError(t, err)
+
+	content1 := s1.Paths.Value("/orders").Post.RequestBody.Value.Content
+	content1["application/xml"] = content1["application/json"]
+	content2 := s2.Paths.Value("/orders").Post.RequestBody.Value.Content
+	content2["application/*"] = content2["application/json"]
+	delete(content2, "application/json")
+
+	d, _, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(),
+		&load.SpecInfo{
+			Spec: s1,
+		},
+		&load.SpecInfo{
+			Spec: s2,
+		})
+	require.NoError(t, err)
+
+	contentDiff := d.PathsDiff.Modified["/orders"].OperationsDiff.Modified["POST"].RequestBodyDiff.ContentDiff
+	require.Empty(t, contentDiff.MediaTypeDeleted)
+	require.Empty(t, contentDiff.MediaTypeAdded)
+	require.Equal(t, &diff.ValueDiff{From: "application/json", To: "application/*"}, contentDiff.MediaTypeModified["application/json"].NameDiff)
+	require.Equal(t, &diff.ValueDiff{From: "application/xml", To: "application/*"}, contentDiff.MediaTypeModified["application/xml"].NameDiff)
+}
@@ -15,6 +15,10 @@ type PathsDiff struct {
 	Modified ModifiedPaths    `json:"modified,omitempty" yaml:"modified,omitempty"`
 	Base     *openapi3.Paths  `json:"-" yaml:"-"`
 	Revision *openapi3.Paths  `json:"-" yaml:"-"`
+
+	// BaseServers and RevisionServers are the servers of the spec, which apply to the paths and operations that don't override them
+	BaseServers     openapi3.Servers `json:"-" yaml:"-"`
+	RevisionServers openapi3.Servers `json:"-" yaml:"-"`
 }
 
 // Empty indicates whether a change was found in this element
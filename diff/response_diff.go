@@ -6,6 +6,7 @@ import (
 
 // ResponseDiff describes the changes between a pair of response objects: https://swagger.io/specification/#response-object
 type ResponseDiff struct {
+	StatusDiff      *ValueDiff         `json:"status,omitempty" yaml:"status,omitempty"`
 	ExtensionsDiff  *ExtensionsDiff    `json:"extensions,omitempty" yaml:"extensions,omitempty"`
 	DescriptionDiff *ValueDiff         `json:"description,omitempty" yaml:"description,omitempty"`
 	HeadersDiff     *HeadersDiff       `json:"headers,omitempty" yaml:"headers,omitempty"`
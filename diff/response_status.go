@@ -0,0 +1,58 @@
+package diff
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// DefaultResponseStatus is the key of the response that covers all the status codes that aren't declared individually: https://spec.openapis.org/oas/v3.0.3#responses-object
+const DefaultResponseStatus = "default"
+
+// IsResponseStatusRange returns true if the status is a range of status codes, like 4XX
+func IsResponseStatusRange(status string) bool {
+	return len(status) == 3 &&
+		status[0] >= '1' && status[0] <= '5' &&
+		strings.EqualFold(status[1:], "XX")
+}
+
+// GetResponseStatusCode returns the status code of a response, or the first status code of a range, like 400 for 4XX
+// The default response returns zero because it covers all the status codes that aren't declared individually
+func GetResponseStatusCode(status string) (int, bool) {
+	if status == DefaultResponseStatus {
+		return 0, true
+	}
+	if IsResponseStatusRange(status) {
+		return int(status[0]-'0') * 100, true
+	}
+	if code, err := strconv.Atoi(status); err == nil && len(status) == 3 {
+		return code, true
+	}
+	return 0, false
+}
+
+// getCoveringResponseStatus returns the response status that covers a status which isn't declared, like 4XX for 404, or the default response
+// The default response covers every status, including the success statuses, as the specification defines it
+// It returns an empty string if the status isn't covered by any of the responses
+func getCoveringResponseStatus(status string, responses map[string]*openapi3.ResponseRef) string {
+	if status == DefaultResponseStatus {
+		return ""
+	}
+
+	if !IsResponseStatusRange(status) {
+		if _, ok := GetResponseStatusCode(status); !ok {
+			return ""
+		}
+		for responseStatus := range responses {
+			if IsResponseStatusRange(responseStatus) && responseStatus[0] == status[0] {
+				return responseStatus
+			}
+		}
+	}
+
+	if _, ok := responses[DefaultResponseStatus]; ok {
+		return DefaultResponseStatus
+	}
+	return ""
+}
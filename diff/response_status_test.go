@@ -0,0 +1,46 @@
+package diff_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/diff"
+)
+
+func TestGetResponseStatusCode(t *testing.T) {
+	tests := []struct {
+		status string
+		code   int
+		ok     bool
+	}{
+		{"200", 200, true},
+		{"4XX", 400, true},
+		{"5xx", 500, true},
+		{"default", 0, true},
+		{"6XX", 0, false},
+		{"NotFound", 0, false},
+	}
+
+	for _, test := range tests {
+		code, ok := diff.GetResponseStatusCode(test.status)
+		require.Equal(t, test.ok, ok, test.status)
+		require.Equal(t, test.code, code, test.status)
+	}
+}
+
+func TestResponseCoveredByRange(t *testing.T) {
+	s1 := l(t, 1)
+	s2 := l(t, 1)
+
+	responses := s2.Paths.Value(securityScorePath).Get.Responses
+	responses.Set("4XX", responses.Value("400"))
+	delete(responses.Map(), "400")
+
+	d, err := diff.Get(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+
+	responsesDiff := d.PathsDiff.Modified[securityScorePath].OperationsDiff.Modified["GET"].ResponsesDiff
+	require.Empty(t, responsesDiff.Deleted)
+	require.Equal(t, []string{"4XX"}, []string(responsesDiff.Added))
+	require.Equal(t, &diff.ValueDiff{From: "400", To: "4XX"}, responsesDiff.Modified["400"].StatusDiff)
+}
@@ -87,9 +87,48 @@ func getResponsesDiffInternal(config *Config, state *state, responses1, response
 		}
 	}
 
+	if err := result.matchCoveredResponses(config, state, responses1, responses2); err != nil {
+		return nil, err
+	}
+
 	return result, nil
 }
 
+// matchCoveredResponses compares deleted responses with the responses that now cover them, like 404 replaced by 4XX or by the default response
+// Matched responses are reported as modified, with a status diff, rather than as deleted
+// The covering responses are still reported as added if they are new, because they may also cover other statuses
+func (responsesDiff *ResponsesDiff) matchCoveredResponses(config *Config, state *state, responses1, responses2 *openapi3.Responses) error {
+	deleted := utils.StringList{}
+
+	for _, responseValue1 := range responsesDiff.Deleted {
+		responseValue2 := getCoveringResponseStatus(responseValue1, responses2.Map())
+		if responseValue2 == "" {
+			deleted = append(deleted, responseValue1)
+			continue
+		}
+
+		value1, err := derefResponse(responses1.Value(responseValue1))
+		if err != nil {
+			return err
+		}
+
+		value2, err := derefResponse(responses2.Value(responseValue2))
+		if err != nil {
+			return err
+		}
+
+		diff, err := diffResponseValuesInternal(config, state, value1, value2)
+		if err != nil {
+			return err
+		}
+		diff.StatusDiff = getValueDiff(responseValue1, responseValue2)
+		responsesDiff.Modified[responseValue1] = diff
+	}
+
+	responsesDiff.Deleted = deleted
+	return nil
+}
+
 func derefResponse(ref *openapi3.ResponseRef) (*openapi3.Response, error) {
 
 	if ref == nil || ref.Value == nil {
@@ -10,6 +10,8 @@ type ServersDiff struct {
 	Added    utils.StringList `json:"added,omitempty" yaml:"added,omitempty"`
 	Deleted  utils.StringList `json:"deleted,omitempty" yaml:"deleted,omitempty"`
 	Modified ModifiedServers  `json:"modified,omitempty" yaml:"modified,omitempty"`
+	Base     openapi3.Servers `json:"-" yaml:"-"`
+	Revision openapi3.Servers `json:"-" yaml:"-"`
 }
 
 // ModifiedServers is map of server names to their respective diffs
@@ -41,9 +43,17 @@ func getServersDiff(config *Config, state *state, pServers1, pServers2 *openapi3
 		return nil
 	}
 
+	diff.Base = derefServers(pServers1)
+	diff.Revision = derefServers(pServers2)
+
 	return diff
 }
 
+// GetServersDiff compares two lists of servers, like the servers that apply to an operation before and after, which may come from different levels of the specs
+func GetServersDiff(config *Config, servers1, servers2 openapi3.Servers) *ServersDiff {
+	return getServersDiff(config, newState(), &servers1, &servers2)
+}
+
 func getServersDiffInternal(config *Config, state *state, pServers1, pServers2 *openapi3.Servers) *ServersDiff {
 
 	result := newServersDiff()
@@ -1,6 +1,9 @@
 package diff
 
-import "github.com/tufin/oasdiff/utils"
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/utils"
+)
 
 type direction int
 
@@ -14,6 +17,7 @@ type state struct {
 	visitedSchemasRevision utils.VisitedRefs
 	cache                  directionalSchemaDiffCache
 	direction              direction
+	webhooks               map[*openapi3.T]*openapi3.Paths
 }
 
 func newState() *state {
@@ -22,6 +26,7 @@ func newState() *state {
 		visitedSchemasRevision: utils.VisitedRefs{},
 		cache:                  newDirectionalSchemaDiffCache(),
 		direction:              directionRequest,
+		webhooks:               map[*openapi3.T]*openapi3.Paths{},
 	}
 }
 
@@ -0,0 +1,61 @@
+package diff
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/load"
+)
+
+// getWebhooks returns the webhooks of a spec, parsing them once per diff so that all the changes refer to the same objects
+func (state *state) getWebhooks(spec *openapi3.T) (*openapi3.Paths, error) {
+	if webhooks, ok := state.webhooks[spec]; ok {
+		return webhooks, nil
+	}
+
+	webhooks, err := load.GetWebhooks(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	state.webhooks[spec] = webhooks
+	return webhooks, nil
+}
+
+// setWebhooks uses the webhooks that were parsed when the spec was loaded, which the locations refer to
+func (state *state) setWebhooks(specInfo *load.SpecInfo) {
+	if specInfo.Webhooks != nil {
+		state.webhooks[specInfo.Spec] = specInfo.Webhooks
+	}
+}
+
+// getWebhooksDiff compares webhooks by name, reusing the diff of paths since each webhook is a Path Item
+func getWebhooksDiff(config *Config, state *state, webhooks1, webhooks2 *openapi3.Paths) (*PathsDiff, error) {
+
+	result := newPathsDiff()
+
+	for name, pathItem1 := range webhooks1.Map() {
+		pathItem2 := webhooks2.Value(name)
+		if pathItem2 == nil {
+			result.addDeletedPath(name)
+			continue
+		}
+
+		if err := result.addModifiedPath(config, state, name, &pathItemPair{PathItem1: pathItem1, PathItem2: pathItem2}); err != nil {
+			return nil, err
+		}
+	}
+
+	for name := range webhooks2.Map() {
+		if webhooks1.Value(name) == nil {
+			result.addAddedPath(name)
+		}
+	}
+
+	if result.Empty() {
+		return nil, nil
+	}
+
+	result.Base = webhooks1
+	result.Revision = webhooks2
+
+	return result, nil
+}
@@ -22,17 +22,22 @@ type Changes []Change
 func NewChanges(originalChanges checker.Changes, l checker.Localizer) Changes {
 	changes := make(Changes, len(originalChanges))
 	for i, change := range originalChanges {
-		changes[i] = Change{
-			Section:     change.GetSection(),
-			Id:          change.GetId(),
-			Text:        change.GetUncolorizedText(l),
-			Comment:     change.GetComment(l),
-			Level:       change.GetLevel(),
-			Operation:   change.GetOperation(),
-			OperationId: change.GetOperationId(),
-			Path:        change.GetPath(),
-			Source:      change.GetSource(),
-		}
+		changes[i] = newChange(change, l)
 	}
 	return changes
 }
+
+func newChange(change checker.Change, l checker.Localizer) Change {
+	return Change{
+		Section:     change.GetSection(),
+		Id:          change.GetId(),
+		Text:        change.GetUncolorizedText(l),
+		Comment:     change.GetComment(l),
+		Level:       change.GetLevel(),
+		Operation:   change.GetOperation(),
+		OperationId: change.GetOperationId(),
+		Path:        change.GetPath(),
+		Source:      change.GetSource(),
+		IsBreaking:  change.IsBreaking(),
+	}
+}
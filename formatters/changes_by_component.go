@@ -0,0 +1,54 @@
+package formatters
+
+import "github.com/tufin/oasdiff/checker"
+
+// ChangesByComponent groups the changes in the components section by the type of the component, like schemas or securitySchemes
+type ChangesByComponent map[string]*Changes
+
+func GroupComponentChanges(changes checker.Changes, l checker.Localizer) ChangesByComponent {
+
+	componentChanges := ChangesByComponent{}
+
+	for _, change := range changes {
+		switch c := change.(type) {
+		case checker.ComponentChange:
+			if group, ok := componentChanges[c.Component]; ok {
+				*group = append(*group, newChange(change, l))
+			} else {
+				componentChanges[c.Component] = &Changes{newChange(change, l)}
+			}
+		}
+	}
+
+	return componentChanges
+}
+
+// GetSecurityChanges returns the changes in the security section
+func GetSecurityChanges(changes checker.Changes, l checker.Localizer) Changes {
+
+	securityChanges := Changes{}
+
+	for _, change := range changes {
+		switch change.(type) {
+		case checker.SecurityChange:
+			securityChanges = append(securityChanges, newChange(change, l))
+		}
+	}
+
+	return securityChanges
+}
+
+// GetServerChanges returns the changes in the servers section
+func GetServerChanges(changes checker.Changes, l checker.Localizer) Changes {
+
+	serverChanges := Changes{}
+
+	for _, change := range changes {
+		switch change.(type) {
+		case checker.ServerChange:
+			serverChanges = append(serverChanges, newChange(change, l))
+		}
+	}
+
+	return serverChanges
+}
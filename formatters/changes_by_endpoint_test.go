@@ -29,8 +29,26 @@ var changes = checker.Changes{
 		Id:    "security-added",
 		Level: checker.INFO,
 	},
+	checker.ServerChange{
+		Id:    "api-server-added",
+		Level: checker.INFO,
+	},
 }
 
 func TestChanges_Group(t *testing.T) {
 	require.Contains(t, formatters.GroupChanges(changes, checker.NewDefaultLocalizer()), formatters.Endpoint{Path: "/test", Operation: "GET"})
 }
+
+func TestChanges_GroupComponents(t *testing.T) {
+	componentChanges := formatters.GroupComponentChanges(changes, checker.NewDefaultLocalizer())
+	require.Len(t, componentChanges, 1)
+	require.Contains(t, componentChanges, "")
+}
+
+func TestChanges_Security(t *testing.T) {
+	require.Len(t, formatters.GetSecurityChanges(changes, checker.NewDefaultLocalizer()), 1)
+}
+
+func TestChanges_Servers(t *testing.T) {
+	require.Len(t, formatters.GetServerChanges(changes, checker.NewDefaultLocalizer()), 1)
+}
@@ -0,0 +1,139 @@
+package formatters
+
+import (
+	"sort"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+)
+
+// DefaultTag groups the changes to operations without tags, like Swagger UI does
+const DefaultTag = "default"
+
+// OperationTags maps endpoints to the tags of their operations
+type OperationTags map[Endpoint][]string
+
+// GetOperationTags returns the tags of the operations in the revision, falling back to the base for deleted operations
+func GetOperationTags(diffReport *diff.Diff) OperationTags {
+	tags := OperationTags{}
+
+	if diffReport == nil || diffReport.PathsDiff == nil {
+		return tags
+	}
+
+	tags.add(diffReport.PathsDiff.Base)
+	tags.add(diffReport.PathsDiff.Revision)
+
+	return tags
+}
+
+func (tags OperationTags) add(paths *openapi3.Paths) {
+	if paths == nil {
+		return
+	}
+
+	for path, pathItem := range paths.Map() {
+		for method, operation := range pathItem.Operations() {
+			tags[Endpoint{Path: path, Operation: method}] = operation.Tags
+		}
+	}
+}
+
+// get returns the tags of the operation of a change, or the default tag if it has none
+func (tags OperationTags) get(change checker.Change) []string {
+	if result := tags[Endpoint{Path: change.GetPath(), Operation: change.GetOperation()}]; len(result) > 0 {
+		return result
+	}
+	return []string{DefaultTag}
+}
+
+// ChangesByTag groups the changes to endpoints by the tags of their operations
+// The changes of an operation with several tags appear under each one of them
+type ChangesByTag map[string]ChangesByEndpoint
+
+func GroupChangesByTag(changes checker.Changes, l checker.Localizer, tags OperationTags) ChangesByTag {
+
+	result := ChangesByTag{}
+
+	for tag, tagChanges := range splitChangesByTag(changes, tags).tags {
+		result[tag] = GroupChanges(tagChanges, l)
+	}
+
+	return result
+}
+
+// changesBySection are the changes to endpoints, split by the tags of their operations, followed by the changes to the other sections of the spec
+type changesBySection struct {
+	tags       map[string]checker.Changes
+	components checker.Changes
+	security   checker.Changes
+	servers    checker.Changes
+}
+
+// splitChangesByTag splits the changes to endpoints by the tags of their operations and the other changes by their section, keeping their order
+func splitChangesByTag(changes checker.Changes, tags OperationTags) changesBySection {
+
+	result := changesBySection{
+		tags: map[string]checker.Changes{},
+	}
+
+	for _, change := range changes {
+		switch change.(type) {
+		case checker.ApiChange:
+			for _, tag := range tags.get(change) {
+				result.tags[tag] = append(result.tags[tag], change)
+			}
+		case checker.ComponentChange:
+			result.components = append(result.components, change)
+		case checker.SecurityChange:
+			result.security = append(result.security, change)
+		case checker.ServerChange:
+			result.servers = append(result.servers, change)
+		}
+	}
+
+	return result
+}
+
+// ChangesGroupedByTag is the structured output of a changelog grouped by tags
+type ChangesGroupedByTag struct {
+	Tags       map[string]Changes `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Components Changes            `json:"components,omitempty" yaml:"components,omitempty"`
+	Security   Changes            `json:"security,omitempty" yaml:"security,omitempty"`
+	Servers    Changes            `json:"servers,omitempty" yaml:"servers,omitempty"`
+}
+
+func NewChangesGroupedByTag(changes checker.Changes, l checker.Localizer, tags OperationTags) *ChangesGroupedByTag {
+
+	changesBySection := splitChangesByTag(changes, tags)
+
+	result := ChangesGroupedByTag{
+		Tags: map[string]Changes{},
+	}
+
+	for tag, tagChanges := range changesBySection.tags {
+		result.Tags[tag] = NewChanges(tagChanges, l)
+	}
+
+	if len(changesBySection.components) > 0 {
+		result.Components = NewChanges(changesBySection.components, l)
+	}
+	if len(changesBySection.security) > 0 {
+		result.Security = NewChanges(changesBySection.security, l)
+	}
+	if len(changesBySection.servers) > 0 {
+		result.Servers = NewChanges(changesBySection.servers, l)
+	}
+
+	return &result
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
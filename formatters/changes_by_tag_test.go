@@ -0,0 +1,95 @@
+package formatters_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/formatters"
+	"github.com/tufin/oasdiff/load"
+)
+
+var tags = formatters.OperationTags{
+	{Path: "/pets", Operation: "GET"}:   {"pets"},
+	{Path: "/stores", Operation: "GET"}: {"stores", "pets"},
+}
+
+var taggedChanges = checker.Changes{
+	checker.ApiChange{
+		Id:        "api-deleted",
+		Level:     checker.ERR,
+		Operation: "GET",
+		Path:      "/pets",
+	},
+	checker.ApiChange{
+		Id:        "api-added",
+		Level:     checker.INFO,
+		Operation: "GET",
+		Path:      "/stores",
+	},
+	checker.ApiChange{
+		Id:        "api-tag-removed",
+		Level:     checker.INFO,
+		Operation: "GET",
+		Path:      "/health",
+	},
+	checker.ComponentChange{
+		Id:        "component-added",
+		Level:     checker.INFO,
+		Component: "schemas",
+	},
+	checker.SecurityChange{
+		Id:    "security-added",
+		Level: checker.INFO,
+	},
+	checker.ServerChange{
+		Id:    "api-server-added",
+		Level: checker.INFO,
+	},
+}
+
+func TestGetOperationTags(t *testing.T) {
+	loader := openapi3.NewLoader()
+
+	s1, err := load.LoadSpecInfo(loader, load.NewSource("../data/run_test/changelog_tags_base.yaml"))
+	require.NoError(t, err)
+
+	s2, err := load.LoadSpecInfo(loader, load.NewSource("../data/run_test/changelog_tags_revision.yaml"))
+	require.NoError(t, err)
+
+	d, err := diff.Get(diff.NewConfig(), s1.Spec, s2.Spec)
+	require.NoError(t, err)
+
+	require.Equal(t, formatters.OperationTags{
+		{Path: "/pets", Operation: "GET"}:      {"pets"},
+		{Path: "/stores", Operation: "GET"}:    {"stores", "pets"},
+		{Path: "/orders", Operation: "DELETE"}: {"orders"},
+		{Path: "/health", Operation: "GET"}:    nil,
+	}, formatters.GetOperationTags(d))
+}
+
+func TestGetOperationTags_NoDiff(t *testing.T) {
+	require.Empty(t, formatters.GetOperationTags(nil))
+}
+
+func TestGroupChangesByTag(t *testing.T) {
+	changesByTag := formatters.GroupChangesByTag(taggedChanges, checker.NewDefaultLocalizer(), tags)
+	require.Len(t, changesByTag, 3)
+	require.Len(t, changesByTag["pets"], 2)
+	require.Contains(t, changesByTag["pets"], formatters.Endpoint{Path: "/stores", Operation: "GET"})
+	require.Contains(t, changesByTag["stores"], formatters.Endpoint{Path: "/stores", Operation: "GET"})
+	require.Contains(t, changesByTag[formatters.DefaultTag], formatters.Endpoint{Path: "/health", Operation: "GET"})
+}
+
+func TestNewChangesGroupedByTag(t *testing.T) {
+	grouped := formatters.NewChangesGroupedByTag(taggedChanges, checker.NewDefaultLocalizer(), tags)
+	require.Len(t, grouped.Tags, 3)
+	require.Len(t, grouped.Tags["pets"], 2)
+	require.Equal(t, "api-deleted", grouped.Tags["pets"][0].Id)
+	require.Equal(t, "api-added", grouped.Tags["pets"][1].Id)
+	require.Len(t, grouped.Components, 1)
+	require.Len(t, grouped.Security, 1)
+	require.Len(t, grouped.Servers, 1)
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/lint"
 )
 
 var githubActionsSeverity = map[checker.Level]string{
@@ -67,13 +68,40 @@ func (f GitHubActionsFormatter) RenderBreakingChanges(changes checker.Changes, o
 	return buf.Bytes(), nil
 }
 
+func (f GitHubActionsFormatter) RenderLint(errs lint.Errors, opts RenderOpts) ([]byte, error) {
+	var buf bytes.Buffer
+
+	// add error and warning count to job output parameters
+	count := errs.GetLevelCount()
+	err := writeGitHubActionsJobOutputParameters(map[string]string{
+		"error_count":   fmt.Sprint(count[lint.LEVEL_ERROR]),
+		"warning_count": fmt.Sprint(count[lint.LEVEL_WARN]),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range errs {
+		var params = []string{
+			"title=" + e.Id,
+		}
+		if e.Source != "" {
+			params = append(params, "file="+e.Source)
+		}
+
+		buf.WriteString(fmt.Sprintf("::%s %s::%s\n", githubActionsSeverity[getLintLevel(e.Level)], strings.Join(params, ","), strings.ReplaceAll(e.Text, "\n", "%0A")))
+	}
+
+	return buf.Bytes(), nil
+}
+
 func getMessage(change checker.Change, l checker.Localizer) string {
 	message := strings.ReplaceAll(change.GetUncolorizedText(l), "\n", "%0A")
 	return fmt.Sprintf("in API %s %s %s", change.GetOperation(), change.GetPath(), message)
 }
 
 func (f GitHubActionsFormatter) SupportedOutputs() []Output {
-	return []Output{OutputBreaking}
+	return []Output{OutputBreaking, OutputLint}
 }
 
 func writeGitHubActionsJobOutputParameters(params map[string]string) error {
@@ -36,17 +36,11 @@ func (f HTMLFormatter) RenderDiff(diff *diff.Diff, opts RenderOpts) ([]byte, err
 //go:embed templates/changelog.html
 var changelog string
 
-type TemplateData struct {
-	APIChanges      ChangesByEndpoint
-	BaseVersion     string
-	RevisionVersion string
-}
-
 func (f HTMLFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, specInfoPair *load.SpecInfoPair) ([]byte, error) {
 	tmpl := template.Must(template.New("changelog").Parse(changelog))
 
 	var out bytes.Buffer
-	if err := tmpl.Execute(&out, TemplateData{GroupChanges(changes, f.Localizer), specInfoPair.GetBaseVersion(), specInfoPair.GetRevisionVersion()}); err != nil {
+	if err := tmpl.Execute(&out, NewTemplateData(changes, f.Localizer, specInfoPair, opts.Tags)); err != nil {
 		return nil, err
 	}
 
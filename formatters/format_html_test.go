@@ -60,6 +60,49 @@ func TestHtmlFormatter_RenderChangelog(t *testing.T) {
 	require.NotEmpty(t, string(out))
 }
 
+func TestHtmlFormatter_RenderChangelog_GroupByTag(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Path:      "/test",
+			Operation: "GET",
+			Id:        "change_id",
+			Level:     checker.ERR,
+		},
+		checker.ComponentChange{
+			Id:        "notice_id",
+			Level:     checker.INFO,
+			Component: "schemas",
+		},
+		checker.SecurityChange{
+			Id:    "warning_id",
+			Level: checker.WARN,
+		},
+	}
+
+	tags := formatters.OperationTags{{Path: "/test", Operation: "GET"}: {"test"}}
+
+	out, err := htmlFormatter.RenderChangelog(testChanges, formatters.RenderOpts{Tags: tags}, nil)
+	require.NoError(t, err)
+	require.Contains(t, string(out), `<div class="tag">test</div>`)
+	require.Contains(t, string(out), "components/schemas")
+	require.Contains(t, string(out), "This is a notice.")
+	require.Contains(t, string(out), "This is a warning.")
+}
+
+func TestHtmlFormatter_RenderChangelog_Servers(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ServerChange{
+			Id:    "notice_id",
+			Level: checker.INFO,
+		},
+	}
+
+	out, err := htmlFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), nil)
+	require.NoError(t, err)
+	require.Contains(t, string(out), `<div class="">servers</div>`)
+	require.Contains(t, string(out), "This is a notice.")
+}
+
 func TestHtmlFormatter_NotImplemented(t *testing.T) {
 	var err error
 	_, err = htmlFormatter.RenderBreakingChanges(checker.Changes{}, formatters.NewRenderOpts())
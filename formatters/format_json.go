@@ -8,6 +8,7 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/tufin/oasdiff/checker"
 	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/lint"
 	"github.com/tufin/oasdiff/load"
 )
 
@@ -34,6 +35,9 @@ func (f JSONFormatter) RenderBreakingChanges(changes checker.Changes, opts Rende
 }
 
 func (f JSONFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, specInfoPair *load.SpecInfoPair) ([]byte, error) {
+	if opts.Tags != nil {
+		return printJSON(NewChangesGroupedByTag(changes, f.Localizer, opts.Tags))
+	}
 	return printJSON(NewChanges(changes, f.Localizer))
 }
 
@@ -45,8 +49,12 @@ func (f JSONFormatter) RenderFlatten(spec *openapi3.T, opts RenderOpts) ([]byte,
 	return printJSON(spec)
 }
 
+func (f JSONFormatter) RenderLint(errs lint.Errors, opts RenderOpts) ([]byte, error) {
+	return printJSON(errs)
+}
+
 func (f JSONFormatter) SupportedOutputs() []Output {
-	return []Output{OutputDiff, OutputSummary, OutputBreaking, OutputChangelog, OutputChecks, OutputFlatten}
+	return []Output{OutputDiff, OutputSummary, OutputBreaking, OutputChangelog, OutputChecks, OutputFlatten, OutputLint}
 }
 
 func printJSON(output interface{}) ([]byte, error) {
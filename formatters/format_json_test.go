@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/tufin/oasdiff/checker"
 	"github.com/tufin/oasdiff/formatters"
+	"github.com/tufin/oasdiff/load"
 )
 
 var jsonFormatter = formatters.JSONFormatter{
@@ -44,6 +45,28 @@ func TestJsonFormatter_RenderChangelog(t *testing.T) {
 	require.Equal(t, "[{\"id\":\"change_id\",\"text\":\"This is a breaking change.\",\"level\":3,\"section\":\"components\"}]", string(out))
 }
 
+func TestJsonFormatter_RenderChangelog_GroupByTag(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Path:      "/test",
+			Operation: "GET",
+			Id:        "change_id",
+			Level:     checker.ERR,
+			Source:    load.NewSource(""),
+		},
+		checker.SecurityChange{
+			Id:    "notice_id",
+			Level: checker.INFO,
+		},
+	}
+
+	tags := formatters.OperationTags{{Path: "/test", Operation: "GET"}: {"test"}}
+
+	out, err := jsonFormatter.RenderChangelog(testChanges, formatters.RenderOpts{Tags: tags}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "{\"tags\":{\"test\":[{\"id\":\"change_id\",\"text\":\"This is a breaking change.\",\"level\":3,\"operation\":\"GET\",\"path\":\"/test\",\"section\":\"paths\"}]},\"security\":[{\"id\":\"notice_id\",\"text\":\"This is a notice.\",\"level\":1,\"section\":\"security\"}]}", string(out))
+}
+
 func TestJsonFormatter_RenderChecks(t *testing.T) {
 	checks := formatters.Checks{
 		{
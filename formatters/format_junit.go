@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/lint"
 )
 
 type JUnitTestSuites struct {
@@ -27,6 +28,8 @@ type JUnitTestCase struct {
 	Name      string        `xml:"name,attr"`
 	Classname string        `xml:"classname,attr"`
 	Time      string        `xml:"time,attr"`
+	File      string        `xml:"file,attr,omitempty"`
+	Line      int           `xml:"line,attr,omitempty"`
 	Failure   *JUnitFailure `xml:"failure,omitempty"`
 }
 
@@ -67,6 +70,10 @@ func (f JUnitFormatter) RenderBreakingChanges(changes checker.Changes, opts Rend
 				CDATA:   change.GetUncolorizedText(f.Localizer),
 			},
 		}
+		if change.GetSourceFile() != "" {
+			testCase.File = change.GetSourceFile()
+			testCase.Line = change.GetSourceLine() + 1
+		}
 		testSuite.TestCases = append(testSuite.TestCases, testCase)
 	}
 
@@ -90,6 +97,49 @@ func (f JUnitFormatter) RenderBreakingChanges(changes checker.Changes, opts Rend
 	return []byte(xml.Header + string(output)), nil
 }
 
+func (f JUnitFormatter) RenderLint(errs lint.Errors, opts RenderOpts) ([]byte, error) {
+	var testSuite = JUnitTestSuite{
+		Package:   "com.oasdiff",
+		Time:      "0",
+		Tests:     len(errs),
+		Errors:    0,
+		Failures:  len(errs),
+		Name:      "OASDiff Lint",
+		TestCases: []JUnitTestCase{},
+	}
+
+	for _, err := range errs {
+		testCase := JUnitTestCase{
+			Name:      err.Id,
+			Classname: "OASDiff",
+			Time:      "0",
+			Failure: &JUnitFailure{
+				Message: "Lint error detected",
+				CDATA:   err.Text,
+			},
+		}
+		testSuite.TestCases = append(testSuite.TestCases, testCase)
+	}
+
+	// if there are no errors, add a dummy test case to the test suite as we need at least one test case
+	if len(errs) == 0 {
+		testCase := JUnitTestCase{
+			Name:      "no lint errors detected",
+			Classname: "OASDiff",
+			Time:      "0",
+		}
+		testSuite.TestCases = append(testSuite.TestCases, testCase)
+	}
+
+	testSuites := JUnitTestSuites{TestSuites: []JUnitTestSuite{testSuite}}
+	output, err := xml.MarshalIndent(testSuites, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal junit XML: %w", err)
+	}
+
+	return []byte(xml.Header + string(output)), nil
+}
+
 func (f JUnitFormatter) SupportedOutputs() []Output {
-	return []Output{OutputBreaking}
+	return []Output{OutputBreaking, OutputLint}
 }
@@ -41,6 +41,21 @@ func TestJUnitFormatter_RenderBreakingChanges_OneFailure(t *testing.T) {
 	assert.Equal(t, expectedOutput, string(output))
 }
 
+func TestJUnitFormatter_RenderBreakingChanges_Location(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ComponentChange{
+			Id:         "change_id",
+			Level:      checker.ERR,
+			SourceFile: "openapi.yaml",
+			SourceLine: 9,
+		},
+	}
+
+	output, err := jUnitFormatter.RenderBreakingChanges(testChanges, formatters.NewRenderOpts())
+	assert.NoError(t, err)
+	assert.Contains(t, string(output), `<testcase name="change_id" classname="OASDiff" time="0" file="openapi.yaml" line="10">`)
+}
+
 func TestJUnitFormatter_RenderBreakingChanges_Success(t *testing.T) {
 	testChanges := checker.Changes{}
 
@@ -0,0 +1,142 @@
+package formatters
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	_ "embed"
+
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/load"
+)
+
+type MarkdownFormatter struct {
+	notImplementedFormatter
+	Localizer checker.Localizer
+}
+
+func newMarkdownFormatter(l checker.Localizer) MarkdownFormatter {
+	return MarkdownFormatter{
+		Localizer: l,
+	}
+}
+
+//go:embed templates/changes.md
+var markdownChanges string
+
+// markdownTemplateData is the data passed to the markdown template of breaking changes and changelog
+type markdownTemplateData struct {
+	TemplateData
+	Title string
+	Total string
+}
+
+var markdownTemplate = template.Must(template.New("markdown").Funcs(template.FuncMap{
+	"badge":  getMarkdownBadge,
+	"escape": escapeMarkdown,
+}).Parse(markdownChanges))
+
+func (f MarkdownFormatter) RenderSummary(diff *diff.Diff, opts RenderOpts) ([]byte, error) {
+	return renderMarkdownSummary(diff.GetSummary()), nil
+}
+
+// renderMarkdownSummary renders a table with the number of added, deleted and modified items of each element of the specs
+func renderMarkdownSummary(summary *diff.Summary) []byte {
+	result := bytes.NewBuffer(nil)
+
+	if !summary.Diff {
+		_, _ = fmt.Fprintln(result, "No changes")
+		return result.Bytes()
+	}
+
+	names := make([]diff.DetailName, 0, len(summary.Details))
+	for name := range summary.Details {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+
+	_, _ = fmt.Fprintln(result, "| Element | Added | Deleted | Modified |")
+	_, _ = fmt.Fprintln(result, "|---|---:|---:|---:|")
+	for _, name := range names {
+		details := summary.GetSummaryDetails(name)
+		_, _ = fmt.Fprintf(result, "| %s | %d | %d | %d |\n", name, details.Added, details.Deleted, details.Modified)
+	}
+
+	return result.Bytes()
+}
+
+func (f MarkdownFormatter) RenderBreakingChanges(changes checker.Changes, opts RenderOpts) ([]byte, error) {
+	title := "API Breaking Changes"
+	if opts.SpecInfoPair != nil {
+		title = fmt.Sprintf("%s %s vs. %s", title, opts.SpecInfoPair.GetBaseVersion(), opts.SpecInfoPair.GetRevisionVersion())
+	}
+	return f.renderChanges(title, getBreakingTitle(changes, f.Localizer, checker.ColorNever), NewTemplateData(changes, f.Localizer, opts.SpecInfoPair, opts.Tags))
+}
+
+func (f MarkdownFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, specInfoPair *load.SpecInfoPair) ([]byte, error) {
+	title := fmt.Sprintf("API Changelog %s vs. %s", specInfoPair.GetBaseVersion(), specInfoPair.GetRevisionVersion())
+	return f.renderChanges(title, getChangelogTitle(changes, f.Localizer, checker.ColorNever), NewTemplateData(changes, f.Localizer, specInfoPair, opts.Tags))
+}
+
+func (f MarkdownFormatter) renderChanges(title, total string, data TemplateData) ([]byte, error) {
+	var out bytes.Buffer
+	if err := markdownTemplate.Execute(&out, markdownTemplateData{
+		TemplateData: data,
+		Title:        title,
+		Total:        strings.TrimSpace(total),
+	}); err != nil {
+		return nil, err
+	}
+
+	return out.Bytes(), nil
+}
+
+func (f MarkdownFormatter) RenderChecks(checks Checks, opts RenderOpts) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+
+	_, _ = fmt.Fprintln(result, "| ID | Description | Level |")
+	_, _ = fmt.Fprintln(result, "|---|---|---|")
+	for _, check := range checks {
+		_, _ = fmt.Fprintf(result, "| %s | %s | %s |\n", check.Id, escapeMarkdown(f.Localizer(check.Description)), check.Level)
+	}
+
+	return result.Bytes(), nil
+}
+
+func (f MarkdownFormatter) SupportedOutputs() []Output {
+	return []Output{OutputSummary, OutputBreaking, OutputChangelog, OutputChecks}
+}
+
+// getMarkdownBadge returns an emoji followed by the level, so that the severity stands out in a list of changes
+func getMarkdownBadge(level checker.Level) string {
+	switch level {
+	case checker.ERR:
+		return "🔴 **" + level.String() + "**"
+	case checker.WARN:
+		return "🟠 **" + level.String() + "**"
+	default:
+		return "🔵 **" + level.String() + "**"
+	}
+}
+
+var markdownReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	`*`, `\*`,
+	`_`, `\_`,
+	`[`, `\[`,
+	`]`, `\]`,
+	`<`, `&lt;`,
+	`>`, `&gt;`,
+	`|`, `\|`,
+	"\n", " ",
+)
+
+// escapeMarkdown prevents the characters of a change, like those of a pattern or a property name, from being interpreted as markdown or html
+func escapeMarkdown(s string) string {
+	return markdownReplacer.Replace(s)
+}
@@ -0,0 +1,178 @@
+package formatters_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/formatters"
+	"github.com/tufin/oasdiff/load"
+)
+
+var markdownFormatter = formatters.MarkdownFormatter{
+	Localizer: MockLocalizer,
+}
+
+func TestMarkdownLookup(t *testing.T) {
+	f, err := formatters.Lookup(string(formatters.FormatMarkdown), formatters.DefaultFormatterOpts())
+	require.NoError(t, err)
+	require.IsType(t, formatters.MarkdownFormatter{}, f)
+}
+
+func TestMarkdownFormatter_RenderBreakingChanges(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Path:      "/test",
+			Operation: "GET",
+			Id:        "change_id",
+			Level:     checker.ERR,
+		},
+		checker.ApiChange{
+			Path:      "/test",
+			Operation: "GET",
+			Id:        "warning_id",
+			Level:     checker.WARN,
+			Comment:   "notice_id",
+		},
+	}
+
+	out, err := markdownFormatter.RenderBreakingChanges(testChanges, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Equal(t, "## API Breaking Changes\n\n2 breaking changes: 1 error, 1 warning\n\n### GET /test\n\n- 🔴 **error** This is a breaking change.\n- 🟠 **warning** This is a warning.\n  <details>\n  <summary>warning_id</summary>\n\n  This is a notice.\n  </details>\n", string(out))
+}
+
+func TestMarkdownFormatter_RenderBreakingChangesVersions(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Path:      "/test",
+			Operation: "GET",
+			Id:        "change_id",
+			Level:     checker.ERR,
+		},
+	}
+
+	specInfoPair := load.NewSpecInfoPair(&load.SpecInfo{Version: "1.0.0"}, &load.SpecInfo{Version: "1.1.0"})
+
+	out, err := markdownFormatter.RenderBreakingChanges(testChanges, formatters.RenderOpts{SpecInfoPair: specInfoPair})
+	require.NoError(t, err)
+	require.Contains(t, string(out), "## API Breaking Changes 1.0.0 vs. 1.1.0\n")
+}
+
+func TestMarkdownFormatter_RenderChangelog(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Path:      "/test/{id}",
+			Operation: "POST",
+			Id:        "notice_id",
+			Level:     checker.INFO,
+		},
+		checker.ApiChange{
+			Path:      "/test",
+			Operation: "GET",
+			Id:        "change_id",
+			Level:     checker.ERR,
+		},
+	}
+
+	specInfoPair := load.NewSpecInfoPair(&load.SpecInfo{Version: "1.0.0"}, &load.SpecInfo{Version: "1.1.0"})
+
+	out, err := markdownFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), specInfoPair)
+	require.NoError(t, err)
+	require.Equal(t, "## API Changelog 1.0.0 vs. 1.1.0\n\n2 changes: 1 error, 0 warning, 1 info\n\n### GET /test\n\n- 🔴 **error** This is a breaking change.\n\n### POST /test/{id}\n\n- 🔵 **info** This is a notice.\n", string(out))
+}
+
+func TestMarkdownFormatter_RenderChangelog_GroupByTag(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Path:      "/test",
+			Operation: "GET",
+			Id:        "change_id",
+			Level:     checker.ERR,
+		},
+		checker.ComponentChange{
+			Id:        "notice_id",
+			Level:     checker.INFO,
+			Component: "schemas",
+		},
+		checker.SecurityChange{
+			Id:    "warning_id",
+			Level: checker.WARN,
+		},
+	}
+
+	tags := formatters.OperationTags{{Path: "/test", Operation: "GET"}: {"test"}}
+
+	out, err := markdownFormatter.RenderChangelog(testChanges, formatters.RenderOpts{Tags: tags}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "## API Changelog n/a vs. n/a\n\n3 changes: 1 error, 1 warning, 1 info\n\n### Tag: test\n\n#### GET /test\n\n- 🔴 **error** This is a breaking change.\n\n### components/schemas\n\n- 🔵 **info** This is a notice.\n\n### security\n\n- 🟠 **warning** This is a warning.\n", string(out))
+}
+
+func TestMarkdownFormatter_RenderChangelog_Servers(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Path:      "/test",
+			Operation: "GET",
+			Id:        "change_id",
+			Level:     checker.ERR,
+		},
+		checker.ServerChange{
+			Id:    "notice_id",
+			Level: checker.INFO,
+		},
+	}
+
+	out, err := markdownFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), nil)
+	require.NoError(t, err)
+	require.Equal(t, "## API Changelog n/a vs. n/a\n\n2 changes: 1 error, 0 warning, 1 info\n\n### GET /test\n\n- 🔴 **error** This is a breaking change.\n\n### servers\n\n- 🔵 **info** This is a notice.\n", string(out))
+}
+
+func TestMarkdownFormatter_RenderChangelog_Escape(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Path:      "/test",
+			Operation: "GET",
+			Id:        "pattern changed to '^[a-z_]*<1>|x$'",
+			Level:     checker.INFO,
+		},
+	}
+
+	out, err := markdownFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), nil)
+	require.NoError(t, err)
+	require.Contains(t, string(out), "## API Changelog n/a vs. n/a\n")
+	require.Contains(t, string(out), "- 🔵 **info** pattern changed to '^\\[a-z\\_\\]\\*&lt;1&gt;\\|x$'\n")
+}
+
+func TestMarkdownFormatter_RenderChecks(t *testing.T) {
+	checks := formatters.Checks{
+		{
+			Id:          "change_id",
+			Level:       "info",
+			Description: "This is a breaking change.",
+			Required:    true,
+		},
+	}
+
+	out, err := markdownFormatter.RenderChecks(checks, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Equal(t, "| ID | Description | Level |\n|---|---|---|\n| change_id | This is a breaking change. | info |\n", string(out))
+}
+
+func TestMarkdownFormatter_RenderSummary(t *testing.T) {
+	out, err := markdownFormatter.RenderSummary(nil, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Equal(t, "No changes\n", string(out))
+}
+
+func TestMarkdownFormatter_NotImplemented(t *testing.T) {
+	var err error
+
+	_, err = markdownFormatter.RenderDiff(nil, formatters.NewRenderOpts())
+	assert.Error(t, err)
+
+	_, err = markdownFormatter.RenderFlatten(nil, formatters.NewRenderOpts())
+	assert.Error(t, err)
+
+	_, err = markdownFormatter.RenderLint(nil, formatters.NewRenderOpts())
+	assert.Error(t, err)
+}
@@ -0,0 +1,238 @@
+package formatters
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/tufin/oasdiff/build"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/lint"
+	"github.com/tufin/oasdiff/load"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+var sarifLevel = map[checker.Level]string{
+	checker.ERR:  "error",
+	checker.WARN: "warning",
+	checker.INFO: "note",
+}
+
+type SarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []SarifRun `json:"runs"`
+}
+
+type SarifRun struct {
+	Tool    SarifTool     `json:"tool"`
+	Results []SarifResult `json:"results"`
+}
+
+type SarifTool struct {
+	Driver SarifDriver `json:"driver"`
+}
+
+type SarifDriver struct {
+	Name           string      `json:"name"`
+	InformationUri string      `json:"informationUri"`
+	Version        string      `json:"version"`
+	Rules          []SarifRule `json:"rules"`
+}
+
+type SarifRule struct {
+	Id                   string                 `json:"id"`
+	ShortDescription     SarifMessage           `json:"shortDescription"`
+	DefaultConfiguration SarifRuleConfiguration `json:"defaultConfiguration"`
+}
+
+type SarifRuleConfiguration struct {
+	Level string `json:"level"`
+}
+
+type SarifMessage struct {
+	Text string `json:"text"`
+}
+
+type SarifResult struct {
+	RuleId    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   SarifMessage    `json:"message"`
+	Locations []SarifLocation `json:"locations,omitempty"`
+}
+
+type SarifLocation struct {
+	PhysicalLocation SarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type SarifPhysicalLocation struct {
+	ArtifactLocation SarifArtifactLocation `json:"artifactLocation"`
+	Region           *SarifRegion          `json:"region,omitempty"`
+}
+
+type SarifArtifactLocation struct {
+	Uri string `json:"uri"`
+}
+
+type SarifRegion struct {
+	StartLine   int `json:"startLine,omitempty"`
+	StartColumn int `json:"startColumn,omitempty"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
+
+type SarifFormatter struct {
+	notImplementedFormatter
+	Localizer checker.Localizer
+}
+
+func newSarifFormatter(l checker.Localizer) SarifFormatter {
+	return SarifFormatter{
+		Localizer: l,
+	}
+}
+
+func (f SarifFormatter) RenderBreakingChanges(changes checker.Changes, opts RenderOpts) ([]byte, error) {
+	return printSarif(f.getRules(), f.getChangeResults(changes))
+}
+
+func (f SarifFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, specInfoPair *load.SpecInfoPair) ([]byte, error) {
+	return printSarif(f.getRules(), f.getChangeResults(changes))
+}
+
+func (f SarifFormatter) RenderLint(errs lint.Errors, opts RenderOpts) ([]byte, error) {
+	rules := []SarifRule{}
+	results := make([]SarifResult, 0, len(errs))
+	ruleIds := map[string]struct{}{}
+
+	for _, err := range errs {
+		level := sarifLevel[getLintLevel(err.Level)]
+
+		if _, ok := ruleIds[err.Id]; !ok {
+			ruleIds[err.Id] = struct{}{}
+			rules = append(rules, SarifRule{
+				Id:                   err.Id,
+				ShortDescription:     SarifMessage{Text: err.Id},
+				DefaultConfiguration: SarifRuleConfiguration{Level: level},
+			})
+		}
+
+		results = append(results, SarifResult{
+			RuleId:    err.Id,
+			Level:     level,
+			Message:   SarifMessage{Text: err.Text},
+			Locations: getSarifLocations(err.Source, false, 0, 0, 0, 0),
+		})
+	}
+
+	return printSarif(rules, results)
+}
+
+func (f SarifFormatter) SupportedOutputs() []Output {
+	return []Output{OutputBreaking, OutputChangelog, OutputLint}
+}
+
+func (f SarifFormatter) getRules() []SarifRule {
+	allRules := checker.GetAllRules()
+
+	result := make([]SarifRule, 0, len(allRules))
+	ruleIds := map[string]struct{}{}
+	for _, rule := range allRules {
+		if _, ok := ruleIds[rule.Id]; ok {
+			continue
+		}
+		ruleIds[rule.Id] = struct{}{}
+
+		result = append(result, SarifRule{
+			Id:                   rule.Id,
+			ShortDescription:     SarifMessage{Text: f.Localizer(rule.Description)},
+			DefaultConfiguration: SarifRuleConfiguration{Level: sarifLevel[rule.Level]},
+		})
+	}
+	return result
+}
+
+func (f SarifFormatter) getChangeResults(changes checker.Changes) []SarifResult {
+	result := make([]SarifResult, 0, len(changes))
+	for _, change := range changes {
+		result = append(result, SarifResult{
+			RuleId:  change.GetId(),
+			Level:   sarifLevel[change.GetLevel()],
+			Message: SarifMessage{Text: getSarifMessage(change, f.Localizer)},
+			Locations: getSarifLocations(
+				change.GetSourceFile(),
+				change.HasSourceLocation(),
+				change.GetSourceLine(),
+				change.GetSourceLineEnd(),
+				change.GetSourceColumn(),
+				change.GetSourceColumnEnd(),
+			),
+		})
+	}
+	return result
+}
+
+func getSarifMessage(change checker.Change, l checker.Localizer) string {
+	if change.GetPath() == "" {
+		return change.GetUncolorizedText(l)
+	}
+	return fmt.Sprintf("in API %s %s %s", change.GetOperation(), change.GetPath(), change.GetUncolorizedText(l))
+}
+
+// getSarifLocations converts zero-based source positions to a one-based SARIF location, with a region only if the positions are known
+func getSarifLocations(file string, hasRegion bool, line, lineEnd, column, columnEnd int) []SarifLocation {
+	if file == "" {
+		return nil
+	}
+
+	location := SarifLocation{
+		PhysicalLocation: SarifPhysicalLocation{
+			ArtifactLocation: SarifArtifactLocation{Uri: file},
+		},
+	}
+
+	if hasRegion {
+		location.PhysicalLocation.Region = &SarifRegion{
+			StartLine:   line + 1,
+			StartColumn: column + 1,
+		}
+		if lineEnd != 0 {
+			location.PhysicalLocation.Region.EndLine = lineEnd + 1
+		}
+		if columnEnd != 0 {
+			location.PhysicalLocation.Region.EndColumn = columnEnd + 1
+		}
+	}
+
+	return []SarifLocation{location}
+}
+
+func printSarif(rules []SarifRule, results []SarifResult) ([]byte, error) {
+	log := SarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs: []SarifRun{
+			{
+				Tool: SarifTool{
+					Driver: SarifDriver{
+						Name:           "oasdiff",
+						InformationUri: "https://github.com/Tufin/oasdiff",
+						Version:        build.Version,
+						Rules:          rules,
+					},
+				},
+				Results: results,
+			},
+		},
+	}
+
+	bytes, err := json.MarshalIndent(log, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SARIF: %w", err)
+	}
+
+	return bytes, nil
+}
@@ -0,0 +1,134 @@
+package formatters_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/formatters"
+	"github.com/tufin/oasdiff/lint"
+	"github.com/tufin/oasdiff/load"
+)
+
+var sarifFormatter = formatters.SarifFormatter{
+	Localizer: MockLocalizer,
+}
+
+func TestSarifLookup(t *testing.T) {
+	f, err := formatters.Lookup(string(formatters.FormatSarif), formatters.DefaultFormatterOpts())
+	require.NoError(t, err)
+	require.IsType(t, formatters.SarifFormatter{}, f)
+}
+
+func TestSarifFormatter_RenderBreakingChanges(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Id:           "change_id",
+			Level:        checker.ERR,
+			Operation:    http.MethodGet,
+			Path:         "/api/test",
+			Source:       load.NewSource("openapi.yaml"),
+			SourceFile:   "openapi.yaml",
+			SourceLine:   10,
+			SourceColumn: 4,
+		},
+	}
+
+	out, err := sarifFormatter.RenderBreakingChanges(testChanges, formatters.NewRenderOpts())
+	require.NoError(t, err)
+
+	var log formatters.SarifLog
+	require.NoError(t, json.Unmarshal(out, &log))
+	require.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1)
+	require.Len(t, log.Runs[0].Tool.Driver.Rules, len(checker.GetAllRules()))
+	require.Equal(t, []formatters.SarifResult{
+		{
+			RuleId:  "change_id",
+			Level:   "error",
+			Message: formatters.SarifMessage{Text: "in API GET /api/test This is a breaking change."},
+			Locations: []formatters.SarifLocation{
+				{
+					PhysicalLocation: formatters.SarifPhysicalLocation{
+						ArtifactLocation: formatters.SarifArtifactLocation{Uri: "openapi.yaml"},
+						Region:           &formatters.SarifRegion{StartLine: 11, StartColumn: 5},
+					},
+				},
+			},
+		},
+	}, log.Runs[0].Results)
+}
+
+func TestSarifFormatter_RenderBreakingChanges_FirstLine(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ComponentChange{
+			Id:         "change_id",
+			Level:      checker.ERR,
+			SourceFile: "openapi.yaml",
+		},
+	}
+
+	out, err := sarifFormatter.RenderBreakingChanges(testChanges, formatters.NewRenderOpts())
+	require.NoError(t, err)
+
+	var log formatters.SarifLog
+	require.NoError(t, json.Unmarshal(out, &log))
+	require.Len(t, log.Runs[0].Results[0].Locations, 1)
+	require.Equal(t, &formatters.SarifRegion{StartLine: 1, StartColumn: 1}, log.Runs[0].Results[0].Locations[0].PhysicalLocation.Region)
+}
+
+func TestSarifFormatter_RenderChangelog(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ComponentChange{
+			Id:    "change_id",
+			Level: checker.INFO,
+		},
+	}
+
+	out, err := sarifFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), nil)
+	require.NoError(t, err)
+
+	var log formatters.SarifLog
+	require.NoError(t, json.Unmarshal(out, &log))
+	require.Len(t, log.Runs[0].Results, 1)
+	require.Equal(t, "note", log.Runs[0].Results[0].Level)
+	require.Empty(t, log.Runs[0].Results[0].Locations)
+}
+
+func TestSarifFormatter_RenderLint(t *testing.T) {
+	errs := lint.Errors{
+		&lint.Error{
+			Id:     "info-missing",
+			Text:   "info is missing",
+			Level:  lint.LEVEL_ERROR,
+			Source: "openapi.yaml",
+		},
+	}
+
+	out, err := sarifFormatter.RenderLint(errs, formatters.NewRenderOpts())
+	require.NoError(t, err)
+
+	var log formatters.SarifLog
+	require.NoError(t, json.Unmarshal(out, &log))
+	require.Len(t, log.Runs[0].Tool.Driver.Rules, 1)
+	require.Equal(t, "info-missing", log.Runs[0].Tool.Driver.Rules[0].Id)
+	require.Len(t, log.Runs[0].Results, 1)
+	require.Equal(t, "openapi.yaml", log.Runs[0].Results[0].Locations[0].PhysicalLocation.ArtifactLocation.Uri)
+}
+
+func TestSarifFormatter_NotImplemented(t *testing.T) {
+	var err error
+	_, err = sarifFormatter.RenderDiff(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = sarifFormatter.RenderSummary(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = sarifFormatter.RenderChecks(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = sarifFormatter.RenderFlatten(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+}
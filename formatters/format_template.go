@@ -0,0 +1,72 @@
+package formatters
+
+import (
+	"bytes"
+	"errors"
+	htmltemplate "html/template"
+	"io"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/load"
+)
+
+// TemplateFormatter renders breaking changes and changelog with a user template, see TemplateData for the data model
+type TemplateFormatter struct {
+	notImplementedFormatter
+	Localizer checker.Localizer
+	Template  string // the path of the template file
+}
+
+func newTemplateFormatter(l checker.Localizer, template string) TemplateFormatter {
+	return TemplateFormatter{
+		Localizer: l,
+		Template:  template,
+	}
+}
+
+func (f TemplateFormatter) RenderBreakingChanges(changes checker.Changes, opts RenderOpts) ([]byte, error) {
+	return f.render(NewTemplateData(changes, f.Localizer, opts.SpecInfoPair, opts.Tags))
+}
+
+func (f TemplateFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, specInfoPair *load.SpecInfoPair) ([]byte, error) {
+	return f.render(NewTemplateData(changes, f.Localizer, specInfoPair, opts.Tags))
+}
+
+func (f TemplateFormatter) render(data TemplateData) ([]byte, error) {
+	tmpl, err := parseTemplate(f.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, data); err != nil {
+		return nil, err
+	}
+
+	return out.Bytes(), nil
+}
+
+func (f TemplateFormatter) SupportedOutputs() []Output {
+	return []Output{OutputBreaking, OutputChangelog}
+}
+
+type templateExecutor interface {
+	Execute(wr io.Writer, data any) error
+}
+
+// parseTemplate parses a template file with html/template if it has an .html or .htm extension, which escapes the changes for HTML, and with text/template otherwise
+func parseTemplate(path string) (templateExecutor, error) {
+	if path == "" {
+		return nil, errors.New("the template format requires a template file")
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".html", ".htm":
+		return htmltemplate.ParseFiles(path)
+	default:
+		return template.ParseFiles(path)
+	}
+}
@@ -0,0 +1,172 @@
+package formatters_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/formatters"
+	"github.com/tufin/oasdiff/load"
+)
+
+func writeTemplate(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+var templateChanges = checker.Changes{
+	checker.ApiChange{
+		Path:      "/test",
+		Operation: "GET",
+		Id:        "change_id",
+		Level:     checker.ERR,
+		Comment:   "notice_id",
+	},
+	checker.ComponentChange{
+		Id:        "warning_id",
+		Level:     checker.WARN,
+		Component: "schemas",
+	},
+	checker.SecurityChange{
+		Id:    "notice_id",
+		Level: checker.INFO,
+	},
+	checker.ServerChange{
+		Id:    "change_id",
+		Level: checker.ERR,
+	},
+}
+
+func TestTemplateLookup(t *testing.T) {
+	f, err := formatters.Lookup(string(formatters.FormatTemplate), formatters.FormatterOpts{Template: "release-notes.tmpl"})
+	require.NoError(t, err)
+	require.IsType(t, formatters.TemplateFormatter{}, f)
+	require.Equal(t, "release-notes.tmpl", f.(formatters.TemplateFormatter).Template)
+}
+
+func TestTemplateFormatter_RenderChangelog(t *testing.T) {
+	formatter := formatters.TemplateFormatter{
+		Localizer: MockLocalizer,
+		Template: writeTemplate(t, "changelog.tmpl",
+			"{{ .BaseVersion }}-{{ .RevisionVersion }}\n"+
+				"{{ range $ep, $changes := .APIChanges }}{{ $ep.Operation }} {{ $ep.Path }}: {{ range $changes }}{{ .Level }} {{ .Id }} {{ .Text }} {{ .Comment }}{{ end }}\n{{ end }}"+
+				"{{ range $component, $changes := .ComponentChanges }}{{ $component }}: {{ range $changes }}{{ .Text }}{{ end }}\n{{ end }}"+
+				"{{ range .SecurityChanges }}security: {{ .Text }}\n{{ end }}"+
+				"{{ range .ServerChanges }}servers: {{ .Text }}\n{{ end }}"+
+				"{{ len .Changes }}"),
+	}
+
+	specInfoPair := load.NewSpecInfoPair(&load.SpecInfo{Version: "1.0.0"}, &load.SpecInfo{Version: "1.1.0"})
+
+	out, err := formatter.RenderChangelog(templateChanges, formatters.NewRenderOpts(), specInfoPair)
+	require.NoError(t, err)
+	require.Equal(t, "1.0.0-1.1.0\nGET /test: error change_id This is a breaking change. This is a notice.\nschemas: This is a warning.\nsecurity: This is a notice.\nservers: This is a breaking change.\n4", string(out))
+}
+
+func TestTemplateFormatter_RenderBreakingChanges_HTML(t *testing.T) {
+	formatter := formatters.TemplateFormatter{
+		Localizer: MockLocalizer,
+		Template:  writeTemplate(t, "breaking.html", "<p>{{ .BaseVersion }}</p>{{ range .Changes }}<p>{{ .Text }}</p>{{ end }}"),
+	}
+
+	out, err := formatter.RenderBreakingChanges(checker.Changes{
+		checker.ApiChange{
+			Path:      "/test",
+			Operation: "GET",
+			Id:        "<script>",
+			Level:     checker.ERR,
+		},
+	}, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Equal(t, "<p>n/a</p><p>&lt;script&gt;</p>", string(out))
+}
+
+func TestTemplateFormatter_RenderBreakingChanges_Versions(t *testing.T) {
+	formatter := formatters.TemplateFormatter{
+		Localizer: MockLocalizer,
+		Template:  writeTemplate(t, "breaking.tmpl", "{{ .BaseVersion }}-{{ .RevisionVersion }}"),
+	}
+
+	specInfoPair := load.NewSpecInfoPair(&load.SpecInfo{Version: "1.0.0"}, &load.SpecInfo{Version: "1.1.0"})
+
+	out, err := formatter.RenderBreakingChanges(templateChanges, formatters.RenderOpts{SpecInfoPair: specInfoPair})
+	require.NoError(t, err)
+	require.Equal(t, "1.0.0-1.1.0", string(out))
+}
+
+func TestTemplateFormatter_RenderChangelog_Source(t *testing.T) {
+	formatter := formatters.TemplateFormatter{
+		Localizer: MockLocalizer,
+		Template:  writeTemplate(t, "source.tmpl", "{{ range .Changes }}[{{ .Source }}]{{ end }}"),
+	}
+
+	out, err := formatter.RenderChangelog(checker.Changes{
+		checker.ApiChange{
+			Path:      "/test",
+			Operation: "GET",
+			Id:        "change_id",
+			Level:     checker.ERR,
+			Source:    load.NewSource("openapi.yaml"),
+		},
+		checker.ApiChange{
+			Path:      "/test",
+			Operation: "POST",
+			Id:        "change_id",
+			Level:     checker.ERR,
+		},
+	}, formatters.NewRenderOpts(), nil)
+	require.NoError(t, err)
+	require.Equal(t, "[openapi.yaml][]", string(out))
+}
+
+func TestTemplateFormatter_RenderChangelog_GroupByTag(t *testing.T) {
+	formatter := formatters.TemplateFormatter{
+		Localizer: MockLocalizer,
+		Template:  writeTemplate(t, "tags.tmpl", "{{ range $tag, $endpoints := .TagChanges }}{{ $tag }}:{{ range $ep, $changes := $endpoints }} {{ $ep.Operation }} {{ $ep.Path }}{{ end }}{{ end }}"),
+	}
+
+	tags := formatters.OperationTags{{Path: "/test", Operation: "GET"}: {"test"}}
+
+	out, err := formatter.RenderChangelog(templateChanges, formatters.RenderOpts{Tags: tags}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "test: GET /test", string(out))
+}
+
+func TestTemplateFormatter_InvalidTemplate(t *testing.T) {
+	formatter := formatters.TemplateFormatter{
+		Localizer: MockLocalizer,
+		Template:  writeTemplate(t, "invalid.tmpl", "{{ .Changes "),
+	}
+
+	_, err := formatter.RenderChangelog(templateChanges, formatters.NewRenderOpts(), nil)
+	require.Error(t, err)
+}
+
+func TestTemplateFormatter_MissingTemplate(t *testing.T) {
+	formatter := formatters.TemplateFormatter{
+		Localizer: MockLocalizer,
+	}
+
+	_, err := formatter.RenderBreakingChanges(templateChanges, formatters.NewRenderOpts())
+	require.EqualError(t, err, "the template format requires a template file")
+}
+
+func TestTemplateFormatter_NotImplemented(t *testing.T) {
+	var err error
+
+	formatter := formatters.TemplateFormatter{}
+
+	_, err = formatter.RenderDiff(nil, formatters.NewRenderOpts())
+	assert.Error(t, err)
+
+	_, err = formatter.RenderSummary(nil, formatters.NewRenderOpts())
+	assert.Error(t, err)
+
+	_, err = formatter.RenderChecks(formatters.Checks{}, formatters.NewRenderOpts())
+	assert.Error(t, err)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/tufin/oasdiff/checker"
 	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/lint"
 	"github.com/tufin/oasdiff/load"
 	"github.com/tufin/oasdiff/report"
 )
@@ -47,6 +48,11 @@ func (f TEXTFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts,
 		_, _ = fmt.Fprint(result, getChangelogTitle(changes, f.Localizer, opts.ColorMode))
 	}
 
+	if opts.Tags != nil {
+		f.renderChangesByTag(result, changes, opts)
+		return result.Bytes(), nil
+	}
+
 	for _, c := range changes {
 		_, _ = fmt.Fprintf(result, "%s\n\n", c.MultiLineError(f.Localizer, opts.ColorMode))
 	}
@@ -54,6 +60,29 @@ func (f TEXTFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts,
 	return result.Bytes(), nil
 }
 
+// renderChangesByTag renders a section for each tag with the changes to its operations, followed by sections for the changes to components, security and servers
+func (f TEXTFormatter) renderChangesByTag(result *bytes.Buffer, changes checker.Changes, opts RenderOpts) {
+	changesBySection := splitChangesByTag(changes, opts.Tags)
+	for _, tag := range sortedKeys(changesBySection.tags) {
+		f.renderSection(result, fmt.Sprintf("tag '%s'", tag), changesBySection.tags[tag], opts)
+	}
+
+	f.renderSection(result, "components", changesBySection.components, opts)
+	f.renderSection(result, "security", changesBySection.security, opts)
+	f.renderSection(result, "servers", changesBySection.servers, opts)
+}
+
+func (f TEXTFormatter) renderSection(result *bytes.Buffer, title string, changes checker.Changes, opts RenderOpts) {
+	if len(changes) == 0 {
+		return
+	}
+
+	_, _ = fmt.Fprintf(result, "%s:\n\n", title)
+	for _, c := range changes {
+		_, _ = fmt.Fprintf(result, "%s\n\n", c.MultiLineError(f.Localizer, opts.ColorMode))
+	}
+}
+
 func (f TEXTFormatter) RenderChecks(checks Checks, opts RenderOpts) ([]byte, error) {
 	result := bytes.NewBuffer(nil)
 
@@ -67,6 +96,42 @@ func (f TEXTFormatter) RenderChecks(checks Checks, opts RenderOpts) ([]byte, err
 	return result.Bytes(), nil
 }
 
+func (f TEXTFormatter) RenderLint(errs lint.Errors, opts RenderOpts) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+
+	if len(errs) > 0 {
+		count := errs.GetLevelCount()
+		_, _ = fmt.Fprintf(result, "%d lint errors: %d %s, %d %s\n",
+			len(errs),
+			count[lint.LEVEL_ERROR],
+			getLintLevel(lint.LEVEL_ERROR).StringCond(opts.ColorMode),
+			count[lint.LEVEL_WARN],
+			getLintLevel(lint.LEVEL_WARN).StringCond(opts.ColorMode),
+		)
+	}
+
+	for _, err := range errs {
+		_, _ = fmt.Fprintf(result, "%s\t[%s] %s %s\t\n\t%s%s\n\n", getLintLevel(err.Level).StringCond(opts.ColorMode), err.Id, f.Localizer("at"), err.Source, err.Text, lintComment(err.Comment))
+	}
+
+	return result.Bytes(), nil
+}
+
+func lintComment(comment string) string {
+	if comment == "" {
+		return ""
+	}
+	return "\n\t\t" + comment
+}
+
+// getLintLevel maps a lint level to the equivalent checker level
+func getLintLevel(level int) checker.Level {
+	if level == lint.LEVEL_ERROR {
+		return checker.ERR
+	}
+	return checker.WARN
+}
+
 func (f TEXTFormatter) SupportedOutputs() []Output {
-	return []Output{OutputDiff, OutputBreaking, OutputChangelog, OutputChecks}
+	return []Output{OutputDiff, OutputBreaking, OutputChangelog, OutputChecks, OutputLint}
 }
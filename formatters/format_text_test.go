@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/tufin/oasdiff/checker"
 	"github.com/tufin/oasdiff/formatters"
+	"github.com/tufin/oasdiff/lint"
+	"github.com/tufin/oasdiff/load"
 )
 
 var textFormatter = formatters.TEXTFormatter{
@@ -77,3 +79,45 @@ func TestTextFormatter_NotImplemented(t *testing.T) {
 	_, err = textFormatter.RenderSummary(nil, formatters.NewRenderOpts())
 	assert.Error(t, err)
 }
+
+func TestTextFormatter_RenderLint(t *testing.T) {
+	errs := lint.Errors{
+		&lint.Error{
+			Id:     "info-missing",
+			Text:   "info is missing",
+			Level:  lint.LEVEL_ERROR,
+			Source: "openapi.yaml",
+		},
+	}
+
+	out, err := textFormatter.RenderLint(errs, formatters.RenderOpts{ColorMode: checker.ColorNever})
+	require.NoError(t, err)
+	require.Equal(t, "1 lint errors: 1 error, 0 warning\nerror\t[info-missing] at openapi.yaml\t\n\tinfo is missing\n\n", string(out))
+}
+
+func TestTextFormatter_RenderChangelog_GroupByTag(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Path:      "/test",
+			Operation: "GET",
+			Id:        "change_id",
+			Level:     checker.ERR,
+			Source:    load.NewSource(""),
+		},
+		checker.ComponentChange{
+			Id:        "notice_id",
+			Level:     checker.INFO,
+			Component: "schemas",
+		},
+		checker.ServerChange{
+			Id:    "warning_id",
+			Level: checker.WARN,
+		},
+	}
+
+	tags := formatters.OperationTags{{Path: "/test", Operation: "GET"}: {"test"}}
+
+	out, err := textFormatter.RenderChangelog(testChanges, formatters.RenderOpts{ColorMode: checker.ColorNever, Tags: tags}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "3 changes: 1 error, 1 warning, 1 info\ntag 'test':\n\nerror\t[change_id] at \t\n\tin API GET /test\n\t\tThis is a breaking change.\n\ncomponents:\n\ninfo\t[notice_id] \t\n\tin components/schemas\n\t\tThis is a notice.\n\nservers:\n\nwarning\t[warning_id] \t\n\tin servers\n\t\tThis is a warning.\n\n", string(out))
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/tufin/oasdiff/checker"
 	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/lint"
 	"github.com/tufin/oasdiff/load"
 	"gopkg.in/yaml.v3"
 )
@@ -34,6 +35,9 @@ func (f YAMLFormatter) RenderBreakingChanges(changes checker.Changes, opts Rende
 }
 
 func (f YAMLFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, specInfoPair *load.SpecInfoPair) ([]byte, error) {
+	if opts.Tags != nil {
+		return printYAML(NewChangesGroupedByTag(changes, f.Localizer, opts.Tags))
+	}
 	return printYAML(NewChanges(changes, f.Localizer))
 }
 
@@ -45,8 +49,12 @@ func (f YAMLFormatter) RenderFlatten(spec *openapi3.T, opts RenderOpts) ([]byte,
 	return printYAML(spec)
 }
 
+func (f YAMLFormatter) RenderLint(errs lint.Errors, opts RenderOpts) ([]byte, error) {
+	return printYAML(errs)
+}
+
 func (f YAMLFormatter) SupportedOutputs() []Output {
-	return []Output{OutputDiff, OutputSummary, OutputBreaking, OutputChangelog, OutputChecks, OutputFlatten}
+	return []Output{OutputDiff, OutputSummary, OutputBreaking, OutputChangelog, OutputChecks, OutputFlatten, OutputLint}
 }
 
 func printYAML(output interface{}) ([]byte, error) {
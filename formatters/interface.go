@@ -7,6 +7,7 @@ import (
 	"github.com/tufin/oasdiff/checker"
 	"github.com/tufin/oasdiff/checker/localizations"
 	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/lint"
 	"github.com/tufin/oasdiff/load"
 	"golang.org/x/exp/slices"
 )
@@ -19,6 +20,7 @@ type Formatter interface {
 	RenderChangelog(changes checker.Changes, opts RenderOpts, specInfoPair *load.SpecInfoPair) ([]byte, error)
 	RenderChecks(checks Checks, opts RenderOpts) ([]byte, error)
 	RenderFlatten(spec *openapi3.T, opts RenderOpts) ([]byte, error)
+	RenderLint(errs lint.Errors, opts RenderOpts) ([]byte, error)
 	SupportedOutputs() []Output
 }
 
@@ -30,6 +32,9 @@ var formatters = map[Format]Formatter{
 	FormatHTML:          HTMLFormatter{},
 	FormatGithubActions: GitHubActionsFormatter{},
 	FormatJUnit:         JUnitFormatter{},
+	FormatSarif:         SarifFormatter{},
+	FormatMarkdown:      MarkdownFormatter{},
+	FormatTemplate:      TemplateFormatter{},
 }
 
 // Lookup returns a formatter by its name
@@ -52,6 +57,12 @@ func Lookup(format string, opts FormatterOpts) (Formatter, error) {
 		return newGitHubActionsFormatter(l), nil
 	case FormatJUnit:
 		return newJUnitFormatter(l), nil
+	case FormatSarif:
+		return newSarifFormatter(l), nil
+	case FormatMarkdown:
+		return newMarkdownFormatter(l), nil
+	case FormatTemplate:
+		return newTemplateFormatter(l, opts.Template), nil
 	default:
 		return nil, fmt.Errorf("unsupported format: %s", f)
 	}
@@ -24,28 +24,46 @@ func TestDiffOutputFormats(t *testing.T) {
 
 func TestSummaryOutputFormats(t *testing.T) {
 	supportedFormats := formatters.SupportedFormatsByContentType(formatters.OutputSummary)
-	assert.Len(t, supportedFormats, 2)
+	assert.Len(t, supportedFormats, 3)
 	assert.Contains(t, supportedFormats, string(formatters.FormatYAML))
 	assert.Contains(t, supportedFormats, string(formatters.FormatJSON))
+	assert.Contains(t, supportedFormats, string(formatters.FormatMarkdown))
 }
 
 func TestChangelogOutputFormats(t *testing.T) {
 	supportedFormats := formatters.SupportedFormatsByContentType(formatters.OutputChangelog)
-	assert.Len(t, supportedFormats, 5)
+	assert.Len(t, supportedFormats, 8)
 	assert.Contains(t, supportedFormats, string(formatters.FormatYAML))
 	assert.Contains(t, supportedFormats, string(formatters.FormatJSON))
 	assert.Contains(t, supportedFormats, string(formatters.FormatText))
 	assert.Contains(t, supportedFormats, string(formatters.FormatSingleLine))
 	assert.Contains(t, supportedFormats, string(formatters.FormatHTML))
+	assert.Contains(t, supportedFormats, string(formatters.FormatSarif))
+	assert.Contains(t, supportedFormats, string(formatters.FormatMarkdown))
+	assert.Contains(t, supportedFormats, string(formatters.FormatTemplate))
 }
 
 func TestBreakingChangesOutputFormats(t *testing.T) {
 	supportedFormats := formatters.SupportedFormatsByContentType(formatters.OutputBreaking)
-	assert.Len(t, supportedFormats, 6)
+	assert.Len(t, supportedFormats, 9)
 	assert.Contains(t, supportedFormats, string(formatters.FormatYAML))
 	assert.Contains(t, supportedFormats, string(formatters.FormatJSON))
 	assert.Contains(t, supportedFormats, string(formatters.FormatText))
 	assert.Contains(t, supportedFormats, string(formatters.FormatSingleLine))
 	assert.Contains(t, supportedFormats, string(formatters.FormatGithubActions))
 	assert.Contains(t, supportedFormats, string(formatters.FormatJUnit))
+	assert.Contains(t, supportedFormats, string(formatters.FormatSarif))
+	assert.Contains(t, supportedFormats, string(formatters.FormatMarkdown))
+	assert.Contains(t, supportedFormats, string(formatters.FormatTemplate))
+}
+
+func TestLintOutputFormats(t *testing.T) {
+	supportedFormats := formatters.SupportedFormatsByContentType(formatters.OutputLint)
+	assert.Len(t, supportedFormats, 6)
+	assert.Contains(t, supportedFormats, string(formatters.FormatYAML))
+	assert.Contains(t, supportedFormats, string(formatters.FormatJSON))
+	assert.Contains(t, supportedFormats, string(formatters.FormatText))
+	assert.Contains(t, supportedFormats, string(formatters.FormatGithubActions))
+	assert.Contains(t, supportedFormats, string(formatters.FormatJUnit))
+	assert.Contains(t, supportedFormats, string(formatters.FormatSarif))
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/tufin/oasdiff/checker"
 	"github.com/tufin/oasdiff/diff"
+	"github.com/tufin/oasdiff/lint"
 	"github.com/tufin/oasdiff/load"
 )
 
@@ -35,6 +36,10 @@ func (f notImplementedFormatter) RenderFlatten(*openapi3.T, RenderOpts) ([]byte,
 	return notImplemented()
 }
 
+func (f notImplementedFormatter) RenderLint(lint.Errors, RenderOpts) ([]byte, error) {
+	return notImplemented()
+}
+
 func notImplemented() ([]byte, error) {
 	return nil, fmt.Errorf("not implemented")
 }
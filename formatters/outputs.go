@@ -9,4 +9,5 @@ const (
 	OutputChangelog
 	OutputChecks
 	OutputFlatten
+	OutputLint
 )
@@ -0,0 +1,36 @@
+package formatters
+
+import (
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/load"
+)
+
+// TemplateData is the data model passed to the changelog templates: the embedded HTML template and the user templates of the template format
+type TemplateData struct {
+	APIChanges       ChangesByEndpoint  // changes to the paths section, grouped by endpoint
+	TagChanges       ChangesByTag       // changes to the paths section, grouped by tag and endpoint, only when grouping by tags
+	ComponentChanges ChangesByComponent // changes to the components section, grouped by the type of the component
+	SecurityChanges  Changes            // changes to the security section
+	ServerChanges    Changes            // changes to the servers section
+	Changes          Changes            // all the changes, in the order of the report
+	BaseVersion      string             // the version of the base spec, or n/a if unknown
+	RevisionVersion  string             // the version of the revision spec, or n/a if unknown
+}
+
+func NewTemplateData(changes checker.Changes, l checker.Localizer, specInfoPair *load.SpecInfoPair, tags OperationTags) TemplateData {
+	data := TemplateData{
+		APIChanges:       GroupChanges(changes, l),
+		ComponentChanges: GroupComponentChanges(changes, l),
+		SecurityChanges:  GetSecurityChanges(changes, l),
+		ServerChanges:    GetServerChanges(changes, l),
+		Changes:          NewChanges(changes, l),
+		BaseVersion:      specInfoPair.GetBaseVersion(),
+		RevisionVersion:  specInfoPair.GetRevisionVersion(),
+	}
+
+	if tags != nil {
+		data.TagChanges = GroupChangesByTag(changes, l, tags)
+	}
+
+	return data
+}
@@ -1,6 +1,9 @@
 package formatters
 
-import "github.com/tufin/oasdiff/checker"
+import (
+	"github.com/tufin/oasdiff/checker"
+	"github.com/tufin/oasdiff/load"
+)
 
 type Format string
 
@@ -13,16 +16,23 @@ const (
 	FormatGithubActions Format = "githubactions"
 	FormatJUnit         Format = "junit"
 	FormatSarif         Format = "sarif"
+	FormatMarkdown      Format = "markdown"
+	FormatTemplate      Format = "template"
 )
 
 // FormatterOpts can be used to pass properties to the formatter (e.g. colors)
 type FormatterOpts struct {
 	Language string
+	Template string // the path of the template file of the template format
 }
 
 // RenderOpts can be used to pass properties to the renderer method
 type RenderOpts struct {
 	ColorMode checker.ColorMode
+	Tags      OperationTags // when set, the changelog is grouped by the tags of the operations
+
+	// SpecInfoPair is the base and revision specs of the breaking changes, used to show their versions, or nil if unknown
+	SpecInfoPair *load.SpecInfoPair
 }
 
 func NewRenderOpts() RenderOpts {
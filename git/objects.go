@@ -0,0 +1,176 @@
+package git
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Hash is the SHA-1 name of a git object
+type Hash [20]byte
+
+func (h Hash) String() string {
+	return hex.EncodeToString(h[:])
+}
+
+// NewHash parses a full hexadecimal object name
+func NewHash(s string) (Hash, error) {
+	var h Hash
+	if len(s) != 2*len(h) {
+		return h, fmt.Errorf("invalid object name %q", s)
+	}
+	if _, err := hex.Decode(h[:], []byte(s)); err != nil {
+		return h, fmt.Errorf("invalid object name %q", s)
+	}
+	return h, nil
+}
+
+type objectType int
+
+const (
+	objectCommit   objectType = 1
+	objectTree     objectType = 2
+	objectBlob     objectType = 3
+	objectTag      objectType = 4
+	objectOfsDelta objectType = 6
+	objectRefDelta objectType = 7
+)
+
+func parseObjectType(name string) (objectType, error) {
+	switch name {
+	case "commit":
+		return objectCommit, nil
+	case "tree":
+		return objectTree, nil
+	case "blob":
+		return objectBlob, nil
+	case "tag":
+		return objectTag, nil
+	}
+	return 0, fmt.Errorf("unknown object type %q", name)
+}
+
+type commit struct {
+	tree      Hash
+	parents   []Hash
+	timestamp int64
+}
+
+func (repo *Repo) readCommit(hash Hash) (*commit, error) {
+	hash, err := repo.peel(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	objType, data, err := repo.readObject(hash)
+	if err != nil {
+		return nil, err
+	}
+	if objType != objectCommit {
+		return nil, fmt.Errorf("object %s is not a commit", hash)
+	}
+
+	result := commit{}
+	for _, line := range headerLines(data) {
+		key, value, _ := strings.Cut(line, " ")
+		switch key {
+		case "tree":
+			if result.tree, err = NewHash(value); err != nil {
+				return nil, err
+			}
+		case "parent":
+			parent, err := NewHash(value)
+			if err != nil {
+				return nil, err
+			}
+			result.parents = append(result.parents, parent)
+		case "committer":
+			// committer Name <email> timestamp timezone
+			fields := strings.Fields(value)
+			if len(fields) >= 2 {
+				result.timestamp, _ = strconv.ParseInt(fields[len(fields)-2], 10, 64)
+			}
+		}
+	}
+
+	return &result, nil
+}
+
+func (repo *Repo) commitTree(hash Hash) (Hash, error) {
+	c, err := repo.readCommit(hash)
+	if err != nil {
+		return Hash{}, err
+	}
+	return c.tree, nil
+}
+
+// peel follows annotated tags to the object they point to
+func (repo *Repo) peel(hash Hash) (Hash, error) {
+	for i := 0; i < maxDepth; i++ {
+		objType, data, err := repo.readObject(hash)
+		if err != nil {
+			return Hash{}, err
+		}
+		if objType != objectTag {
+			return hash, nil
+		}
+
+		found := false
+		for _, line := range headerLines(data) {
+			if value, ok := strings.CutPrefix(line, "object "); ok {
+				if hash, err = NewHash(value); err != nil {
+					return Hash{}, err
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			return Hash{}, fmt.Errorf("invalid tag %s", hash)
+		}
+	}
+	return Hash{}, fmt.Errorf("too many levels of tags at %s", hash)
+}
+
+// headerLines returns the header lines of a commit or a tag, which precede the message
+func headerLines(data []byte) []string {
+	header, _, _ := bytes.Cut(data, []byte("\n\n"))
+	return strings.Split(string(header), "\n")
+}
+
+type treeEntry struct {
+	mode string
+	name string
+	hash Hash
+}
+
+func (repo *Repo) treeEntry(tree Hash, name string) (*treeEntry, error) {
+	objType, data, err := repo.readObject(tree)
+	if err != nil {
+		return nil, err
+	}
+	if objType != objectTree {
+		return nil, nil
+	}
+
+	// each entry is: mode SP name NUL hash
+	for len(data) > 0 {
+		header, rest, found := bytes.Cut(data, []byte{0})
+		if !found || len(rest) < len(Hash{}) {
+			return nil, fmt.Errorf("invalid tree %s", tree)
+		}
+
+		mode, entryName, _ := strings.Cut(string(header), " ")
+		entry := treeEntry{mode: mode, name: entryName}
+		copy(entry.hash[:], rest)
+		data = rest[len(Hash{}):]
+
+		if entry.name == name {
+			return &entry, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,272 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// pack is a pack file along with its version 2 index
+type pack struct {
+	file    string
+	names   []Hash
+	offsets []int64
+}
+
+const idxVersion2Magic = "\377tOc"
+
+func openPack(idxFile string) (*pack, error) {
+	data, err := os.ReadFile(idxFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < 8+256*4 || string(data[:4]) != idxVersion2Magic || binary.BigEndian.Uint32(data[4:8]) != 2 {
+		return nil, fmt.Errorf("unsupported pack index %s", idxFile)
+	}
+
+	fanout := data[8 : 8+256*4]
+	count := int(binary.BigEndian.Uint32(fanout[255*4:]))
+
+	namesStart := 8 + 256*4
+	crcStart := namesStart + count*len(Hash{})
+	offsetsStart := crcStart + count*4
+	largeOffsetsStart := offsetsStart + count*4
+	if len(data) < largeOffsetsStart {
+		return nil, fmt.Errorf("truncated pack index %s", idxFile)
+	}
+
+	result := pack{
+		file:    strings.TrimSuffix(idxFile, ".idx") + ".pack",
+		names:   make([]Hash, count),
+		offsets: make([]int64, count),
+	}
+
+	for i := 0; i < count; i++ {
+		copy(result.names[i][:], data[namesStart+i*len(Hash{}):])
+
+		offset := binary.BigEndian.Uint32(data[offsetsStart+i*4:])
+		if offset&0x80000000 == 0 {
+			result.offsets[i] = int64(offset)
+			continue
+		}
+
+		// large offsets are stored in a separate table
+		pos := largeOffsetsStart + int(offset&0x7fffffff)*8
+		if len(data) < pos+8 {
+			return nil, fmt.Errorf("truncated pack index %s", idxFile)
+		}
+		result.offsets[i] = int64(binary.BigEndian.Uint64(data[pos:]))
+	}
+
+	return &result, nil
+}
+
+// find returns the offset of an object in the pack
+func (p *pack) find(hash Hash) (int64, bool) {
+	i := sort.Search(len(p.names), func(i int) bool {
+		return bytes.Compare(p.names[i][:], hash[:]) >= 0
+	})
+	if i < len(p.names) && p.names[i] == hash {
+		return p.offsets[i], true
+	}
+	return 0, false
+}
+
+// findPrefix returns the names of all objects in the pack that start with the given hexadecimal prefix
+func (p *pack) findPrefix(prefix string) []Hash {
+	result := []Hash{}
+	for _, name := range p.names {
+		if strings.HasPrefix(name.String(), prefix) {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+func (p *pack) readObject(repo *Repo, offset int64) (objectType, []byte, error) {
+	file, err := os.Open(p.file)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer file.Close()
+
+	return p.readObjectAt(repo, file, offset, 0)
+}
+
+func (p *pack) readObjectAt(repo *Repo, file *os.File, offset int64, depth int) (objectType, []byte, error) {
+	if depth > maxDeltaDepth {
+		return 0, nil, fmt.Errorf("delta chain too long in %s", p.file)
+	}
+
+	reader := bufio.NewReader(io.NewSectionReader(file, offset, 1<<62))
+
+	// object header: 3 bits of type and a variable length size
+	b, err := reader.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	objType := objectType((b >> 4) & 7)
+	size := int64(b & 0x0f)
+	for shift := 4; b&0x80 != 0; shift += 7 {
+		if b, err = reader.ReadByte(); err != nil {
+			return 0, nil, err
+		}
+		size |= int64(b&0x7f) << shift
+	}
+
+	switch objType {
+	case objectCommit, objectTree, objectBlob, objectTag:
+		data, err := inflate(reader, size)
+		return objType, data, err
+
+	case objectOfsDelta:
+		// the base is stored at a negative offset, encoded with a variable length
+		b, err := reader.ReadByte()
+		if err != nil {
+			return 0, nil, err
+		}
+		distance := int64(b & 0x7f)
+		for b&0x80 != 0 {
+			if b, err = reader.ReadByte(); err != nil {
+				return 0, nil, err
+			}
+			distance = ((distance + 1) << 7) | int64(b&0x7f)
+		}
+
+		delta, err := inflate(reader, size)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		baseType, base, err := p.readObjectAt(repo, file, offset-distance, depth+1)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		data, err := applyDelta(base, delta)
+		return baseType, data, err
+
+	case objectRefDelta:
+		var baseHash Hash
+		if _, err := io.ReadFull(reader, baseHash[:]); err != nil {
+			return 0, nil, err
+		}
+
+		delta, err := inflate(reader, size)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		baseType, base, err := repo.readObject(baseHash)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		data, err := applyDelta(base, delta)
+		return baseType, data, err
+	}
+
+	return 0, nil, fmt.Errorf("unknown object type %d in %s", objType, p.file)
+}
+
+func inflate(reader io.Reader, size int64) ([]byte, error) {
+	zr, err := zlib.NewReader(reader)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(zr, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+var errInvalidDelta = errors.New("invalid delta")
+
+// applyDelta reconstructs an object from its base and a delta of copy and insert instructions
+func applyDelta(base, delta []byte) ([]byte, error) {
+	baseSize, delta, err := readDeltaSize(delta)
+	if err != nil {
+		return nil, err
+	}
+	if baseSize != len(base) {
+		return nil, errInvalidDelta
+	}
+
+	resultSize, delta, err := readDeltaSize(delta)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]byte, 0, resultSize)
+	for len(delta) > 0 {
+		op := delta[0]
+		delta = delta[1:]
+
+		if op&0x80 == 0 {
+			// insert the next op bytes
+			if op == 0 || int(op) > len(delta) {
+				return nil, errInvalidDelta
+			}
+			result = append(result, delta[:op]...)
+			delta = delta[op:]
+			continue
+		}
+
+		// copy from base: bits 0-3 select offset bytes and bits 4-6 select size bytes
+		offset, size := 0, 0
+		for i := 0; i < 4; i++ {
+			if op&(1<<i) != 0 {
+				if len(delta) == 0 {
+					return nil, errInvalidDelta
+				}
+				offset |= int(delta[0]) << (8 * i)
+				delta = delta[1:]
+			}
+		}
+		for i := 0; i < 3; i++ {
+			if op&(1<<(4+i)) != 0 {
+				if len(delta) == 0 {
+					return nil, errInvalidDelta
+				}
+				size |= int(delta[0]) << (8 * i)
+				delta = delta[1:]
+			}
+		}
+		if size == 0 {
+			size = 0x10000
+		}
+		if offset+size > len(base) {
+			return nil, errInvalidDelta
+		}
+		result = append(result, base[offset:offset+size]...)
+	}
+
+	if len(result) != resultSize {
+		return nil, errInvalidDelta
+	}
+
+	return result, nil
+}
+
+func readDeltaSize(delta []byte) (int, []byte, error) {
+	size, shift := 0, 0
+	for i, b := range delta {
+		size |= int(b&0x7f) << shift
+		shift += 7
+		if b&0x80 == 0 {
+			return size, delta[i+1:], nil
+		}
+	}
+	return 0, nil, errInvalidDelta
+}
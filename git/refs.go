@@ -0,0 +1,323 @@
+package git
+
+import (
+	"bufio"
+	"container/heap"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	maxDepth      = 10
+	maxDeltaDepth = 100
+	mergeBaseSep  = "..."
+)
+
+// ResolveRevision returns the commit named by a revision
+// Supported revisions are: HEAD, branches, tags, remote branches, full or abbreviated commit hashes,
+// any of these followed by ~N, ^ or ^N, and A...B for the merge base of A and B
+func (repo *Repo) ResolveRevision(revision string) (Hash, error) {
+	if left, right, found := strings.Cut(revision, mergeBaseSep); found {
+		return repo.resolveMergeBase(left, right)
+	}
+
+	name, suffixes := splitSuffixes(revision)
+	hash, err := repo.resolveName(name)
+	if err != nil {
+		return Hash{}, err
+	}
+
+	for _, suffix := range suffixes {
+		if hash, err = repo.applySuffix(hash, suffix); err != nil {
+			return Hash{}, fmt.Errorf("failed to resolve %q: %w", revision, err)
+		}
+	}
+
+	if hash, err = repo.peel(hash); err != nil {
+		return Hash{}, fmt.Errorf("failed to resolve %q: %w", revision, err)
+	}
+
+	if _, err := repo.readCommit(hash); err != nil {
+		return Hash{}, fmt.Errorf("failed to resolve %q: %w", revision, err)
+	}
+
+	return hash, nil
+}
+
+var (
+	reSuffixes = regexp.MustCompile(`([~^][0-9]*)+$`)
+	reSuffix   = regexp.MustCompile(`[~^][0-9]*`)
+)
+
+// splitSuffixes separates a revision like main~2^ into the name and the ancestry suffixes
+func splitSuffixes(revision string) (string, []string) {
+	loc := reSuffixes.FindStringIndex(revision)
+	if loc == nil || loc[0] == 0 {
+		return revision, nil
+	}
+
+	return revision[:loc[0]], reSuffix.FindAllString(revision[loc[0]:], -1)
+}
+
+func (repo *Repo) applySuffix(hash Hash, suffix string) (Hash, error) {
+	n := 1
+	if len(suffix) > 1 {
+		var err error
+		if n, err = strconv.Atoi(suffix[1:]); err != nil {
+			return Hash{}, err
+		}
+	}
+
+	if suffix[0] == '^' {
+		if n == 0 {
+			return repo.peel(hash)
+		}
+		c, err := repo.readCommit(hash)
+		if err != nil {
+			return Hash{}, err
+		}
+		if n > len(c.parents) {
+			return Hash{}, fmt.Errorf("commit %s has no parent number %d", hash, n)
+		}
+		return c.parents[n-1], nil
+	}
+
+	for i := 0; i < n; i++ {
+		c, err := repo.readCommit(hash)
+		if err != nil {
+			return Hash{}, err
+		}
+		if len(c.parents) == 0 {
+			return Hash{}, fmt.Errorf("commit %s has no parent", hash)
+		}
+		hash = c.parents[0]
+	}
+	return hash, nil
+}
+
+var reHex = regexp.MustCompile(`^[0-9a-fA-F]{4,40}$`)
+
+// resolveName resolves a ref name or an object name, in the same order as git
+func (repo *Repo) resolveName(name string) (Hash, error) {
+	if name == "" || name == "@" {
+		name = "HEAD"
+	}
+
+	if len(name) == 2*len(Hash{}) {
+		if hash, err := NewHash(strings.ToLower(name)); err == nil {
+			return hash, nil
+		}
+	}
+
+	for _, ref := range []string{
+		name,
+		"refs/" + name,
+		"refs/tags/" + name,
+		"refs/heads/" + name,
+		"refs/remotes/" + name,
+		"refs/remotes/" + name + "/HEAD",
+	} {
+		hash, err := repo.resolveRef(ref, 0)
+		if err == nil {
+			return hash, nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return Hash{}, err
+		}
+	}
+
+	if reHex.MatchString(name) {
+		return repo.resolvePrefix(strings.ToLower(name))
+	}
+
+	return Hash{}, fmt.Errorf("unknown revision %q", name)
+}
+
+// resolveRef reads a loose or a packed ref, following symbolic refs
+func (repo *Repo) resolveRef(ref string, depth int) (Hash, error) {
+	if depth > maxDepth {
+		return Hash{}, fmt.Errorf("too many levels of symbolic refs at %q", ref)
+	}
+
+	for _, dir := range repo.refDirs(ref) {
+		data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(ref)))
+		if err != nil {
+			continue
+		}
+
+		content := strings.TrimSpace(string(data))
+		if target, ok := strings.CutPrefix(content, "ref:"); ok {
+			return repo.resolveRef(strings.TrimSpace(target), depth+1)
+		}
+		return NewHash(content)
+	}
+
+	return repo.resolvePackedRef(ref)
+}
+
+// refDirs returns the directories in which a ref may be stored
+// HEAD and other pseudo refs are per worktree while refs/ are shared
+func (repo *Repo) refDirs(ref string) []string {
+	if repo.gitDir == repo.commonDir {
+		return []string{repo.gitDir}
+	}
+	if strings.HasPrefix(ref, "refs/") {
+		return []string{repo.commonDir}
+	}
+	return []string{repo.gitDir, repo.commonDir}
+}
+
+func (repo *Repo) resolvePackedRef(ref string) (Hash, error) {
+	file, err := os.Open(filepath.Join(repo.commonDir, "packed-refs"))
+	if err != nil {
+		return Hash{}, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || line[0] == '#' || line[0] == '^' {
+			continue
+		}
+		hash, name, found := strings.Cut(line, " ")
+		if found && name == ref {
+			return NewHash(hash)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Hash{}, err
+	}
+
+	return Hash{}, os.ErrNotExist
+}
+
+// resolvePrefix finds the object that starts with an abbreviated name
+func (repo *Repo) resolvePrefix(prefix string) (Hash, error) {
+	matches := map[Hash]struct{}{}
+
+	entries, _ := os.ReadDir(filepath.Join(repo.commonDir, "objects", prefix[:2]))
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), prefix[2:]) {
+			if hash, err := NewHash(prefix[:2] + entry.Name()); err == nil {
+				matches[hash] = struct{}{}
+			}
+		}
+	}
+
+	packs, err := repo.getPacks()
+	if err != nil {
+		return Hash{}, err
+	}
+	for _, p := range packs {
+		for _, hash := range p.findPrefix(prefix) {
+			matches[hash] = struct{}{}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return Hash{}, fmt.Errorf("unknown revision %q", prefix)
+	case 1:
+		for hash := range matches {
+			return hash, nil
+		}
+	}
+	return Hash{}, fmt.Errorf("ambiguous revision %q", prefix)
+}
+
+// resolveMergeBase finds the best common ancestor of two revisions
+// Like git, it walks the history of the second revision from the most recent commit and returns the first commit that is also reachable from the first revision
+func (repo *Repo) resolveMergeBase(left, right string) (Hash, error) {
+	leftHash, err := repo.ResolveRevision(left)
+	if err != nil {
+		return Hash{}, err
+	}
+	rightHash, err := repo.ResolveRevision(right)
+	if err != nil {
+		return Hash{}, err
+	}
+
+	ancestors, err := repo.ancestors(leftHash)
+	if err != nil {
+		return Hash{}, err
+	}
+
+	queue := &commitQueue{}
+	seen := map[Hash]bool{}
+	if err := repo.pushCommit(queue, seen, rightHash); err != nil {
+		return Hash{}, err
+	}
+
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(queuedCommit)
+		if ancestors[item.hash] {
+			return item.hash, nil
+		}
+		for _, parent := range item.parents {
+			if err := repo.pushCommit(queue, seen, parent); err != nil {
+				return Hash{}, err
+			}
+		}
+	}
+
+	return Hash{}, fmt.Errorf("no merge base found for %q and %q", left, right)
+}
+
+func (repo *Repo) ancestors(hash Hash) (map[Hash]bool, error) {
+	result := map[Hash]bool{}
+	stack := []Hash{hash}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if result[current] {
+			continue
+		}
+		result[current] = true
+
+		c, err := repo.readCommit(current)
+		if err != nil {
+			return nil, err
+		}
+		stack = append(stack, c.parents...)
+	}
+	return result, nil
+}
+
+func (repo *Repo) pushCommit(queue *commitQueue, seen map[Hash]bool, hash Hash) error {
+	if seen[hash] {
+		return nil
+	}
+	seen[hash] = true
+
+	c, err := repo.readCommit(hash)
+	if err != nil {
+		return err
+	}
+	heap.Push(queue, queuedCommit{hash: hash, commit: c})
+	return nil
+}
+
+type queuedCommit struct {
+	hash Hash
+	*commit
+}
+
+// commitQueue orders commits from the most recent to the oldest
+type commitQueue []queuedCommit
+
+func (q commitQueue) Len() int           { return len(q) }
+func (q commitQueue) Less(i, j int) bool { return q[i].timestamp > q[j].timestamp }
+func (q commitQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *commitQueue) Push(x any)        { *q = append(*q, x.(queuedCommit)) }
+func (q *commitQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,276 @@
+package git
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Repo is a read-only view of a local git repository
+// It reads the .git directory directly and doesn't require a git installation
+type Repo struct {
+	workTree  string
+	gitDir    string
+	commonDir string
+	packs     []*pack
+	packsRead bool
+}
+
+// Open finds the repository that contains dir, searching parent directories like git does
+func Open(dir string) (*Repo, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for current := dir; ; {
+		if repo, err := openWorkTree(current); err == nil {
+			return repo, nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+
+		parent := filepath.Dir(current)
+		if parent == current {
+			return nil, fmt.Errorf("not a git repository (or any of the parent directories): %s", dir)
+		}
+		current = parent
+	}
+}
+
+func openWorkTree(workTree string) (*Repo, error) {
+	dotGit := filepath.Join(workTree, ".git")
+	info, err := os.Stat(dotGit)
+	if err != nil {
+		return nil, err
+	}
+
+	gitDir := dotGit
+	if !info.IsDir() {
+		// worktrees and submodules use a .git file that points to the actual git directory
+		if gitDir, err = readGitDirFile(dotGit); err != nil {
+			return nil, err
+		}
+	}
+
+	commonDir := gitDir
+	if data, err := os.ReadFile(filepath.Join(gitDir, "commondir")); err == nil {
+		commonDir = resolvePath(gitDir, strings.TrimSpace(string(data)))
+	}
+
+	if err := checkObjectFormat(commonDir); err != nil {
+		return nil, err
+	}
+
+	return &Repo{
+		workTree:  workTree,
+		gitDir:    gitDir,
+		commonDir: commonDir,
+	}, nil
+}
+
+func readGitDirFile(file string) (string, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+
+	line := strings.TrimSpace(string(data))
+	if !strings.HasPrefix(line, "gitdir:") {
+		return "", fmt.Errorf("invalid gitdir file: %s", file)
+	}
+
+	return resolvePath(filepath.Dir(file), strings.TrimSpace(strings.TrimPrefix(line, "gitdir:"))), nil
+}
+
+func resolvePath(dir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
+
+func checkObjectFormat(commonDir string) error {
+	data, err := os.ReadFile(filepath.Join(commonDir, "config"))
+	if err != nil {
+		return nil
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		key, value, found := strings.Cut(strings.TrimSpace(line), "=")
+		if found && strings.EqualFold(strings.TrimSpace(key), "objectformat") && strings.TrimSpace(value) != "sha1" {
+			return fmt.Errorf("unsupported git object format %q", strings.TrimSpace(value))
+		}
+	}
+	return nil
+}
+
+// WorkTree returns the top-level directory of the repository
+func (repo *Repo) WorkTree() string {
+	return repo.workTree
+}
+
+// RelativePath converts a path in the file system to a path relative to the top-level directory of the repository
+func (repo *Repo) RelativePath(path string) (string, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	workTree, err := filepath.EvalSymlinks(repo.workTree)
+	if err != nil {
+		return "", err
+	}
+
+	if dir, err := filepath.EvalSymlinks(filepath.Dir(path)); err == nil {
+		path = filepath.Join(dir, filepath.Base(path))
+	}
+
+	rel, err := filepath.Rel(workTree, path)
+	if err != nil {
+		return "", err
+	}
+
+	if rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s is outside repository %s", path, repo.workTree)
+	}
+
+	return filepath.ToSlash(rel), nil
+}
+
+// ReadFile returns the contents of a file at the given revision
+// The path is relative to the top-level directory of the repository
+func (repo *Repo) ReadFile(revision Hash, path string) ([]byte, error) {
+	tree, err := repo.commitTree(revision)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := tree
+	for _, name := range splitPath(path) {
+		entry, err := repo.treeEntry(hash, name)
+		if err != nil {
+			return nil, err
+		}
+		if entry == nil {
+			return nil, fmt.Errorf("path %q does not exist in %s", path, revision)
+		}
+		hash = entry.hash
+	}
+
+	objType, data, err := repo.readObject(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if objType != objectBlob {
+		return nil, fmt.Errorf("path %q in %s is not a file", path, revision)
+	}
+
+	return data, nil
+}
+
+func splitPath(path string) []string {
+	result := []string{}
+	for _, name := range strings.Split(filepath.ToSlash(path), "/") {
+		switch name {
+		case "", ".":
+			continue
+		case "..":
+			if len(result) > 0 {
+				result = result[:len(result)-1]
+			}
+		default:
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// readObject returns the type and contents of an object from the loose object store or from a pack
+func (repo *Repo) readObject(hash Hash) (objectType, []byte, error) {
+	objType, data, err := repo.readLooseObject(hash)
+	if err == nil {
+		return objType, data, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return 0, nil, err
+	}
+
+	packs, err := repo.getPacks()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	for _, p := range packs {
+		if offset, ok := p.find(hash); ok {
+			return p.readObject(repo, offset)
+		}
+	}
+
+	return 0, nil, fmt.Errorf("object %s not found", hash)
+}
+
+func (repo *Repo) looseObjectPath(hash Hash) string {
+	s := hash.String()
+	return filepath.Join(repo.commonDir, "objects", s[:2], s[2:])
+}
+
+func (repo *Repo) readLooseObject(hash Hash) (objectType, []byte, error) {
+	file, err := os.Open(repo.looseObjectPath(hash))
+	if err != nil {
+		return 0, nil, err
+	}
+	defer file.Close()
+
+	reader, err := zlib.NewReader(file)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to read object %s: %w", hash, err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to read object %s: %w", hash, err)
+	}
+
+	header, content, found := bytes.Cut(data, []byte{0})
+	if !found {
+		return 0, nil, fmt.Errorf("invalid object %s", hash)
+	}
+
+	typeName, _, _ := strings.Cut(string(header), " ")
+	objType, err := parseObjectType(typeName)
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid object %s: %w", hash, err)
+	}
+
+	return objType, content, nil
+}
+
+func (repo *Repo) getPacks() ([]*pack, error) {
+	if repo.packsRead {
+		return repo.packs, nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(repo.commonDir, "objects", "pack", "*.idx"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		p, err := openPack(file)
+		if err != nil {
+			return nil, err
+		}
+		repo.packs = append(repo.packs, p)
+	}
+	repo.packsRead = true
+
+	return repo.packs, nil
+}
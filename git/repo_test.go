@@ -0,0 +1,143 @@
+package git_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/git"
+)
+
+// newTestRepo creates a repository with two commits on main and one commit on a feature branch
+func newTestRepo(t *testing.T) string {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+			"GIT_CONFIG_GLOBAL=/dev/null", "GIT_CONFIG_NOSYSTEM=1",
+		)
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	write := func(file, content string) {
+		path := filepath.Join(dir, file)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	run("init", "-q", "-b", "main")
+	write("api/openapi.yaml", "version: 1\n")
+	write("api/schemas/pet.yaml", "type: object\n")
+	run("add", "-A")
+	run("commit", "-q", "-m", "first", "--date", "2023-01-01T00:00:00Z")
+	run("tag", "-a", "v1", "-m", "version 1")
+
+	write("api/openapi.yaml", "version: 2\n")
+	run("commit", "-q", "-am", "second")
+
+	run("checkout", "-q", "-b", "feature", "main~1")
+	write("api/openapi.yaml", "version: 3\n")
+	run("commit", "-q", "-am", "third")
+	run("checkout", "-q", "main")
+
+	return dir
+}
+
+func readFile(t *testing.T, repo *git.Repo, revision, path string) string {
+	t.Helper()
+
+	hash, err := repo.ResolveRevision(revision)
+	require.NoError(t, err)
+	data, err := repo.ReadFile(hash, path)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestRepo_Loose(t *testing.T) {
+	dir := newTestRepo(t)
+	repo, err := git.Open(filepath.Join(dir, "api"))
+	require.NoError(t, err)
+
+	require.Equal(t, "version: 2\n", readFile(t, repo, "HEAD", "api/openapi.yaml"))
+	require.Equal(t, "version: 2\n", readFile(t, repo, "main", "api/openapi.yaml"))
+	require.Equal(t, "version: 1\n", readFile(t, repo, "main~1", "api/openapi.yaml"))
+	require.Equal(t, "version: 1\n", readFile(t, repo, "main^", "api/openapi.yaml"))
+	require.Equal(t, "version: 1\n", readFile(t, repo, "v1", "api/openapi.yaml"))
+	require.Equal(t, "version: 3\n", readFile(t, repo, "refs/heads/feature", "api/openapi.yaml"))
+	require.Equal(t, "version: 1\n", readFile(t, repo, "main...feature", "api/openapi.yaml"))
+	require.Equal(t, "type: object\n", readFile(t, repo, "main", "api/openapi.yaml/../schemas/pet.yaml"))
+}
+
+func TestRepo_Packed(t *testing.T) {
+	dir := newTestRepo(t)
+
+	cmd := exec.Command("git", "gc", "-q", "--aggressive")
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	repo, err := git.Open(dir)
+	require.NoError(t, err)
+
+	require.Equal(t, "version: 2\n", readFile(t, repo, "main", "api/openapi.yaml"))
+	require.Equal(t, "version: 1\n", readFile(t, repo, "v1", "api/openapi.yaml"))
+	require.Equal(t, "version: 3\n", readFile(t, repo, "feature", "api/openapi.yaml"))
+	require.Equal(t, "version: 1\n", readFile(t, repo, "main...feature", "api/openapi.yaml"))
+
+	hash, err := repo.ResolveRevision("main")
+	require.NoError(t, err)
+	require.Equal(t, "version: 2\n", readFile(t, repo, hash.String()[:8], "api/openapi.yaml"))
+}
+
+func TestRepo_NotARepo(t *testing.T) {
+	_, err := git.Open(t.TempDir())
+	require.ErrorContains(t, err, "not a git repository")
+}
+
+func TestRepo_UnknownRevision(t *testing.T) {
+	repo, err := git.Open(newTestRepo(t))
+	require.NoError(t, err)
+
+	_, err = repo.ResolveRevision("no-such-branch")
+	require.EqualError(t, err, `unknown revision "no-such-branch"`)
+}
+
+func TestRepo_UnknownPath(t *testing.T) {
+	repo, err := git.Open(newTestRepo(t))
+	require.NoError(t, err)
+
+	hash, err := repo.ResolveRevision("main")
+	require.NoError(t, err)
+
+	_, err = repo.ReadFile(hash, "api/missing.yaml")
+	require.ErrorContains(t, err, `path "api/missing.yaml" does not exist`)
+
+	_, err = repo.ReadFile(hash, "api")
+	require.ErrorContains(t, err, `path "api" in`)
+}
+
+func TestRepo_RelativePath(t *testing.T) {
+	dir := newTestRepo(t)
+	repo, err := git.Open(dir)
+	require.NoError(t, err)
+
+	path, err := repo.RelativePath(filepath.Join(dir, "api", "openapi.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, "api/openapi.yaml", path)
+
+	_, err = repo.RelativePath(t.TempDir())
+	require.ErrorContains(t, err, "outside repository")
+}
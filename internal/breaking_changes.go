@@ -9,6 +9,7 @@ import (
 	"github.com/tufin/oasdiff/checker/localizations"
 	"github.com/tufin/oasdiff/diff"
 	"github.com/tufin/oasdiff/formatters"
+	"github.com/tufin/oasdiff/load"
 )
 
 func getBreakingChangesCmd() *cobra.Command {
@@ -24,7 +25,9 @@ func getBreakingChangesCmd() *cobra.Command {
 	}
 
 	cmd.PersistentFlags().BoolVarP(&flags.composed, "composed", "c", false, "work in 'composed' mode, compare paths in all specs matching base and revision globs")
+	cmd.PersistentFlags().StringVarP(&flags.baseRef, "base-ref", "", "", "compare the revision spec file with the same file at this git revision, like main or main...HEAD for the merge base")
 	enumWithOptions(&cmd, newEnumValue(formatters.SupportedFormatsByContentType(formatters.OutputBreaking), string(formatters.FormatText), &flags.format), "format", "f", "output format")
+	cmd.PersistentFlags().StringVarP(&flags.template, "template", "", "", "render the output with this Go template file, using html/template for .html files and text/template otherwise (implies --format template)")
 	enumWithOptions(&cmd, newEnumSliceValue(diff.ExcludeDiffOptions, nil, &flags.excludeElements), "exclude-elements", "e", "comma-separated list of elements to exclude")
 	cmd.PersistentFlags().StringVarP(&flags.matchPath, "match-path", "p", "", "include only paths that match this regular expression")
 	cmd.PersistentFlags().StringVarP(&flags.filterExtension, "filter-extension", "", "", "exclude paths and operations with an OpenAPI Extension matching this regular expression")
@@ -39,7 +42,9 @@ func getBreakingChangesCmd() *cobra.Command {
 	enumWithOptions(&cmd, newEnumValue(localizations.GetSupportedLanguages(), localizations.LangDefault, &flags.lang), "lang", "l", "language for localized output")
 	cmd.PersistentFlags().StringVarP(&flags.errIgnoreFile, "err-ignore", "", "", "configuration file for ignoring errors")
 	cmd.PersistentFlags().StringVarP(&flags.warnIgnoreFile, "warn-ignore", "", "", "configuration file for ignoring warnings")
+	cmd.PersistentFlags().StringVarP(&flags.ignoreFile, "ignore", "", "", "structured YAML file with rules for ignoring changes by id, method, path, operationId, component and args")
 	cmd.PersistentFlags().VarP(newEnumSliceValue(checker.GetOptionalChecks(), nil, &flags.includeChecks), "include-checks", "i", "comma-separated list of optional checks (run 'oasdiff checks --required false' to see options)")
+	cmd.PersistentFlags().StringSliceVarP(&flags.levelOverrides, "level-overrides", "", nil, "comma-separated list of check levels to override, in the form id=LEVEL where LEVEL is ERR, WARN, INFO or OFF")
 	cmd.PersistentFlags().IntVarP(&flags.deprecationDaysBeta, "deprecation-days-beta", "", checker.BetaDeprecationDays, "min days required between deprecating a beta resource and removing it")
 	cmd.PersistentFlags().IntVarP(&flags.deprecationDaysStable, "deprecation-days-stable", "", checker.StableDeprecationDays, "min days required between deprecating a stable resource and removing it")
 	enumWithOptions(&cmd, newEnumValue([]string{"auto", "always", "never"}, "auto", &flags.color), "color", "", "when to colorize textual output")
@@ -47,14 +52,15 @@ func getBreakingChangesCmd() *cobra.Command {
 	return &cmd
 }
 
-func runBreakingChanges(flags Flags, stdout io.Writer) (bool, *ReturnError) {
-	return getChangelog(flags, stdout, checker.WARN)
+func runBreakingChanges(flags Flags, stdout io.Writer, stderr io.Writer) (bool, *ReturnError) {
+	return getChangelog(flags, stdout, stderr, checker.WARN)
 }
 
-func outputBreakingChanges(format string, lang string, color string, stdout io.Writer, errs checker.Changes) *ReturnError {
+func outputBreakingChanges(format string, lang string, color string, template string, stdout io.Writer, errs checker.Changes, specInfoPair *load.SpecInfoPair) *ReturnError {
 	// formatter lookup
 	formatter, err := formatters.Lookup(format, formatters.FormatterOpts{
 		Language: lang,
+		Template: template,
 	})
 	if err != nil {
 		return getErrUnsupportedBreakingChangesFormat(format)
@@ -66,7 +72,7 @@ func outputBreakingChanges(format string, lang string, color string, stdout io.W
 		return getErrInvalidColorMode(err)
 	}
 
-	bytes, err := formatter.RenderBreakingChanges(errs, formatters.RenderOpts{ColorMode: colorMode})
+	bytes, err := formatter.RenderBreakingChanges(errs, formatters.RenderOpts{ColorMode: colorMode, SpecInfoPair: specInfoPair})
 	if err != nil {
 		return getErrFailedPrint("breaking "+format, err)
 	}
@@ -3,6 +3,7 @@ package internal
 import (
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/spf13/cobra"
@@ -26,7 +27,10 @@ func getChangelogCmd() *cobra.Command {
 	}
 
 	cmd.PersistentFlags().BoolVarP(&flags.composed, "composed", "c", false, "work in 'composed' mode, compare paths in all specs matching base and revision globs")
+	cmd.PersistentFlags().StringVarP(&flags.baseRef, "base-ref", "", "", "compare the revision spec file with the same file at this git revision, like main or main...HEAD for the merge base")
 	enumWithOptions(&cmd, newEnumValue(formatters.SupportedFormatsByContentType(formatters.OutputChangelog), string(formatters.FormatText), &flags.format), "format", "f", "output format")
+	cmd.PersistentFlags().StringVarP(&flags.template, "template", "", "", "render the output with this Go template file, using html/template for .html files and text/template otherwise (implies --format template)")
+	cmd.PersistentFlags().BoolVarP(&flags.groupByTag, "group-by-tag", "", false, "group the changes by the tags of their operations, followed by the changes to components and security")
 	enumWithOptions(&cmd, newEnumSliceValue(diff.ExcludeDiffOptions, nil, &flags.excludeElements), "exclude-elements", "e", "comma-separated list of elements to exclude")
 	cmd.PersistentFlags().StringVarP(&flags.matchPath, "match-path", "p", "", "include only paths that match this regular expression")
 	cmd.PersistentFlags().StringVarP(&flags.filterExtension, "filter-extension", "", "", "exclude paths and operations with an OpenAPI Extension matching this regular expression")
@@ -40,7 +44,9 @@ func getChangelogCmd() *cobra.Command {
 	enumWithOptions(&cmd, newEnumValue(localizations.GetSupportedLanguages(), localizations.LangDefault, &flags.lang), "lang", "l", "language for localized output")
 	cmd.PersistentFlags().StringVarP(&flags.errIgnoreFile, "err-ignore", "", "", "configuration file for ignoring errors")
 	cmd.PersistentFlags().StringVarP(&flags.warnIgnoreFile, "warn-ignore", "", "", "configuration file for ignoring warnings")
+	cmd.PersistentFlags().StringVarP(&flags.ignoreFile, "ignore", "", "", "structured YAML file with rules for ignoring changes by id, method, path, operationId, component and args")
 	cmd.PersistentFlags().VarP(newEnumSliceValue(checker.GetOptionalChecks(), nil, &flags.includeChecks), "include-checks", "i", "comma-separated list of optional checks (run 'oasdiff checks --required false' to see options)")
+	cmd.PersistentFlags().StringSliceVarP(&flags.levelOverrides, "level-overrides", "", nil, "comma-separated list of check levels to override, in the form id=LEVEL where LEVEL is ERR, WARN, INFO or OFF")
 	cmd.PersistentFlags().IntVarP(&flags.deprecationDaysBeta, "deprecation-days-beta", "", checker.BetaDeprecationDays, "min days required between deprecating a beta resource and removing it")
 	cmd.PersistentFlags().IntVarP(&flags.deprecationDaysStable, "deprecation-days-stable", "", checker.StableDeprecationDays, "min days required between deprecating a stable resource and removing it")
 	enumWithOptions(&cmd, newEnumValue([]string{"auto", "always", "never"}, "auto", &flags.color), "color", "", "when to colorize textual output")
@@ -52,20 +58,26 @@ func enumWithOptions(cmd *cobra.Command, value enumVal, name, shorthand, usage s
 	cmd.PersistentFlags().VarP(value, name, shorthand, usage+": "+value.listOf())
 }
 
-func runChangelog(flags Flags, stdout io.Writer) (bool, *ReturnError) {
-	return getChangelog(flags, stdout, checker.INFO)
+func runChangelog(flags Flags, stdout io.Writer, stderr io.Writer) (bool, *ReturnError) {
+	return getChangelog(flags, stdout, stderr, checker.INFO)
 }
 
-func getChangelog(flags Flags, stdout io.Writer, level checker.Level) (bool, *ReturnError) {
+func getChangelog(flags Flags, stdout io.Writer, stderr io.Writer, level checker.Level) (bool, *ReturnError) {
 
 	openapi3.CircularReferenceCounter = flags.getCircularReferenceCounter()
 
-	diffResult, err := calcDiff(flags)
+	levelOverrides, err := checker.ParseLevelOverrides(flags.getLevelOverrides())
 	if err != nil {
-		return false, err
+		return false, getErrInvalidFlags(err)
 	}
 
-	bcConfig := checker.GetAllChecks(flags.getIncludeChecks(), flags.getDeprecationDaysBeta(), flags.getDeprecationDaysStable())
+	diffResult, returnErr := calcDiff(flags)
+	if returnErr != nil {
+		return false, returnErr
+	}
+
+	bcConfig := checker.GetAllChecks(flags.getIncludeChecks(), flags.getDeprecationDaysBeta(), flags.getDeprecationDaysStable()).WithLevelOverrides(levelOverrides)
+	bcConfig.Locations = diffResult.locations
 
 	errs, returnErr := filterIgnored(
 		checker.CheckBackwardCompatibilityUntilLevel(bcConfig, diffResult.diffReport, diffResult.operationsSources, level),
@@ -77,14 +89,22 @@ func getChangelog(flags Flags, stdout io.Writer, level checker.Level) (bool, *Re
 		return false, returnErr
 	}
 
+	if errs, returnErr = applyIgnoreRules(errs, flags.getIgnoreFile(), stderr); returnErr != nil {
+		return false, returnErr
+	}
+
 	if level == checker.WARN {
 		// breaking changes
-		if returnErr := outputBreakingChanges(flags.getFormat(), flags.getLang(), flags.getColor(), stdout, errs); returnErr != nil {
+		if returnErr := outputBreakingChanges(flags.getFormat(), flags.getLang(), flags.getColor(), flags.getTemplate(), stdout, errs, diffResult.specInfoPair); returnErr != nil {
 			return false, returnErr
 		}
 	} else {
 		// changelog
-		if returnErr := outputChangelog(flags.getFormat(), flags.getLang(), flags.getColor(), stdout, errs, diffResult.specInfoPair); returnErr != nil {
+		var tags formatters.OperationTags
+		if flags.getGroupByTag() {
+			tags = formatters.GetOperationTags(diffResult.diffReport)
+		}
+		if returnErr := outputChangelog(flags.getFormat(), flags.getLang(), flags.getColor(), flags.getTemplate(), stdout, errs, diffResult.specInfoPair, tags); returnErr != nil {
 			return false, returnErr
 		}
 	}
@@ -121,10 +141,30 @@ func filterIgnored(errs checker.Changes, warnIgnoreFile string, errIgnoreFile st
 	return errs, nil
 }
 
-func outputChangelog(format string, lang string, color string, stdout io.Writer, errs checker.Changes, specInfoPair *load.SpecInfoPair) *ReturnError {
+// applyIgnoreRules removes the changes that match the rules in a structured ignore file, and warns about expired rules
+func applyIgnoreRules(errs checker.Changes, ignoreFile string, stderr io.Writer) (checker.Changes, *ReturnError) {
+	if ignoreFile == "" {
+		return errs, nil
+	}
+
+	rules, err := checker.LoadIgnoreRules(ignoreFile)
+	if err != nil {
+		return nil, getErrCantProcessIgnoreFile("structured", err)
+	}
+
+	errs, expired := rules.Apply(errs, time.Now())
+	for _, rule := range expired {
+		_, _ = fmt.Fprintf(stderr, "Warning: expired rule in %s no longer applies, remove or renew it: %s\n", ignoreFile, rule)
+	}
+
+	return errs, nil
+}
+
+func outputChangelog(format string, lang string, color string, template string, stdout io.Writer, errs checker.Changes, specInfoPair *load.SpecInfoPair, tags formatters.OperationTags) *ReturnError {
 	// formatter lookup
 	formatter, err := formatters.Lookup(format, formatters.FormatterOpts{
 		Language: lang,
+		Template: template,
 	})
 	if err != nil {
 		return getErrUnsupportedChangelogFormat(format)
@@ -136,7 +176,7 @@ func outputChangelog(format string, lang string, color string, stdout io.Writer,
 		return getErrInvalidColorMode(err)
 	}
 
-	bytes, err := formatter.RenderChangelog(errs, formatters.RenderOpts{ColorMode: colorMode}, specInfoPair)
+	bytes, err := formatter.RenderChangelog(errs, formatters.RenderOpts{ColorMode: colorMode, Tags: tags}, specInfoPair)
 	if err != nil {
 		return getErrFailedPrint("changelog "+format, err)
 	}
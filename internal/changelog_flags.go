@@ -8,6 +8,7 @@ import (
 type ChangelogFlags struct {
 	base                     *load.Source
 	revision                 *load.Source
+	baseRef                  string
 	composed                 bool
 	prefixBase               string
 	prefixRevision           string
@@ -20,14 +21,18 @@ type ChangelogFlags struct {
 	includePathParams        bool
 	excludeElements          []string
 	includeChecks            []string
+	levelOverrides           []string
 	failOn                   string
 	flatten                  bool
 	lang                     string
 	errIgnoreFile            string
 	warnIgnoreFile           string
+	ignoreFile               string
 	deprecationDaysBeta      int
 	deprecationDaysStable    int
 	color                    string
+	template                 string
+	groupByTag               bool
 }
 
 func (flags *ChangelogFlags) toConfig() *diff.Config {
@@ -51,6 +56,10 @@ func (flags *ChangelogFlags) getBase() *load.Source {
 	return flags.base
 }
 
+func (flags *ChangelogFlags) getBaseRef() string {
+	return flags.baseRef
+}
+
 func (flags *ChangelogFlags) getRevision() *load.Source {
 	return flags.revision
 }
@@ -67,6 +76,10 @@ func (flags *ChangelogFlags) getIncludeChecks() []string {
 	return flags.includeChecks
 }
 
+func (flags *ChangelogFlags) getLevelOverrides() []string {
+	return flags.levelOverrides
+}
+
 func (flags *ChangelogFlags) getDeprecationDaysBeta() int {
 	return flags.deprecationDaysBeta
 }
@@ -91,10 +104,22 @@ func (flags *ChangelogFlags) getErrIgnoreFile() string {
 	return flags.errIgnoreFile
 }
 
+func (flags *ChangelogFlags) getIgnoreFile() string {
+	return flags.ignoreFile
+}
+
 func (flags *ChangelogFlags) getFormat() string {
 	return flags.format
 }
 
+func (flags *ChangelogFlags) getTemplate() string {
+	return flags.template
+}
+
+func (flags *ChangelogFlags) getGroupByTag() bool {
+	return flags.groupByTag
+}
+
 func (flags *ChangelogFlags) getFailOn() string {
 	return flags.failOn
 }
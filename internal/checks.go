@@ -14,11 +14,12 @@ import (
 )
 
 type ChecksFlags struct {
-	lang     string
-	format   string
-	severity []string
-	tags     []string
-	required string
+	lang           string
+	format         string
+	severity       []string
+	tags           []string
+	required       string
+	levelOverrides []string
 }
 
 func getChecksCmd() *cobra.Command {
@@ -48,6 +49,7 @@ func getChecksCmd() *cobra.Command {
 	enumWithOptions(&cmd, newEnumSliceValue([]string{"info", "warn", "error"}, nil, &flags.severity), "severity", "s", "list of severities to include (experimental)")
 	cmd.PersistentFlags().StringSliceVarP(&flags.tags, "tags", "t", []string{}, "list of tags to include, eg. parameter, request (experimental)")
 	enumWithOptions(&cmd, newEnumValue([]string{"true", "false", "all"}, "all", &flags.required), "required", "r", "filter by required / optional")
+	cmd.PersistentFlags().StringSliceVarP(&flags.levelOverrides, "level-overrides", "", nil, "comma-separated list of check levels to override, in the form id=LEVEL where LEVEL is ERR, WARN, INFO or OFF")
 
 	return &cmd
 }
@@ -78,9 +80,19 @@ func outputChecks(stdout io.Writer, flags ChecksFlags, rules []checker.BackwardC
 		return getErrUnsupportedChecksFormat(flags.format)
 	}
 
+	levelOverrides, err := checker.ParseLevelOverrides(flags.levelOverrides)
+	if err != nil {
+		return getErrInvalidFlags(err)
+	}
+
 	// filter rules
 	checks := make(formatters.Checks, 0, len(rules))
 	for _, rule := range rules {
+		// effective level
+		if level, ok := levelOverrides[rule.Id]; ok {
+			rule.Level = level
+		}
+
 		// severity
 		if len(flags.severity) > 0 {
 			if rule.Level == checker.ERR && !slices.Contains(flags.severity, "error") {
@@ -0,0 +1,255 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	configFlag        = "config"
+	defaultConfigFile = ".oasdiff.yaml"
+
+	// configAnnotation marks flags that were set by the config rather than passed on the command-line
+	configAnnotation = "oasdiff_config"
+)
+
+// configFileFlags are the flags whose values are paths to files, which are relative to the directory of the config file
+var configFileFlags = map[string]bool{
+	"err-ignore":  true,
+	"warn-ignore": true,
+	"ignore":      true,
+	"template":    true,
+}
+
+// Config is the project-level configuration file
+// It sets the values of command-line flags, for all commands or for specific commands under "commands"
+// Flags that are passed explicitly on the command-line take precedence over the config
+type Config struct {
+	Flags    map[string]any            `yaml:",inline"`
+	Commands map[string]map[string]any `yaml:"commands"`
+
+	// dir is the directory of the config file
+	dir string
+}
+
+func addConfigFlag(rootCmd *cobra.Command) {
+	rootCmd.PersistentFlags().String(configFlag, "", "path to a config file with default values for flags (default is "+defaultConfigFile+" in the current directory, if it exists)")
+
+	for _, cmd := range rootCmd.Commands() {
+		cmd.Args = withConfig(cmd.Args)
+	}
+}
+
+// withConfig applies the config to the command's flags before validating the arguments, so that the validation sees the final flag values
+func withConfig(args cobra.PositionalArgs) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, a []string) error {
+		if err := applyConfig(cmd); err != nil {
+			cmd.Root().SilenceUsage = true
+			setReturnValue(cmd, err.Code)
+			return err
+		}
+		if args == nil {
+			return nil
+		}
+		return args(cmd, a)
+	}
+}
+
+func applyConfig(cmd *cobra.Command) *ReturnError {
+	path, _ := cmd.Flags().GetString(configFlag)
+
+	config, err := readConfig(path)
+	if err != nil {
+		return getErrInvalidConfig(err)
+	}
+	if config == nil {
+		return nil
+	}
+
+	if err := config.validate(cmd.Root()); err != nil {
+		return getErrInvalidConfig(err)
+	}
+
+	if err := config.setFlags(cmd); err != nil {
+		return getErrInvalidConfig(err)
+	}
+
+	return nil
+}
+
+// getFlags returns the flags for a command, where command-specific values override the global ones
+func (config *Config) getFlags(commandName string) map[string]any {
+	result := map[string]any{}
+	for name, value := range config.Flags {
+		result[name] = value
+	}
+	for name, value := range config.Commands[commandName] {
+		result[name] = value
+	}
+	return result
+}
+
+// readConfig reads the given config file, or the default config file if it exists
+func readConfig(path string) (*Config, error) {
+	if path == "" {
+		if _, err := os.Stat(defaultConfigFile); errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		path = defaultConfigFile
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	config := Config{}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	config.dir = filepath.Dir(path)
+
+	return &config, nil
+}
+
+// validate checks that the config only contains flags and commands that exist, to catch typos
+func (config *Config) validate(rootCmd *cobra.Command) error {
+	commands := map[string]*cobra.Command{}
+	for _, cmd := range rootCmd.Commands() {
+		commands[cmd.Name()] = cmd
+	}
+
+	for _, name := range sortedKeys(config.Flags) {
+		if name == configFlag {
+			return fmt.Errorf("%q can't be set in the config", configFlag)
+		}
+		found := false
+		for _, cmd := range commands {
+			if lookupFlag(cmd, name) != nil {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("unknown flag %q", name)
+		}
+	}
+
+	for _, commandName := range sortedKeys(config.Commands) {
+		cmd, ok := commands[commandName]
+		if !ok {
+			return fmt.Errorf("unknown command %q", commandName)
+		}
+		for _, name := range sortedKeys(config.Commands[commandName]) {
+			if name == configFlag || lookupFlag(cmd, name) == nil {
+				return fmt.Errorf("unknown flag %q for command %q", name, commandName)
+			}
+		}
+	}
+
+	return nil
+}
+
+func lookupFlag(cmd *cobra.Command, name string) *pflag.Flag {
+	if flag := cmd.Flags().Lookup(name); flag != nil {
+		return flag
+	}
+	return cmd.PersistentFlags().Lookup(name)
+}
+
+// isGlobal returns true if the value of a flag for a command comes from the top level of the config rather than from the command's section
+func (config *Config) isGlobal(commandName, name string) bool {
+	_, ok := config.Commands[commandName][name]
+	return !ok
+}
+
+// setFlags sets the flags of the command that weren't passed on the command-line
+// The flags are set through the flag set, like flags on the command-line, so that they are validated in the same way
+// Top-level values that the command rejects, like a format that only other commands support, are skipped with a warning
+// Relative file paths are resolved against the directory of the config file
+func (config *Config) setFlags(cmd *cobra.Command) error {
+	values := config.getFlags(cmd.Name())
+	for _, name := range sortedKeys(values) {
+		flag := lookupFlag(cmd, name)
+		if flag == nil || flag.Changed {
+			continue
+		}
+
+		value, err := configValueToString(values[name])
+		if err != nil {
+			return fmt.Errorf("invalid value for %q: %w", name, err)
+		}
+
+		if configFileFlags[name] && value != "" && !filepath.IsAbs(value) {
+			value = filepath.Join(config.dir, value)
+		}
+
+		if err := cmd.Flags().Set(name, value); err != nil {
+			if config.isGlobal(cmd.Name(), name) {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Warning: ignoring the config value of %q for the %q command: %v\n", name, cmd.Name(), err)
+				continue
+			}
+			return fmt.Errorf("invalid value for %q: %w", name, err)
+		}
+		if err := cmd.Flags().SetAnnotation(name, configAnnotation, []string{"true"}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isSetByConfig returns true if the flag was set by the config rather than passed on the command-line
+func isSetByConfig(cmd *cobra.Command, name string) bool {
+	flag := cmd.Flags().Lookup(name)
+	return flag != nil && flag.Annotations[configAnnotation] != nil
+}
+
+// configValueToString converts a YAML value to the string form of a command-line flag
+// Lists become comma-separated values and maps become comma-separated key=value pairs
+func configValueToString(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case []any:
+		items := make([]string, len(v))
+		for i, item := range v {
+			s, err := configValueToString(item)
+			if err != nil {
+				return "", err
+			}
+			items[i] = s
+		}
+		return writeAsCSV(items)
+	case map[string]any:
+		items := make([]string, 0, len(v))
+		for _, key := range sortedKeys(v) {
+			s, err := configValueToString(v[key])
+			if err != nil {
+				return "", err
+			}
+			items = append(items, key+"="+s)
+		}
+		return writeAsCSV(items)
+	case string:
+		return v, nil
+	case bool, int, float64:
+		return fmt.Sprint(v), nil
+	}
+	return "", fmt.Errorf("unsupported type %T", value)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
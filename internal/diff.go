@@ -25,6 +25,7 @@ func getDiffCmd() *cobra.Command {
 	}
 
 	cmd.PersistentFlags().BoolVarP(&flags.composed, "composed", "c", false, "work in 'composed' mode, compare paths in all specs matching base and revision globs")
+	cmd.PersistentFlags().StringVarP(&flags.baseRef, "base-ref", "", "", "compare the revision spec file with the same file at this git revision, like main or main...HEAD for the merge base")
 	enumWithOptions(&cmd, newEnumValue(formatters.SupportedFormatsByContentType(formatters.OutputDiff), string(formatters.FormatYAML), &flags.format), "format", "f", "output format")
 	enumWithOptions(&cmd, newEnumSliceValue(diff.ExcludeDiffOptions, nil, &flags.excludeElements), "exclude-elements", "e", "comma-separated list of elements to exclude")
 	cmd.PersistentFlags().StringVarP(&flags.matchPath, "match-path", "p", "", "include only paths that match this regular expression")
@@ -41,7 +42,7 @@ func getDiffCmd() *cobra.Command {
 	return &cmd
 }
 
-func runDiff(flags Flags, stdout io.Writer) (bool, *ReturnError) {
+func runDiff(flags Flags, stdout io.Writer, _ io.Writer) (bool, *ReturnError) {
 
 	openapi3.CircularReferenceCounter = flags.getCircularReferenceCounter()
 
@@ -96,13 +97,15 @@ type diffResult struct {
 	diffReport        *diff.Diff
 	operationsSources *diff.OperationsSourcesMap
 	specInfoPair      *load.SpecInfoPair
+	locations         load.Locations
 }
 
-func newDiffResult(d *diff.Diff, o *diff.OperationsSourcesMap, s *load.SpecInfoPair) *diffResult {
+func newDiffResult(d *diff.Diff, o *diff.OperationsSourcesMap, s *load.SpecInfoPair, l load.Locations) *diffResult {
 	return &diffResult{
 		diffReport:        d,
 		operationsSources: o,
 		specInfoPair:      s,
+		locations:         l,
 	}
 }
 
@@ -137,7 +140,7 @@ func normalDiff(loader load.Loader, flags Flags) (*diffResult, *ReturnError) {
 		return nil, getErrDiffFailed(err)
 	}
 
-	return newDiffResult(diffReport, operationsSources, load.NewSpecInfoPair(s1, s2)), nil
+	return newDiffResult(diffReport, operationsSources, load.NewSpecInfoPair(s1, s2), load.GetLocations(s1, s2)), nil
 }
 
 func composedDiff(loader load.Loader, flags Flags) (*diffResult, *ReturnError) {
@@ -166,7 +169,7 @@ func composedDiff(loader load.Loader, flags Flags) (*diffResult, *ReturnError) {
 		return nil, getErrDiffFailed(err)
 	}
 
-	return newDiffResult(diffReport, operationsSources, nil), nil
+	return newDiffResult(diffReport, operationsSources, nil, load.GetLocations(s1...).Merge(load.GetLocations(s2...))), nil
 }
 
 func mergeAllOf(title string, specInfos []*load.SpecInfo, source *load.Source) *ReturnError {
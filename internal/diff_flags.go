@@ -8,6 +8,7 @@ import (
 type DiffFlags struct {
 	base                     *load.Source
 	revision                 *load.Source
+	baseRef                  string
 	composed                 bool
 	prefixBase               string
 	prefixRevision           string
@@ -44,6 +45,10 @@ func (flags *DiffFlags) getBase() *load.Source {
 	return flags.base
 }
 
+func (flags *DiffFlags) getBaseRef() string {
+	return flags.baseRef
+}
+
 func (flags *DiffFlags) getRevision() *load.Source {
 	return flags.revision
 }
@@ -60,6 +65,10 @@ func (flags *DiffFlags) getIncludeChecks() []string {
 	return nil
 }
 
+func (flags *DiffFlags) getLevelOverrides() []string {
+	return nil
+}
+
 func (flags *DiffFlags) getDeprecationDaysBeta() int {
 	return 0
 }
@@ -84,10 +93,22 @@ func (flags *DiffFlags) getErrIgnoreFile() string {
 	return ""
 }
 
+func (flags *DiffFlags) getIgnoreFile() string {
+	return ""
+}
+
 func (flags *DiffFlags) getFormat() string {
 	return flags.format
 }
 
+func (flags *DiffFlags) getTemplate() string {
+	return ""
+}
+
+func (flags *DiffFlags) getGroupByTag() bool {
+	return false
+}
+
 func (flags *DiffFlags) getFailOn() string {
 	return ""
 }
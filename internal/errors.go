@@ -25,6 +25,13 @@ func getErrFailedToLoadSpec(what string, source *load.Source, err error) *Return
 	}
 }
 
+func getErrInvalidBaseRef(baseRef string, err error) *ReturnError {
+	return &ReturnError{
+		error: fmt.Errorf("failed to load base spec from git revision %q with %v", baseRef, err),
+		Code:  102,
+	}
+}
+
 func getErrFailedToFlattenSpec(what string, source *load.Source, err error) *ReturnError {
 	return &ReturnError{
 		error: fmt.Errorf("failed to flatten %s spec from %s with %v", what, source.Out(), err),
@@ -88,6 +95,20 @@ func getErrUnsupportedChecksFormat(format string) *ReturnError {
 	}
 }
 
+func getErrUnsupportedLintFormat(format string) *ReturnError {
+	return &ReturnError{
+		error: fmt.Errorf("format %q is not supported with \"lint\"", format),
+		Code:  115,
+	}
+}
+
+func getErrInvalidConfig(err error) *ReturnError {
+	return &ReturnError{
+		error: fmt.Errorf("invalid config: %v", err),
+		Code:  116,
+	}
+}
+
 func getErrInvalidColorMode(err error) *ReturnError {
 	return &ReturnError{
 		error: err,
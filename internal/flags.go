@@ -11,16 +11,21 @@ type Flags interface {
 	getComposed() bool
 	getBase() *load.Source
 	getRevision() *load.Source
+	getBaseRef() string
 	getFlatten() bool
 	getCircularReferenceCounter() int
 	getIncludeChecks() []string
+	getLevelOverrides() []string
 	getDeprecationDaysBeta() int
 	getDeprecationDaysStable() int
 	getLang() string
 	getColor() string
 	getWarnIgnoreFile() string
 	getErrIgnoreFile() string
+	getIgnoreFile() string
 	getFormat() string
+	getTemplate() string
+	getGroupByTag() bool
 	getFailOn() string
 	getFailOnDiff() bool
 
@@ -9,11 +9,30 @@ import (
 )
 
 const specHelp = `
-Base and revision can be a path to a file, a URL, or '-' to read standard input.
+Base and revision can be a path to a file, a URL, a file in the local git repository at a given revision like 'git:main:api/openapi.yaml', or '-' to read standard input.
+With '--base-ref', only the revision is specified and the base is the same file at the given git revision.
 In 'composed' mode, base and revision can be a glob and oasdiff will compare matching endpoints between the two sets of files.`
 
 func getParseArgs(flags Flags) cobra.PositionalArgs {
 	return func(cmd *cobra.Command, args []string) error {
+		if flags.getBaseRef() != "" {
+			if len(args) != 1 {
+				return errors.New("please specify only the revision argument as a path to a file when using --base-ref")
+			}
+			if flags.getComposed() {
+				return errors.New("--base-ref can't be used in composed mode")
+			}
+			if args[0] == "-" {
+				return errors.New("can't read revision from stdin when using --base-ref")
+			}
+			if err := checkColor(cmd); err != nil {
+				return err
+			}
+			if err := checkTemplate(cmd); err != nil {
+				return err
+			}
+			return checkGroupByTag(cmd)
+		}
 		if len(args) < 2 {
 			return errors.New("please specify base and revision arguments as a path to a file, a glob (in composed mode), a URL, or '-' to read standard input")
 		}
@@ -28,23 +47,31 @@ func getParseArgs(flags Flags) cobra.PositionalArgs {
 		if err := checkColor(cmd); err != nil {
 			return err
 		}
+		if err := checkTemplate(cmd); err != nil {
+			return err
+		}
+		if err := checkGroupByTag(cmd); err != nil {
+			return err
+		}
 
 		return nil
 	}
 }
 
-type runner func(flags Flags, stdout io.Writer) (bool, *ReturnError)
+type runner func(flags Flags, stdout io.Writer, stderr io.Writer) (bool, *ReturnError)
 
 func getRun(flags Flags, runner runner) cobra.PositionalArgs {
 	return func(cmd *cobra.Command, args []string) error {
 
-		flags.setBase(load.NewSource(args[0]))
-		flags.setRevision(load.NewSource(args[1]))
+		if err := setSources(flags, args); err != nil {
+			setReturnValue(cmd, err.Code)
+			return err
+		}
 
 		// by now flags have been parsed successfully so we don't need to show usage on any errors
 		cmd.Root().SilenceUsage = true
 
-		failEmpty, err := runner(flags, cmd.OutOrStdout())
+		failEmpty, err := runner(flags, cmd.OutOrStdout(), cmd.ErrOrStderr())
 		if err != nil {
 			setReturnValue(cmd, err.Code)
 			return err
@@ -58,6 +85,22 @@ func getRun(flags Flags, runner runner) cobra.PositionalArgs {
 	}
 }
 
+func setSources(flags Flags, args []string) *ReturnError {
+	if baseRef := flags.getBaseRef(); baseRef != "" {
+		base, err := load.NewGitSourceForFile(baseRef, args[0])
+		if err != nil {
+			return getErrInvalidBaseRef(baseRef, err)
+		}
+		flags.setBase(base)
+		flags.setRevision(load.NewSource(args[0]))
+		return nil
+	}
+
+	flags.setBase(load.NewSource(args[0]))
+	flags.setRevision(load.NewSource(args[1]))
+	return nil
+}
+
 func checkColor(cmd *cobra.Command) error {
 
 	if colorPassed := cmd.Flags().Changed("color"); !colorPassed {
@@ -70,3 +113,49 @@ func checkColor(cmd *cobra.Command) error {
 
 	return errors.New(`--color flag is only relevant with 'text' or 'singleline' formats`)
 }
+
+func checkTemplate(cmd *cobra.Command) error {
+
+	if cmd.Flags().Lookup("template") == nil {
+		return nil
+	}
+
+	template, _ := cmd.Flags().GetString("template")
+	format, _ := cmd.Flags().GetString("format")
+
+	if template == "" {
+		if format == "template" {
+			return errors.New(`'template' format requires the --template flag`)
+		}
+		return nil
+	}
+
+	if cmd.Flags().Changed("format") && format != "template" {
+		templateByConfig, formatByConfig := isSetByConfig(cmd, "template"), isSetByConfig(cmd, "format")
+		switch {
+		case templateByConfig && !formatByConfig:
+			// the format on the command-line takes precedence over the template in the config
+			return nil
+		case formatByConfig && !templateByConfig:
+			// the template on the command-line takes precedence over the format in the config
+		default:
+			return errors.New(`--template flag is only relevant with 'template' format`)
+		}
+	}
+
+	return cmd.Flags().Set("format", "template")
+}
+
+func checkGroupByTag(cmd *cobra.Command) error {
+
+	if groupByTag, _ := cmd.Flags().GetBool("group-by-tag"); !groupByTag {
+		return nil
+	}
+
+	switch format, _ := cmd.Flags().GetString("format"); format {
+	case "text", "html", "markdown", "json", "yaml", "template":
+		return nil
+	}
+
+	return errors.New(`--group-by-tag flag is only relevant with 'text', 'html', 'markdown', 'json', 'yaml' or 'template' formats`)
+}
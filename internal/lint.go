@@ -0,0 +1,140 @@
+package internal
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/spf13/cobra"
+	"github.com/tufin/oasdiff/formatters"
+	"github.com/tufin/oasdiff/lint"
+	"github.com/tufin/oasdiff/load"
+)
+
+func getLintCmd() *cobra.Command {
+
+	flags := LintFlags{}
+
+	cmd := cobra.Command{
+		Use:   "lint spec [flags]",
+		Short: "Lint an OpenAPI spec",
+		Long: `Check the given OpenAPI spec for common errors.
+Spec can be a path to a file, a URL or '-' to read standard input.
+In 'composed' mode, spec can be a glob and oasdiff will lint all matching files.
+`,
+		Args: getParseLintArgs(&flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			flags.spec = load.NewSource(args[0])
+
+			// by now flags have been parsed successfully, so we don't need to show usage on any errors
+			cmd.Root().SilenceUsage = true
+
+			failed, err := runLint(&flags, cmd.OutOrStdout())
+			if err != nil {
+				setReturnValue(cmd, err.Code)
+				return err
+			}
+
+			if failed {
+				setReturnValue(cmd, 1)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.PersistentFlags().BoolVarP(&flags.composed, "composed", "c", false, "work in 'composed' mode, lint all specs matching the glob")
+	enumWithOptions(&cmd, newEnumValue(formatters.SupportedFormatsByContentType(formatters.OutputLint), string(formatters.FormatText), &flags.format), "format", "f", "output format")
+	enumWithOptions(&cmd, newEnumValue([]string{LevelErr, LevelWarn}, "", &flags.failOn), "fail-on", "o", "exit with return code 1 when output includes errors with this level or higher")
+	enumWithOptions(&cmd, newEnumSliceValue(lint.GetCheckIds(), lint.GetCheckIds(), &flags.checks), "checks", "k", "comma-separated list of checks to run")
+	cmd.PersistentFlags().IntVarP(&flags.circularReferenceCounter, "max-circular-dep", "", 5, "maximum allowed number of circular dependencies between objects in OpenAPI specs")
+
+	return &cmd
+}
+
+func getParseLintArgs(flags *LintFlags) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if err := cobra.ExactArgs(1)(cmd, args); err != nil {
+			return err
+		}
+		if flags.composed && args[0] == "-" {
+			return fmt.Errorf("can't read from stdin in composed mode")
+		}
+		return nil
+	}
+}
+
+func runLint(flags *LintFlags, stdout io.Writer) (bool, *ReturnError) {
+
+	openapi3.CircularReferenceCounter = flags.circularReferenceCounter
+
+	config, err := lint.NewConfigFromIds(flags.checks)
+	if err != nil {
+		return false, getErrInvalidFlags(err)
+	}
+
+	specs, returnErr := loadLintSpecs(flags)
+	if returnErr != nil {
+		return false, returnErr
+	}
+
+	errs := lint.Errors{}
+	for _, spec := range specs {
+		errs = append(errs, lint.Run(config, spec.Url, spec)...)
+	}
+	sort.Sort(errs)
+
+	if returnErr := outputLint(stdout, errs, flags.format); returnErr != nil {
+		return false, returnErr
+	}
+
+	if flags.failOn != "" {
+		level, err := lint.NewLevel(flags.failOn)
+		if err != nil {
+			return false, getErrInvalidFlags(fmt.Errorf("invalid fail-on value %s", flags.failOn))
+		}
+		return errs.HasLevelOrHigher(level), nil
+	}
+
+	return false, nil
+}
+
+func loadLintSpecs(flags *LintFlags) ([]*load.SpecInfo, *ReturnError) {
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+
+	if flags.composed {
+		specs, err := load.FromGlob(loader, flags.spec.Path)
+		if err != nil {
+			return nil, getErrFailedToLoadSpecs("lint", flags.spec.Path, err)
+		}
+		return specs, nil
+	}
+
+	spec, err := load.LoadSpecInfo(loader, flags.spec)
+	if err != nil {
+		return nil, getErrFailedToLoadSpec("lint", flags.spec, err)
+	}
+	return []*load.SpecInfo{spec}, nil
+}
+
+func outputLint(stdout io.Writer, errs lint.Errors, format string) *ReturnError {
+	// formatter lookup
+	formatter, err := formatters.Lookup(format, formatters.DefaultFormatterOpts())
+	if err != nil {
+		return getErrUnsupportedLintFormat(format)
+	}
+
+	// render
+	bytes, err := formatter.RenderLint(errs, formatters.NewRenderOpts())
+	if err != nil {
+		return getErrFailedPrint("lint "+format, err)
+	}
+
+	// print output
+	_, _ = fmt.Fprintf(stdout, "%s\n", bytes)
+
+	return nil
+}
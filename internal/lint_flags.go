@@ -0,0 +1,12 @@
+package internal
+
+import "github.com/tufin/oasdiff/load"
+
+type LintFlags struct {
+	spec                     *load.Source
+	composed                 bool
+	format                   string
+	failOn                   string
+	checks                   []string
+	circularReferenceCounter int
+}
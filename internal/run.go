@@ -38,9 +38,11 @@ func Run(args []string, stdout io.Writer, stderr io.Writer) int {
 		getBreakingChangesCmd(),
 		getChangelogCmd(),
 		getFlattenCmd(),
+		getLintCmd(),
 		getChecksCmd(),
 		getQRCodeCmd(),
 	)
+	addConfigFlag(rootCmd)
 
 	return run(rootCmd)
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/tufin/oasdiff/checker"
 	"github.com/tufin/oasdiff/formatters"
 	"github.com/tufin/oasdiff/internal"
+	"github.com/tufin/oasdiff/lint"
 	"gopkg.in/yaml.v3"
 )
 
@@ -170,7 +172,7 @@ func Test_BreakingChangesIgnoreErrs(t *testing.T) {
 	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --err-ignore ../data/ignore-err-example.txt --format json"), &stdout, io.Discard))
 	bc := formatters.Changes{}
 	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
-	require.Len(t, bc, 5)
+	require.Len(t, bc, 9)
 }
 
 func Test_BreakingChangesIgnoreErrsAndWarns(t *testing.T) {
@@ -178,7 +180,7 @@ func Test_BreakingChangesIgnoreErrsAndWarns(t *testing.T) {
 	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --err-ignore ../data/ignore-err-example.txt --warn-ignore ../data/ignore-warn-example.txt --format json"), &stdout, io.Discard))
 	bc := formatters.Changes{}
 	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
-	require.Len(t, bc, 4)
+	require.Len(t, bc, 8)
 }
 
 func Test_BreakingChangesIgnoreErrsApiSchemaOptional(t *testing.T) {
@@ -186,7 +188,7 @@ func Test_BreakingChangesIgnoreErrsApiSchemaOptional(t *testing.T) {
 	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --err-ignore ../data/ignore-err-example.txt --warn-ignore ../data/ignore-warn-example.txt --include-checks api-schema-removed --format json"), &stdout, io.Discard))
 	bc := formatters.Changes{}
 	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
-	require.Len(t, bc, 4)
+	require.Len(t, bc, 8)
 }
 
 func Test_BreakingChangesInvalidIgnoreFile(t *testing.T) {
@@ -280,6 +282,63 @@ func Test_Checks(t *testing.T) {
 	require.Zero(t, internal.Run(cmdToArgs("oasdiff checks -l ru"), io.Discard, io.Discard))
 }
 
+func Test_ChecksLevelOverrides(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff checks -f json --level-overrides endpoint-added=WARN,api-tag-removed=off"), &stdout, io.Discard))
+	checks := formatters.Checks{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &checks))
+
+	levels := map[string]string{}
+	for _, check := range checks {
+		levels[check.Id] = check.Level
+	}
+	require.Equal(t, "warning", levels["endpoint-added"])
+	require.Equal(t, "off", levels["api-tag-removed"])
+	require.Equal(t, "error", levels["api-path-removed-without-deprecation"])
+}
+
+func Test_ChecksInvalidLevelOverrides(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff checks --level-overrides endpoint-added=CRITICAL"), io.Discard, &stderr))
+	require.Equal(t, "Error: invalid level override \"endpoint-added=CRITICAL\", level must be one of ERR, WARN, INFO or OFF\n", stderr.String())
+}
+
+func Test_BreakingChangesLevelOverrides(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/run_test/breaking_changes_include_checks_base.yaml ../data/run_test/breaking_changes_include_checks_revision.yaml --include-checks response-non-success-status-removed,api-tag-removed --level-overrides api-tag-removed=off,response-non-success-status-removed=WARN --format json"), &stdout, io.Discard))
+	bc := formatters.Changes{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.Len(t, bc, 1)
+	require.Equal(t, "response-non-success-status-removed", bc[0].Id)
+	require.Equal(t, checker.WARN, bc[0].Level)
+}
+
+func Test_ConfigLevelOverrides(t *testing.T) {
+	config := writeConfig(t, t.TempDir(), "level-overrides:\n  endpoint-added: WARN\n")
+
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff checks -f json --tags endpoint-added --config "+config), &stdout, io.Discard))
+	checks := formatters.Checks{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &checks))
+	require.Len(t, checks, 1)
+	require.Equal(t, "warning", checks[0].Level)
+}
+
+func Test_BreakingChangesIgnoreRules(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --ignore ../data/ignore-rules/example.yaml --include-checks api-schema-removed --format json"), &stdout, &stderr))
+	bc := formatters.Changes{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.Len(t, bc, 9)
+	require.Equal(t, "Warning: expired rule in ../data/ignore-rules/example.yaml no longer applies, remove or renew it: id: \"request-parameter-removed\", owner: \"api-team\", reason: \"temporary, until the clients are updated\", expires: 2020-01-01\n", stderr.String())
+}
+
+func Test_BreakingChangesInvalidIgnoreRules(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 121, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --ignore ../data/ignore-err-example.txt"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), "can't process structured ignore file")
+}
+
 func Test_Color(t *testing.T) {
 	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/allof/simple.yaml ../data/allof/revision.yaml --color always"), io.Discard, io.Discard))
 }
@@ -289,3 +348,257 @@ func Test_ColorWithNonTextFormat(t *testing.T) {
 	require.Equal(t, 100, internal.Run(cmdToArgs("oasdiff changelog ../data/allof/simple.yaml ../data/allof/revision.yaml -f yaml --color always"), io.Discard, &stderr))
 	require.Equal(t, "Error: --color flag is only relevant with 'text' or 'singleline' formats\n", stderr.String())
 }
+
+func Test_ChangelogTemplate(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff changelog ../data/openapi-test1.yaml ../data/openapi-test3.yaml --template ../data/templates/release-notes.md.tmpl"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), "# Release notes 1.0.0 → 1.0.1\n")
+	require.Contains(t, stdout.String(), "## components/schemas\n- [info] removed the schema 'network-policies' (api-schema-removed)\n")
+	require.Contains(t, stdout.String(), "## servers\n- [error] removed the server 'tufin.com' (api-server-removed)\n")
+}
+
+func Test_BreakingChangesHTMLTemplate(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --template ../data/templates/changes.html"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), "<li>removed the server &#39;tufin.com&#39;</li>")
+}
+
+func Test_BreakingChangesTemplateVersions(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --template ../data/templates/release-notes.md.tmpl"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), "# Release notes 1.0.0 → 1.0.1\n")
+}
+
+func Test_BreakingChangesMarkdownVersions(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml -f markdown"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), "## API Breaking Changes 1.0.0 vs. 1.0.1\n")
+}
+
+func Test_TemplateWithOtherFormat(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 100, internal.Run(cmdToArgs("oasdiff changelog ../data/allof/simple.yaml ../data/allof/revision.yaml -f yaml --template ../data/templates/changes.html"), io.Discard, &stderr))
+	require.Equal(t, "Error: --template flag is only relevant with 'template' format\n", stderr.String())
+}
+
+func Test_TemplateFormatWithoutTemplate(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 100, internal.Run(cmdToArgs("oasdiff breaking ../data/allof/simple.yaml ../data/allof/revision.yaml -f template"), io.Discard, &stderr))
+	require.Equal(t, "Error: 'template' format requires the --template flag\n", stderr.String())
+}
+
+func Test_TemplateNotFound(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 105, internal.Run(cmdToArgs("oasdiff breaking ../data/allof/simple.yaml ../data/allof/revision.yaml --template ../data/templates/not-found.tmpl"), io.Discard, &stderr))
+}
+
+func Test_ChangelogGroupByTag(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff changelog ../data/run_test/changelog_tags_base.yaml ../data/run_test/changelog_tags_revision.yaml --group-by-tag --format json"), &stdout, io.Discard))
+	cl := formatters.ChangesGroupedByTag{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &cl))
+	require.Len(t, cl.Tags, 4)
+	require.Len(t, cl.Tags["pets"], 2)
+	require.Len(t, cl.Tags["stores"], 1)
+	require.Equal(t, "api-path-removed-without-deprecation", cl.Tags["orders"][0].Id)
+	require.Equal(t, "response-non-success-status-added", cl.Tags[formatters.DefaultTag][0].Id)
+	require.Len(t, cl.Components, 1)
+	require.Len(t, cl.Security, 1)
+}
+
+func Test_GroupByTagWithUnsupportedFormat(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 100, internal.Run(cmdToArgs("oasdiff changelog ../data/run_test/changelog_tags_base.yaml ../data/run_test/changelog_tags_revision.yaml --group-by-tag -f singleline"), io.Discard, &stderr))
+	require.Equal(t, "Error: --group-by-tag flag is only relevant with 'text', 'html', 'markdown', 'json', 'yaml' or 'template' formats\n", stderr.String())
+}
+
+func Test_LintOK(t *testing.T) {
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff lint ../data/lint/openapi.yaml --fail-on WARN"), io.Discard, io.Discard))
+}
+
+func Test_LintFailOn(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Equal(t, 1, internal.Run(cmdToArgs("oasdiff lint ../data/lint/info/no-info.yaml --fail-on ERR -f json"), &stdout, io.Discard))
+	errs := lint.Errors{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &errs))
+	require.Len(t, errs, 1)
+	require.Equal(t, "info-missing", errs[0].Id)
+}
+
+func Test_LintChecks(t *testing.T) {
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff lint ../data/lint/info/no-info.yaml --fail-on ERR --checks schema,path-params"), io.Discard, io.Discard))
+}
+
+func Test_LintComposed(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Equal(t, 1, internal.Run(cmdToArgs("oasdiff lint -c ../data/lint/info/*.yaml --fail-on ERR -f yaml"), &stdout, io.Discard))
+	errs := lint.Errors{}
+	require.NoError(t, yaml.Unmarshal(stdout.Bytes(), &errs))
+	require.Len(t, errs, 4)
+}
+
+func Test_BreakingChangesSarif(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml -f sarif"), &stdout, io.Discard))
+	log := formatters.SarifLog{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &log))
+	require.NotEmpty(t, log.Runs[0].Results)
+}
+
+func Test_BreakingChangesGithubActionsLocation(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml -f githubactions"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), "::error title=response-media-type-removed,file=../data/openapi-test1.yaml,col=13,line=127,endLine=129::")
+}
+
+func Test_BaseRefTooManyArgs(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 100, internal.Run(cmdToArgs("oasdiff breaking --base-ref main ../data/openapi-test1.yaml ../data/openapi-test3.yaml"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), "please specify only the revision argument")
+}
+
+func Test_BaseRefComposed(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 100, internal.Run(cmdToArgs("oasdiff diff --base-ref main -c ../data/*.yaml"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), "--base-ref can't be used in composed mode")
+}
+
+func Test_BaseRefNotARepo(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "openapi.yaml")
+	require.NoError(t, os.WriteFile(file, []byte("openapi: 3.0.1"), 0644))
+
+	var stderr bytes.Buffer
+	require.Equal(t, 102, internal.Run([]string{"oasdiff", "changelog", "--base-ref", "main", file}, io.Discard, &stderr))
+	require.Contains(t, stderr.String(), `failed to load base spec from git revision "main"`)
+}
+
+func writeConfig(t *testing.T, dir string, content string) string {
+	t.Helper()
+	file := filepath.Join(dir, ".oasdiff.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(content), 0644))
+	return file
+}
+
+func Test_ConfigFormat(t *testing.T) {
+	config := writeConfig(t, t.TempDir(), "format: yaml\n")
+
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --config "+config), &stdout, io.Discard))
+	bc := formatters.Changes{}
+	require.NoError(t, yaml.Unmarshal(stdout.Bytes(), &bc))
+	require.NotEmpty(t, bc)
+}
+
+func Test_ConfigCommandOverride(t *testing.T) {
+	config := writeConfig(t, t.TempDir(), "format: yaml\ncommands:\n  breaking:\n    format: json\n    fail-on: ERR\n")
+
+	var stdout bytes.Buffer
+	require.Equal(t, 1, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --config "+config), &stdout, io.Discard))
+	bc := formatters.Changes{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.NotEmpty(t, bc)
+}
+
+func Test_ConfigFlagPrecedence(t *testing.T) {
+	config := writeConfig(t, t.TempDir(), "format: yaml\n")
+
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test1.yaml --format json --config "+config), &stdout, io.Discard))
+	require.Equal(t, "[]\n", stdout.String())
+}
+
+func Test_ConfigList(t *testing.T) {
+	config := writeConfig(t, t.TempDir(), "format: json\ninclude-checks:\n  - response-non-success-status-removed\n  - api-tag-removed\n")
+
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/run_test/breaking_changes_include_checks_base.yaml ../data/run_test/breaking_changes_include_checks_revision.yaml --config "+config), &stdout, io.Discard))
+	bc := formatters.Changes{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.Len(t, bc, 2)
+}
+
+func Test_ConfigAutoDiscovery(t *testing.T) {
+	data, err := filepath.Abs("../data")
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	writeConfig(t, dir, "commands:\n  breaking:\n    format: yaml\n")
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	defer func() { require.NoError(t, os.Chdir(wd)) }()
+
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run([]string{"oasdiff", "breaking", filepath.Join(data, "openapi-test1.yaml"), filepath.Join(data, "openapi-test3.yaml")}, &stdout, io.Discard))
+	bc := formatters.Changes{}
+	require.NoError(t, yaml.Unmarshal(stdout.Bytes(), &bc))
+	require.NotEmpty(t, bc)
+}
+
+func Test_ConfigRelativePath(t *testing.T) {
+	data, err := os.ReadFile("../data/ignore-err-example.txt")
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ignore.txt"), data, 0644))
+	config := writeConfig(t, dir, "format: json\nerr-ignore: ignore.txt\n")
+
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --config "+config), &stdout, io.Discard))
+	bc := formatters.Changes{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.Len(t, bc, 9)
+}
+
+func Test_ConfigInvalidColor(t *testing.T) {
+	config := writeConfig(t, t.TempDir(), "format: yaml\ncolor: always\n")
+
+	var stderr bytes.Buffer
+	require.Equal(t, 100, internal.Run(cmdToArgs("oasdiff changelog ../data/allof/simple.yaml ../data/allof/revision.yaml --config "+config), io.Discard, &stderr))
+	require.Equal(t, "Error: --color flag is only relevant with 'text' or 'singleline' formats\n", stderr.String())
+}
+
+func Test_ConfigFormatWithTemplate(t *testing.T) {
+	config := writeConfig(t, t.TempDir(), "format: yaml\n")
+
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff changelog ../data/openapi-test1.yaml ../data/openapi-test3.yaml --template ../data/templates/release-notes.md.tmpl --config "+config), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), "# Release notes 1.0.0 → 1.0.1\n")
+}
+
+func Test_ConfigUnknownFlag(t *testing.T) {
+	config := writeConfig(t, t.TempDir(), "fail-onn: ERR\n")
+
+	var stderr bytes.Buffer
+	require.Equal(t, 116, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --config "+config), io.Discard, &stderr))
+	require.Equal(t, "Error: invalid config: unknown flag \"fail-onn\"\n", stderr.String())
+}
+
+func Test_ConfigUnknownCommand(t *testing.T) {
+	config := writeConfig(t, t.TempDir(), "commands:\n  braking:\n    format: json\n")
+
+	var stderr bytes.Buffer
+	require.Equal(t, 116, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --config "+config), io.Discard, &stderr))
+	require.Equal(t, "Error: invalid config: unknown command \"braking\"\n", stderr.String())
+}
+
+func Test_ConfigInvalidValue(t *testing.T) {
+	config := writeConfig(t, t.TempDir(), "commands:\n  breaking:\n    fail-on: CRITICAL\n")
+
+	var stderr bytes.Buffer
+	require.Equal(t, 116, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --config "+config), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), `invalid value for "fail-on"`)
+}
+
+func Test_ConfigGlobalValueInvalidForCommand(t *testing.T) {
+	config := writeConfig(t, t.TempDir(), "format: sarif\n")
+
+	var stderr bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff diff ../data/openapi-test1.yaml ../data/openapi-test3.yaml --config "+config), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), `Warning: ignoring the config value of "format" for the "diff" command`)
+}
+
+func Test_ConfigMissingFile(t *testing.T) {
+	require.Equal(t, 116, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --config no-such-config.yaml"), io.Discard, io.Discard))
+}
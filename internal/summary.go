@@ -22,6 +22,7 @@ func getSummaryCmd() *cobra.Command {
 	}
 
 	cmd.PersistentFlags().BoolVarP(&flags.composed, "composed", "c", false, "work in 'composed' mode, compare paths in all specs matching base and revision globs")
+	cmd.PersistentFlags().StringVarP(&flags.baseRef, "base-ref", "", "", "compare the revision spec file with the same file at this git revision, like main or main...HEAD for the merge base")
 	enumWithOptions(&cmd, newEnumValue(formatters.SupportedFormatsByContentType(formatters.OutputSummary), string(formatters.FormatYAML), &flags.format), "format", "f", "output format")
 	cmd.PersistentFlags().VarP(newEnumSliceValue(diff.ExcludeDiffOptions, nil, &flags.excludeElements), "exclude-elements", "e", "comma-separated list of elements to exclude")
 	cmd.PersistentFlags().StringVarP(&flags.matchPath, "match-path", "p", "", "include only paths that match this regular expression")
@@ -37,7 +38,7 @@ func getSummaryCmd() *cobra.Command {
 	return &cmd
 }
 
-func runSummary(flags Flags, stdout io.Writer) (bool, *ReturnError) {
+func runSummary(flags Flags, stdout io.Writer, _ io.Writer) (bool, *ReturnError) {
 
 	openapi3.CircularReferenceCounter = flags.getCircularReferenceCounter()
 
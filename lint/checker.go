@@ -1,6 +1,7 @@
 package lint
 
 import (
+	"fmt"
 	"sort"
 
 	"github.com/tufin/oasdiff/load"
@@ -11,6 +12,17 @@ const (
 	LEVEL_WARN  = 1
 )
 
+// NewLevel converts a level name (ERR or WARN) to a lint level
+func NewLevel(level string) (int, error) {
+	switch level {
+	case "ERR":
+		return LEVEL_ERROR, nil
+	case "WARN":
+		return LEVEL_WARN, nil
+	}
+	return LEVEL_WARN, fmt.Errorf("invalid level %s", level)
+}
+
 type Check func(string, *load.SpecInfo) []*Error
 
 type Error struct {
@@ -23,6 +35,24 @@ type Error struct {
 
 type Errors []*Error
 
+// HasLevelOrHigher returns true if there is at least one error with the given level or a more severe one
+func (e Errors) HasLevelOrHigher(level int) bool {
+	for _, err := range e {
+		if err.Level <= level {
+			return true
+		}
+	}
+	return false
+}
+
+func (e Errors) GetLevelCount() map[int]int {
+	counts := map[int]int{}
+	for _, err := range e {
+		counts[err.Level] = counts[err.Level] + 1
+	}
+	return counts
+}
+
 func (e Errors) Len() int {
 	return len(e)
 }
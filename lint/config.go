@@ -1,5 +1,10 @@
 package lint
 
+import (
+	"fmt"
+	"sort"
+)
+
 type Config struct {
 	Checks []Check
 }
@@ -14,6 +19,36 @@ func DefaultConfig() *Config {
 	}
 }
 
+// NewConfigFromIds creates a config with the checks matching the given ids
+func NewConfigFromIds(ids []string) (*Config, error) {
+	checks := make([]Check, 0, len(ids))
+	for _, id := range ids {
+		check, ok := checksById[id]
+		if !ok {
+			return nil, fmt.Errorf("unknown lint check %q", id)
+		}
+		checks = append(checks, check)
+	}
+	return NewConfig(checks), nil
+}
+
+var checksById = map[string]Check{
+	"schema":          SchemaCheck,
+	"path-params":     PathParamsCheck,
+	"required-params": RequiredParamsCheck,
+	"info":            InfoCheck,
+}
+
+// GetCheckIds returns the ids of all available checks
+func GetCheckIds() []string {
+	result := make([]string, 0, len(checksById))
+	for id := range checksById {
+		result = append(result, id)
+	}
+	sort.Strings(result)
+	return result
+}
+
 func defaultChecks() []Check {
 	return []Check{
 		SchemaCheck,
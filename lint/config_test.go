@@ -0,0 +1,28 @@
+package lint_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/lint"
+)
+
+func TestConfig_FromIds(t *testing.T) {
+	config, err := lint.NewConfigFromIds([]string{"info"})
+	require.NoError(t, err)
+	require.Len(t, config.Checks, 1)
+
+	const source = "../data/lint/info/no-info.yaml"
+	errs := lint.Run(config, source, loadFrom(t, source))
+	require.Len(t, errs, 1)
+	require.True(t, errs.HasLevelOrHigher(lint.LEVEL_ERROR))
+}
+
+func TestConfig_FromIdsUnknown(t *testing.T) {
+	_, err := lint.NewConfigFromIds([]string{"xxx"})
+	require.EqualError(t, err, `unknown lint check "xxx"`)
+}
+
+func TestConfig_AllIds(t *testing.T) {
+	require.Equal(t, []string{"info", "path-params", "required-params", "schema"}, lint.GetCheckIds())
+}
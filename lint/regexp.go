@@ -1,7 +1,7 @@
 package lint
 
 import (
-	"regexp"
+	"github.com/tufin/oasdiff/regex"
 )
 
 func checkRegex(pattern string, s *state) *Error {
@@ -21,13 +21,12 @@ func checkRegex(pattern string, s *state) *Error {
 	return nil
 }
 
-// *** THIS IS A TEMPORARY IMPLEMENTATION ***
-// SHOULD USE ECMA 262, SEE: https://swagger.io/docs/specification/data-models/data-types/#pattern
+// validate checks that the pattern is a valid ECMA-262 regular expression, see: https://swagger.io/docs/specification/data-models/data-types/#pattern
 func validate(cache map[string]error, pattern string) error {
 	if result, ok := cache[pattern]; ok {
 		return result
 	}
-	_, err := regexp.Compile(pattern)
+	err := regex.Validate(pattern)
 	cache[pattern] = err
 	return err
 }
@@ -29,6 +29,13 @@ func TestRegexCheck_Embedded(t *testing.T) {
 	}
 }
 
+func TestRegexCheck_ECMA(t *testing.T) {
+
+	const source = "../data/lint/regex/openapi-valid-ecma-regex.yaml"
+	errs := lint.Run(lint.NewConfig([]lint.Check{lint.SchemaCheck}), source, loadFrom(t, source))
+	require.Empty(t, errs)
+}
+
 func TestRegexCheck_Circular(t *testing.T) {
 
 	const source = "../data/circular2.yaml"
@@ -0,0 +1,110 @@
+package load
+
+import (
+	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/tufin/oasdiff/git"
+)
+
+// gitSpec is a spec file at a revision of the local git repository
+type gitSpec struct {
+	repo     *git.Repo
+	revision git.Hash
+	path     string
+}
+
+func openGitSpec(source *Source) (*gitSpec, error) {
+	dir := "."
+	if source.GitWorkTree != "" {
+		dir = source.GitWorkTree
+	}
+
+	repo, err := git.Open(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := repo.ResolveRevision(source.GitRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	specPath, err := gitRepoPath(repo, source.GitPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gitSpec{
+		repo:     repo,
+		revision: revision,
+		path:     specPath,
+	}, nil
+}
+
+// gitRepoPath converts a path to be relative to the top-level directory of the repository
+// Like git, paths that start with ./ or ../ are relative to the current directory
+func gitRepoPath(repo *git.Repo, p string) (string, error) {
+	if strings.HasPrefix(p, "./") || strings.HasPrefix(p, "../") {
+		return repo.RelativePath(p)
+	}
+	return path.Clean(p), nil
+}
+
+// readFromURI reads externally referenced files from the same revision of the repository
+func (spec *gitSpec) readFromURI(loader *openapi3.Loader, location *url.URL) ([]byte, error) {
+	if location.Scheme != "" || location.Host != "" {
+		return nil, openapi3.ErrURINotSupported
+	}
+	return spec.repo.ReadFile(spec.revision, path.Clean(location.Path))
+}
+
+// loadFromGit loads a spec, and all the files it references, from a revision of the local git repository
+// It returns the spec along with the raw contents of the spec file
+func loadFromGit(loader Loader, source *Source) (*openapi3.T, []byte, error) {
+	spec, err := openGitSpec(source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := spec.repo.ReadFile(spec.revision, spec.path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gitLoader := openapi3.NewLoader()
+	gitLoader.IsExternalRefsAllowed = true
+	if l, ok := loader.(*openapi3.Loader); ok {
+		gitLoader.IsExternalRefsAllowed = l.IsExternalRefsAllowed
+		gitLoader.Context = l.Context
+	}
+	gitLoader.ReadFromURIFunc = openapi3.ReadFromURIs(openapi3.ReadFromHTTP(http.DefaultClient), spec.readFromURI)
+
+	s, err := gitLoader.LoadFromDataWithPath(data, &url.URL{Path: spec.path})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s, data, nil
+}
+
+// NewGitSourceForFile creates a source for a file of the working tree at another revision of the repository
+func NewGitSourceForFile(revision, file string) (*Source, error) {
+	repo, err := git.Open(filepath.Dir(file))
+	if err != nil {
+		return nil, err
+	}
+
+	repoPath, err := repo.RelativePath(file)
+	if err != nil {
+		return nil, err
+	}
+
+	source := NewGitSource(revision, repoPath)
+	source.GitWorkTree = repo.WorkTree()
+	return source, nil
+}
@@ -0,0 +1,141 @@
+package load_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/load"
+)
+
+const gitTestSpec = `openapi: 3.0.1
+info:
+  title: Test
+  version: "1.0"
+paths:
+  /pets:
+    get:
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: "schemas/pet.yaml"
+`
+
+const gitTestSchema = `type: object
+properties:
+  name:
+    type: string
+`
+
+// createGitRepo creates a repository with a spec that references an external schema
+func createGitRepo(t *testing.T) string {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "api", "schemas"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "api", "openapi.yaml"), []byte(gitTestSpec), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "api", "schemas", "pet.yaml"), []byte(gitTestSchema), 0644))
+
+	for _, args := range [][]string{
+		{"init", "-q", "-b", "main"},
+		{"add", "-A"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "-m", "first"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	return dir
+}
+
+// chdir changes into dir until the end of the test
+func chdir(t *testing.T, dir string) {
+	t.Helper()
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+}
+
+// chdirToGitRepo creates a repository with a spec that references an external schema, and changes into it
+func chdirToGitRepo(t *testing.T) string {
+	t.Helper()
+
+	dir := createGitRepo(t)
+	chdir(t, dir)
+	return dir
+}
+
+func TestGit_LoadWithExternalRef(t *testing.T) {
+	chdirToGitRepo(t)
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+
+	specInfo, err := load.LoadSpecInfo(loader, load.NewSource("git:main:api/openapi.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, "git:main:api/openapi.yaml", specInfo.Url)
+
+	schema := specInfo.Spec.Paths.Value("/pets").Get.Responses.Value("200").Value.Content.Get("application/json").Schema
+	require.Equal(t, "string", schema.Value.Properties["name"].Value.Type)
+
+	location := specInfo.Locations.Get(specInfo.Spec.Paths.Value("/pets").Get)
+	require.NotNil(t, location)
+	require.Equal(t, "api/openapi.yaml", location.File)
+	require.Equal(t, 6, location.Line)
+}
+
+func TestGit_RelativeToCurrentDir(t *testing.T) {
+	dir := chdirToGitRepo(t)
+	require.NoError(t, os.Chdir(filepath.Join(dir, "api")))
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+
+	_, err := load.From(loader, load.NewSource("git:HEAD:./openapi.yaml"))
+	require.NoError(t, err)
+}
+
+func TestGit_UnknownRevision(t *testing.T) {
+	chdirToGitRepo(t)
+
+	_, err := load.From(openapi3.NewLoader(), load.NewSource("git:no-such-branch:api/openapi.yaml"))
+	require.EqualError(t, err, `unknown revision "no-such-branch"`)
+}
+
+func TestGit_SourceForFile(t *testing.T) {
+	dir := chdirToGitRepo(t)
+
+	source, err := load.NewGitSourceForFile("main", filepath.Join(dir, "api", "openapi.yaml"))
+	require.NoError(t, err)
+	require.True(t, source.IsGit())
+	require.Equal(t, "git:main:api/openapi.yaml", source.Path)
+}
+
+func TestGit_SourceForFileOutsideCurrentRepo(t *testing.T) {
+	dir := createGitRepo(t)
+	chdir(t, t.TempDir())
+
+	source, err := load.NewGitSourceForFile("main", filepath.Join(dir, "api", "openapi.yaml"))
+	require.NoError(t, err)
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+
+	specInfo, err := load.LoadSpecInfo(loader, source)
+	require.NoError(t, err)
+	require.NotNil(t, specInfo.Spec.Paths.Value("/pets"))
+}
@@ -14,7 +14,7 @@ type Loader interface {
 	LoadFromStdin() (*openapi3.T, error)
 }
 
-// From is a convenience function that opens an OpenAPI spec from a URL or a local path based on the format of the path parameter
+// From is a convenience function that opens an OpenAPI spec from a URL, a local path or a git revision based on the format of the path parameter
 func From(loader Loader, source *Source) (*openapi3.T, error) {
 
 	switch source.Type {
@@ -22,6 +22,9 @@ func From(loader Loader, source *Source) (*openapi3.T, error) {
 		return loader.LoadFromStdin()
 	case SourceTypeURL:
 		return loader.LoadFromURI(source.Uri)
+	case SourceTypeGit:
+		s, _, err := loadFromGit(loader, source)
+		return s, err
 	default:
 		return loader.LoadFromFile(source.Path)
 	}
@@ -0,0 +1,518 @@
+package load
+
+import (
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"gopkg.in/yaml.v3"
+)
+
+// Location is the position of a spec element in its source file
+// Lines and columns are zero-based
+type Location struct {
+	File      string
+	Line      int
+	LineEnd   int
+	Column    int
+	ColumnEnd int
+}
+
+// ComponentKey identifies a named component, like a schema or a security scheme, by section and name rather than by pointer
+// When locations of several specs are merged, the last spec that defines the component wins
+type ComponentKey struct {
+	Section string
+	Name    string
+}
+
+// SectionKey identifies a top-level section of a spec, like "security" or "servers"
+type SectionKey string
+
+// Locations maps spec elements, like operations, parameters and schemas, to their position in the source file
+type Locations map[any]*Location
+
+// Get returns the location of the first element that has one
+func (locations Locations) Get(elements ...any) *Location {
+	if locations == nil {
+		return nil
+	}
+
+	for _, element := range elements {
+		if isNil(element) {
+			continue
+		}
+		if location, ok := locations[element]; ok {
+			return location
+		}
+	}
+
+	return nil
+}
+
+// Merge adds the locations of other to locations
+func (locations Locations) Merge(other Locations) Locations {
+	if locations == nil {
+		locations = Locations{}
+	}
+	for k, v := range other {
+		locations[k] = v
+	}
+	return locations
+}
+
+func isNil(element any) bool {
+	if element == nil {
+		return true
+	}
+	value := reflect.ValueOf(element)
+	return value.Kind() == reflect.Ptr && value.IsNil()
+}
+
+// NewLocationsFromFile reads a spec file and maps the elements of the loaded spec, and its webhooks, to their position in the file
+func NewLocationsFromFile(file string, spec *openapi3.T, webhooks *openapi3.Paths) (Locations, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return NewLocations(file, data, spec, webhooks)
+}
+
+// NewLocations maps the elements of the loaded spec, and its webhooks, to their position in the given YAML or JSON data
+// The webhooks are passed separately since kin-openapi keeps them with the extensions of the spec, see GetWebhooks
+func NewLocations(file string, data []byte, spec *openapi3.T, webhooks *openapi3.Paths) (Locations, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, err
+	}
+
+	locations := Locations{}
+	if spec == nil || len(root.Content) == 0 {
+		return locations, nil
+	}
+
+	w := locationsWalker{file: file, locations: locations}
+	w.walkDocument(spec, webhooks, root.Content[0])
+	return locations, nil
+}
+
+type locationsWalker struct {
+	file      string
+	locations Locations
+}
+
+// add registers an element at the position of its key (if any) through the end of its value
+func (w *locationsWalker) add(element any, key *yaml.Node, value *yaml.Node) {
+	if isNil(element) || value == nil {
+		return
+	}
+	if _, ok := w.locations[element]; ok {
+		return
+	}
+
+	start := value
+	if key != nil {
+		start = key
+	}
+	end := lastNode(value)
+
+	location := &Location{
+		File:   w.file,
+		Line:   start.Line - 1,
+		Column: start.Column - 1,
+	}
+	if end.Line > start.Line {
+		location.LineEnd = end.Line - 1
+	}
+	if end.Kind == yaml.ScalarNode && end.Line == start.Line {
+		location.ColumnEnd = end.Column - 1 + len(end.Value)
+	}
+
+	w.locations[element] = location
+}
+
+func lastNode(node *yaml.Node) *yaml.Node {
+	for len(node.Content) > 0 {
+		node = node.Content[len(node.Content)-1]
+	}
+	return node
+}
+
+// mapValue returns the key and value nodes of a mapping entry
+func mapValue(node *yaml.Node, key string) (*yaml.Node, *yaml.Node) {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i], node.Content[i+1]
+		}
+	}
+	return nil, nil
+}
+
+// forEachEntry calls f with the key and value nodes of each mapping entry
+func forEachEntry(node *yaml.Node, f func(key, value *yaml.Node)) {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		f(node.Content[i], node.Content[i+1])
+	}
+}
+
+// seqValue returns the nth item of a sequence
+func seqValue(node *yaml.Node, i int) *yaml.Node {
+	if node == nil || node.Kind != yaml.SequenceNode || i >= len(node.Content) {
+		return nil
+	}
+	return node.Content[i]
+}
+
+func (w *locationsWalker) walkDocument(spec *openapi3.T, webhooks *openapi3.Paths, node *yaml.Node) {
+	forEachEntry(node, func(key, value *yaml.Node) {
+		w.add(SectionKey(key.Value), key, value)
+	})
+
+	// components are walked first so that shared elements are mapped to their definition
+	_, components := mapValue(node, "components")
+	w.walkComponents(spec.Components, components)
+
+	_, servers := mapValue(node, "servers")
+	w.walkServers(spec.Servers, servers)
+
+	_, paths := mapValue(node, "paths")
+	w.walkPaths(spec.Paths, paths)
+
+	_, webhooksNode := mapValue(node, "webhooks")
+	w.walkPaths(webhooks, webhooksNode)
+}
+
+func (w *locationsWalker) walkComponents(components *openapi3.Components, node *yaml.Node) {
+	if components == nil || node == nil {
+		return
+	}
+
+	forEachEntry(node, func(section, sectionValue *yaml.Node) {
+		forEachEntry(sectionValue, func(key, value *yaml.Node) {
+			w.add(ComponentKey{Section: section.Value, Name: key.Value}, key, value)
+		})
+	})
+
+	_, schemas := mapValue(node, "schemas")
+	for name, schemaRef := range components.Schemas {
+		key, value := mapValue(schemas, name)
+		w.walkSchemaRef(schemaRef, key, value)
+	}
+
+	_, parameters := mapValue(node, "parameters")
+	for name, parameterRef := range components.Parameters {
+		key, value := mapValue(parameters, name)
+		w.walkParameterRef(parameterRef, key, value)
+	}
+
+	_, headers := mapValue(node, "headers")
+	for name, headerRef := range components.Headers {
+		key, value := mapValue(headers, name)
+		w.walkHeaderRef(headerRef, key, value)
+	}
+
+	_, requestBodies := mapValue(node, "requestBodies")
+	for name, requestBodyRef := range components.RequestBodies {
+		key, value := mapValue(requestBodies, name)
+		w.walkRequestBodyRef(requestBodyRef, key, value)
+	}
+
+	_, responses := mapValue(node, "responses")
+	for name, responseRef := range components.Responses {
+		key, value := mapValue(responses, name)
+		w.walkResponseRef(responseRef, key, value)
+	}
+
+	_, securitySchemes := mapValue(node, "securitySchemes")
+	for name, securitySchemeRef := range components.SecuritySchemes {
+		if securitySchemeRef == nil || securitySchemeRef.Ref != "" {
+			continue
+		}
+		key, value := mapValue(securitySchemes, name)
+		w.add(securitySchemeRef.Value, key, value)
+	}
+
+	_, examples := mapValue(node, "examples")
+	for name, exampleRef := range components.Examples {
+		key, value := mapValue(examples, name)
+		w.walkExampleRef(exampleRef, key, value)
+	}
+
+	_, links := mapValue(node, "links")
+	for name, linkRef := range components.Links {
+		key, value := mapValue(links, name)
+		w.walkLinkRef(linkRef, key, value)
+	}
+
+	_, callbacks := mapValue(node, "callbacks")
+	for name, callbackRef := range components.Callbacks {
+		key, value := mapValue(callbacks, name)
+		w.walkCallbackRef(callbackRef, key, value)
+	}
+}
+
+func (w *locationsWalker) walkServers(servers openapi3.Servers, node *yaml.Node) {
+	for i, server := range servers {
+		w.add(server, nil, seqValue(node, i))
+	}
+}
+
+func (w *locationsWalker) walkPaths(paths *openapi3.Paths, node *yaml.Node) {
+	if paths == nil || node == nil {
+		return
+	}
+
+	for path, pathItem := range paths.Map() {
+		key, value := mapValue(node, path)
+		w.walkPathItem(pathItem, key, value)
+	}
+}
+
+func (w *locationsWalker) walkPathItem(pathItem *openapi3.PathItem, key *yaml.Node, node *yaml.Node) {
+	if pathItem == nil || node == nil {
+		return
+	}
+
+	w.add(pathItem, key, node)
+
+	_, servers := mapValue(node, "servers")
+	w.walkServers(pathItem.Servers, servers)
+
+	_, parameters := mapValue(node, "parameters")
+	w.walkParameters(pathItem.Parameters, parameters)
+
+	for method, operation := range pathItem.Operations() {
+		key, value := mapValue(node, strings.ToLower(method))
+		w.walkOperation(operation, key, value)
+	}
+}
+
+func (w *locationsWalker) walkOperation(operation *openapi3.Operation, key *yaml.Node, node *yaml.Node) {
+	if operation == nil || node == nil {
+		return
+	}
+
+	w.add(operation, key, node)
+
+	_, parameters := mapValue(node, "parameters")
+	w.walkParameters(operation.Parameters, parameters)
+
+	requestBodyKey, requestBody := mapValue(node, "requestBody")
+	w.walkRequestBodyRef(operation.RequestBody, requestBodyKey, requestBody)
+
+	_, responses := mapValue(node, "responses")
+	if operation.Responses != nil {
+		for status, responseRef := range operation.Responses.Map() {
+			key, value := mapValue(responses, status)
+			w.walkResponseRef(responseRef, key, value)
+		}
+	}
+
+	_, callbacks := mapValue(node, "callbacks")
+	for name, callbackRef := range operation.Callbacks {
+		key, value := mapValue(callbacks, name)
+		w.walkCallbackRef(callbackRef, key, value)
+	}
+
+	if operation.Servers != nil {
+		_, servers := mapValue(node, "servers")
+		w.walkServers(*operation.Servers, servers)
+	}
+}
+
+func (w *locationsWalker) walkParameters(parameters openapi3.Parameters, node *yaml.Node) {
+	for i, parameterRef := range parameters {
+		w.walkParameterRef(parameterRef, nil, seqValue(node, i))
+	}
+}
+
+func (w *locationsWalker) walkParameterRef(parameterRef *openapi3.ParameterRef, key *yaml.Node, node *yaml.Node) {
+	if parameterRef == nil || parameterRef.Ref != "" || node == nil {
+		return
+	}
+
+	parameter := parameterRef.Value
+	w.add(parameter, key, node)
+
+	if parameter == nil {
+		return
+	}
+
+	schemaKey, schema := mapValue(node, "schema")
+	w.walkSchemaRef(parameter.Schema, schemaKey, schema)
+
+	_, content := mapValue(node, "content")
+	w.walkContent(parameter.Content, content)
+
+	_, examples := mapValue(node, "examples")
+	w.walkExamples(parameter.Examples, examples)
+}
+
+func (w *locationsWalker) walkHeaderRef(headerRef *openapi3.HeaderRef, key *yaml.Node, node *yaml.Node) {
+	if headerRef == nil || headerRef.Ref != "" || node == nil {
+		return
+	}
+
+	header := headerRef.Value
+	w.add(header, key, node)
+
+	if header == nil {
+		return
+	}
+
+	schemaKey, schema := mapValue(node, "schema")
+	w.walkSchemaRef(header.Schema, schemaKey, schema)
+
+	_, content := mapValue(node, "content")
+	w.walkContent(header.Content, content)
+}
+
+func (w *locationsWalker) walkRequestBodyRef(requestBodyRef *openapi3.RequestBodyRef, key *yaml.Node, node *yaml.Node) {
+	if requestBodyRef == nil || requestBodyRef.Ref != "" || node == nil {
+		return
+	}
+
+	w.add(requestBodyRef.Value, key, node)
+
+	if requestBodyRef.Value == nil {
+		return
+	}
+
+	_, content := mapValue(node, "content")
+	w.walkContent(requestBodyRef.Value.Content, content)
+}
+
+func (w *locationsWalker) walkResponseRef(responseRef *openapi3.ResponseRef, key *yaml.Node, node *yaml.Node) {
+	if responseRef == nil || responseRef.Ref != "" || node == nil {
+		return
+	}
+
+	response := responseRef.Value
+	w.add(response, key, node)
+
+	if response == nil {
+		return
+	}
+
+	_, headers := mapValue(node, "headers")
+	for name, headerRef := range response.Headers {
+		key, value := mapValue(headers, name)
+		w.walkHeaderRef(headerRef, key, value)
+	}
+
+	_, content := mapValue(node, "content")
+	w.walkContent(response.Content, content)
+
+	_, links := mapValue(node, "links")
+	for name, linkRef := range response.Links {
+		key, value := mapValue(links, name)
+		w.walkLinkRef(linkRef, key, value)
+	}
+}
+
+func (w *locationsWalker) walkContent(content openapi3.Content, node *yaml.Node) {
+	for name, mediaType := range content {
+		key, value := mapValue(node, name)
+		if mediaType == nil || value == nil {
+			continue
+		}
+
+		w.add(mediaType, key, value)
+
+		schemaKey, schema := mapValue(value, "schema")
+		w.walkSchemaRef(mediaType.Schema, schemaKey, schema)
+
+		_, examples := mapValue(value, "examples")
+		w.walkExamples(mediaType.Examples, examples)
+
+		_, encodings := mapValue(value, "encoding")
+		for name, encoding := range mediaType.Encoding {
+			key, value := mapValue(encodings, name)
+			w.add(encoding, key, value)
+		}
+	}
+}
+
+func (w *locationsWalker) walkExamples(examples openapi3.Examples, node *yaml.Node) {
+	for name, exampleRef := range examples {
+		key, value := mapValue(node, name)
+		w.walkExampleRef(exampleRef, key, value)
+	}
+}
+
+func (w *locationsWalker) walkExampleRef(exampleRef *openapi3.ExampleRef, key *yaml.Node, node *yaml.Node) {
+	if exampleRef == nil || exampleRef.Ref != "" {
+		return
+	}
+	w.add(exampleRef.Value, key, node)
+}
+
+func (w *locationsWalker) walkLinkRef(linkRef *openapi3.LinkRef, key *yaml.Node, node *yaml.Node) {
+	if linkRef == nil || linkRef.Ref != "" {
+		return
+	}
+	w.add(linkRef.Value, key, node)
+}
+
+func (w *locationsWalker) walkCallbackRef(callbackRef *openapi3.CallbackRef, key *yaml.Node, node *yaml.Node) {
+	if callbackRef == nil || callbackRef.Ref != "" || callbackRef.Value == nil || node == nil {
+		return
+	}
+
+	w.add(callbackRef.Value, key, node)
+
+	for path, pathItem := range callbackRef.Value.Map() {
+		key, value := mapValue(node, path)
+		w.walkPathItem(pathItem, key, value)
+	}
+}
+
+func (w *locationsWalker) walkSchemaRef(schemaRef *openapi3.SchemaRef, key *yaml.Node, node *yaml.Node) {
+	if schemaRef == nil || schemaRef.Ref != "" || node == nil {
+		// referenced schemas are mapped to their definition
+		return
+	}
+
+	schema := schemaRef.Value
+	if schema == nil {
+		return
+	}
+	if _, ok := w.locations[schema]; ok {
+		// already visited
+		return
+	}
+
+	w.add(schema, key, node)
+
+	_, properties := mapValue(node, "properties")
+	for name, property := range schema.Properties {
+		key, value := mapValue(properties, name)
+		w.walkSchemaRef(property, key, value)
+	}
+
+	itemsKey, items := mapValue(node, "items")
+	w.walkSchemaRef(schema.Items, itemsKey, items)
+
+	notKey, not := mapValue(node, "not")
+	w.walkSchemaRef(schema.Not, notKey, not)
+
+	additionalPropertiesKey, additionalProperties := mapValue(node, "additionalProperties")
+	w.walkSchemaRef(schema.AdditionalProperties.Schema, additionalPropertiesKey, additionalProperties)
+
+	w.walkSchemaRefs(schema.AllOf, node, "allOf")
+	w.walkSchemaRefs(schema.AnyOf, node, "anyOf")
+	w.walkSchemaRefs(schema.OneOf, node, "oneOf")
+}
+
+func (w *locationsWalker) walkSchemaRefs(schemaRefs openapi3.SchemaRefs, node *yaml.Node, name string) {
+	_, list := mapValue(node, name)
+	for i, schemaRef := range schemaRefs {
+		w.walkSchemaRef(schemaRef, nil, seqValue(list, i))
+	}
+}
@@ -0,0 +1,80 @@
+package load_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/load"
+)
+
+func TestLocations_Operation(t *testing.T) {
+	specInfo, err := load.LoadSpecInfo(MockLoader{}, load.NewSource("../data/openapi-test1.yaml"))
+	require.NoError(t, err)
+
+	operation := specInfo.Spec.Paths.Value("/api/{domain}/{project}/install-command").Get
+	require.Equal(t, &load.Location{
+		File:    "../data/openapi-test1.yaml",
+		Line:    131,
+		LineEnd: 172,
+		Column:  4,
+	}, specInfo.Locations.Get(operation))
+}
+
+func TestLocations_Parameter(t *testing.T) {
+	specInfo, err := load.LoadSpecInfo(MockLoader{}, load.NewSource("../data/openapi-test1.yaml"))
+	require.NoError(t, err)
+
+	parameter := specInfo.Spec.Paths.Value("/api/{domain}/{project}/badges/security-score").Get.Parameters.GetByInAndName("cookie", "test")
+	require.Equal(t, &load.Location{
+		File:    "../data/openapi-test1.yaml",
+		Line:    61,
+		LineEnd: 66,
+		Column:  8,
+	}, specInfo.Locations.Get(parameter))
+}
+
+func TestLocations_Component(t *testing.T) {
+	specInfo, err := load.LoadSpecInfo(MockLoader{}, load.NewSource("../data/openapi-test1.yaml"))
+	require.NoError(t, err)
+
+	location := specInfo.Locations.Get(load.ComponentKey{Section: "schemas", Name: "network-policies"})
+	require.NotNil(t, location)
+	require.Equal(t, location, specInfo.Locations.Get(specInfo.Spec.Components.Schemas["network-policies"].Value))
+}
+
+func TestLocations_Webhook(t *testing.T) {
+	specInfo, err := load.LoadSpecInfo(MockLoader{}, load.NewSource("../data/checker/webhook_base.yaml"))
+	require.NoError(t, err)
+
+	operation := specInfo.Webhooks.Value("newPet").Post
+	require.Equal(t, &load.Location{
+		File:    "../data/checker/webhook_base.yaml",
+		Line:    6,
+		LineEnd: 22,
+		Column:  4,
+	}, specInfo.Locations.Get(operation))
+}
+
+func TestLocations_GetFirst(t *testing.T) {
+	specInfo, err := load.LoadSpecInfo(MockLoader{}, load.NewSource("../data/openapi-test1.yaml"))
+	require.NoError(t, err)
+
+	operation := specInfo.Spec.Paths.Value("/register").Post
+	require.Equal(t, specInfo.Locations.Get(operation), specInfo.Locations.Get(nil, operation))
+}
+
+func TestLocations_Nil(t *testing.T) {
+	var locations load.Locations
+	require.Nil(t, locations.Get(load.SectionKey("paths")))
+}
+
+func TestLocations_InvalidFile(t *testing.T) {
+	_, err := load.NewLocationsFromFile("../data/no-such-file.yaml", nil, nil)
+	require.Error(t, err)
+}
+
+func TestLocations_Glob(t *testing.T) {
+	specInfos, err := load.FromGlob(MockLoader{}, "../data/openapi-test1.yaml")
+	require.NoError(t, err)
+	require.NotNil(t, load.GetLocations(specInfos...).Get(load.SectionKey("paths")))
+}
@@ -3,6 +3,7 @@ package load
 import (
 	"fmt"
 	"net/url"
+	"strings"
 )
 
 type SourceType int
@@ -11,12 +12,22 @@ const (
 	SourceTypeStdin SourceType = iota
 	SourceTypeURL
 	SourceTypeFile
+	SourceTypeGit
 )
 
+const gitPrefix = "git:"
+
 type Source struct {
 	Path string
 	Uri  *url.URL
 	Type SourceType
+
+	// GitRevision and GitPath are set for specs read from a git repository, like git:main:api/openapi.yaml
+	GitRevision string
+	GitPath     string
+
+	// GitWorkTree is the top-level directory of the repository, or empty for the repository of the current directory
+	GitWorkTree string
 }
 
 func NewSource(path string) *Source {
@@ -27,6 +38,10 @@ func NewSource(path string) *Source {
 		}
 	}
 
+	if revision, gitPath, ok := parseGitPath(path); ok {
+		return NewGitSource(revision, gitPath)
+	}
+
 	if uri, err := getURL(path); err == nil {
 		return &Source{
 			Path: path,
@@ -41,6 +56,32 @@ func NewSource(path string) *Source {
 	}
 }
 
+// NewGitSource creates a source for a spec in the local git repository at the given revision
+// The path is relative to the top-level directory of the repository, or to the current directory if it starts with ./ or ../
+func NewGitSource(revision, path string) *Source {
+	return &Source{
+		Path:        gitPrefix + revision + ":" + path,
+		Type:        SourceTypeGit,
+		GitRevision: revision,
+		GitPath:     path,
+	}
+}
+
+// parseGitPath splits a path like git:<revision>:<path> into the revision and the path
+func parseGitPath(path string) (string, string, bool) {
+	rest, ok := strings.CutPrefix(path, gitPrefix)
+	if !ok {
+		return "", "", false
+	}
+
+	revision, gitPath, found := strings.Cut(rest, ":")
+	if !found || revision == "" || gitPath == "" {
+		return "", "", false
+	}
+
+	return revision, gitPath, true
+}
+
 func (source *Source) String() string {
 	return source.Path
 }
@@ -59,3 +100,7 @@ func (source *Source) IsStdin() bool {
 func (source *Source) IsFile() bool {
 	return source.Type == SourceTypeFile
 }
+
+func (source *Source) IsGit() bool {
+	return source.Type == SourceTypeGit
+}
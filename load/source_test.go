@@ -26,3 +26,14 @@ func TestSource_OutStdin(t *testing.T) {
 func TestSource_Out(t *testing.T) {
 	require.Equal(t, `"http://twitter.com"`, load.NewSource("http://twitter.com").Out())
 }
+
+func TestSource_NewGit(t *testing.T) {
+	source := load.NewSource("git:main:api/openapi.yaml")
+	require.True(t, source.IsGit())
+	require.Equal(t, "main", source.GitRevision)
+	require.Equal(t, "api/openapi.yaml", source.GitPath)
+}
+
+func TestSource_NewGitNoPath(t *testing.T) {
+	require.True(t, load.NewSource("git:main").IsFile())
+}
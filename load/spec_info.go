@@ -10,9 +10,13 @@ import (
 
 // SpecInfo contains information about an OpenAPI spec and its metadata
 type SpecInfo struct {
-	Url     string
-	Spec    *openapi3.T
-	Version string
+	Url       string
+	Spec      *openapi3.T
+	Version   string
+	Locations Locations
+
+	// Webhooks are the parsed webhooks of OpenAPI 3.1 specs, which kin-openapi keeps with the extensions of the spec
+	Webhooks *openapi3.Paths
 }
 
 func (specInfo *SpecInfo) GetVersion() string {
@@ -23,11 +27,25 @@ func (specInfo *SpecInfo) GetVersion() string {
 }
 
 func newSpecInfo(spec *openapi3.T, path string) *SpecInfo {
+	// invalid webhooks are reported by the diff, which parses them again
+	webhooks, _ := GetWebhooks(spec)
 	return &SpecInfo{
-		Spec:    spec,
-		Url:     path,
-		Version: getVersion(spec),
+		Spec:     spec,
+		Url:      path,
+		Version:  getVersion(spec),
+		Webhooks: webhooks,
+	}
+}
+
+// GetLocations returns the locations of all elements in the given specs
+func GetLocations(specInfos ...*SpecInfo) Locations {
+	result := Locations{}
+	for _, specInfo := range specInfos {
+		if specInfo != nil {
+			result.Merge(specInfo.Locations)
+		}
 	}
+	return result
 }
 
 type SpecInfoPair struct {
@@ -65,13 +83,29 @@ func getVersion(spec *openapi3.T) string {
 	return spec.Info.Version
 }
 
-// LoadSpecInfo creates a SpecInfo from a local file path, a URL, or stdin
+// LoadSpecInfo creates a SpecInfo from a local file path, a URL, a git revision, or stdin
 func LoadSpecInfo(loader Loader, source *Source) (*SpecInfo, error) {
+	if source.IsGit() {
+		s, data, err := loadFromGit(loader, source)
+		if err != nil {
+			return nil, err
+		}
+		specInfo := newSpecInfo(s, source.Path)
+		// locations are optional, so errors are ignored
+		specInfo.Locations, _ = NewLocations(source.GitPath, data, s, specInfo.Webhooks)
+		return specInfo, nil
+	}
+
 	s, err := From(loader, source)
 	if err != nil {
 		return nil, err
 	}
-	return newSpecInfo(s, source.Path), nil
+	specInfo := newSpecInfo(s, source.Path)
+	if source.IsFile() {
+		// locations are optional, so errors are ignored
+		specInfo.Locations, _ = NewLocationsFromFile(source.Path, s, specInfo.Webhooks)
+	}
+	return specInfo, nil
 }
 
 // FromGlob creates SpecInfo specs from local files matching the specified glob parameter
@@ -86,7 +120,9 @@ func FromGlob(loader Loader, glob string) ([]*SpecInfo, error) {
 		if err != nil {
 			return nil, err
 		}
-		result = append(result, &SpecInfo{Url: file, Spec: spec})
+		specInfo := newSpecInfo(spec, file)
+		specInfo.Locations, _ = NewLocationsFromFile(file, spec, specInfo.Webhooks)
+		result = append(result, specInfo)
 	}
 
 	if len(result) > 0 {
@@ -0,0 +1,54 @@
+package load
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// webhooksField is the OpenAPI 3.1 field that describes the requests that the API may initiate: https://spec.openapis.org/oas/v3.1.0#openapi-object
+const webhooksField = "webhooks"
+
+/*
+GetWebhooks returns the webhooks of an OpenAPI 3.1 spec as Paths, keyed by the webhook names.
+
+kin-openapi doesn't support webhooks yet and keeps them with the extensions of the spec, so they are parsed on each call and the spec isn't modified.
+SpecInfo keeps the parsed webhooks, so that the diff and the locations refer to the same objects.
+References to components are resolved against the components of the spec.
+*/
+func GetWebhooks(spec *openapi3.T) (*openapi3.Paths, error) {
+	if spec == nil {
+		return openapi3.NewPaths(), nil
+	}
+
+	value, ok := spec.Extensions[webhooksField]
+	if !ok || value == nil {
+		return openapi3.NewPaths(), nil
+	}
+
+	if webhooks, ok := value.(*openapi3.Paths); ok {
+		return webhooks, nil
+	}
+
+	data, ok := value.(json.RawMessage)
+	if !ok {
+		var err error
+		if data, err = json.Marshal(value); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", webhooksField, err)
+		}
+	}
+
+	webhooks := openapi3.NewPaths()
+	if err := json.Unmarshal(data, webhooks); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", webhooksField, err)
+	}
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	if err := loader.ResolveRefsIn(&openapi3.T{OpenAPI: spec.OpenAPI, Components: spec.Components, Paths: webhooks}, nil); err != nil {
+		return nil, fmt.Errorf("failed to resolve references in %s: %w", webhooksField, err)
+	}
+
+	return webhooks, nil
+}
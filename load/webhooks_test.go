@@ -0,0 +1,21 @@
+package load_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/load"
+)
+
+func TestGetWebhooks_KeepsSpec(t *testing.T) {
+	spec, err := openapi3.NewLoader().LoadFromFile("../data/checker/webhook_base.yaml")
+	require.NoError(t, err)
+
+	webhooks, err := load.GetWebhooks(spec)
+	require.NoError(t, err)
+	require.NotNil(t, webhooks.Value("newPet").Post)
+
+	_, parsed := spec.Extensions["webhooks"].(*openapi3.Paths)
+	require.False(t, parsed)
+}
@@ -0,0 +1,447 @@
+package regex
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Relation describes how the strings matched by one pattern relate to the strings matched by another
+type Relation int
+
+const (
+	RelationUnknown      Relation = iota // the patterns use features outside of the regular subset, like lookarounds or backreferences, or they are too large to compare
+	RelationEqual                        // the patterns match the same strings
+	RelationSuperset                     // the second pattern matches all the strings that the first pattern matches, and more
+	RelationSubset                       // the first pattern matches all the strings that the second pattern matches, and more
+	RelationIncomparable                 // each pattern matches strings that the other pattern doesn't
+)
+
+func (relation Relation) String() string {
+	switch relation {
+	case RelationEqual:
+		return "equal"
+	case RelationSuperset:
+		return "superset"
+	case RelationSubset:
+		return "subset"
+	case RelationIncomparable:
+		return "incomparable"
+	}
+	return "unknown"
+}
+
+const (
+	// maxNFAStates limits the size of patterns with large quantifiers, like [a-z]{1,1000}
+	maxNFAStates = 5000
+	// maxDFAStates limits the states of the deterministic automata and of their product
+	maxDFAStates = 10000
+)
+
+var errUnsupported = errors.New("unsupported pattern")
+
+// Compare returns the relation of the strings matched by the second pattern to the strings matched by the first pattern
+// For example, comparing [0-9]+ to [0-9]* returns RelationSuperset because every string that matches the first pattern also matches the second
+// Patterns are compared like the pattern keyword applies them, so they aren't anchored unless they use ^ and $
+// Patterns with lookarounds, word boundaries or backreferences aren't regular, so comparing them returns RelationUnknown
+func Compare(pattern1, pattern2 string) Relation {
+	if pattern1 == pattern2 {
+		return RelationEqual
+	}
+
+	node1, err := Parse(pattern1)
+	if err != nil {
+		return RelationUnknown
+	}
+	node2, err := Parse(pattern2)
+	if err != nil {
+		return RelationUnknown
+	}
+
+	nfa1, err := newNFA(node1)
+	if err != nil {
+		return RelationUnknown
+	}
+	nfa2, err := newNFA(node2)
+	if err != nil {
+		return RelationUnknown
+	}
+
+	alphabet := newAlphabet(nfa1, nfa2)
+	dfa1, err := newDFA(nfa1, alphabet)
+	if err != nil {
+		return RelationUnknown
+	}
+	dfa2, err := newDFA(nfa2, alphabet)
+	if err != nil {
+		return RelationUnknown
+	}
+
+	only1, only2, err := compareDFAs(dfa1, dfa2, len(alphabet))
+	if err != nil {
+		return RelationUnknown
+	}
+
+	switch {
+	case !only1 && !only2:
+		return RelationEqual
+	case !only1:
+		return RelationSuperset
+	case !only2:
+		return RelationSubset
+	default:
+		return RelationIncomparable
+	}
+}
+
+type edgeKind int
+
+const (
+	edgeEpsilon edgeKind = iota
+	edgeChar
+	edgeBeginText
+	edgeEndText
+)
+
+type nfaEdge struct {
+	kind   edgeKind
+	ranges []rune // edgeChar
+	to     int
+}
+
+// nfa is a nondeterministic automaton with epsilon edges that matches a pattern from its start state to its accept state
+type nfa struct {
+	edges  [][]nfaEdge
+	start  int
+	accept int
+}
+
+func newNFA(node *Node) (*nfa, error) {
+	result := nfa{}
+	start, end, err := result.compile(node)
+	if err != nil {
+		return nil, err
+	}
+	result.start, result.accept = start, end
+	return &result, nil
+}
+
+func (a *nfa) newState() (int, error) {
+	if len(a.edges) >= maxNFAStates {
+		return 0, errUnsupported
+	}
+	a.edges = append(a.edges, nil)
+	return len(a.edges) - 1, nil
+}
+
+func (a *nfa) addEdge(from int, edge nfaEdge) {
+	a.edges[from] = append(a.edges[from], edge)
+}
+
+// compile adds the states of a node and returns its start and end states
+func (a *nfa) compile(node *Node) (int, int, error) {
+	start, err := a.newState()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	switch node.Op {
+	case OpCapture:
+		// groups don't affect the matched strings, unless they are referenced, which isn't supported
+		subStart, subEnd, err := a.compile(node.Sub[0])
+		if err != nil {
+			return 0, 0, err
+		}
+		a.addEdge(start, nfaEdge{kind: edgeEpsilon, to: subStart})
+		return start, subEnd, nil
+	case OpConcat:
+		end := start
+		for _, sub := range node.Sub {
+			subStart, subEnd, err := a.compile(sub)
+			if err != nil {
+				return 0, 0, err
+			}
+			a.addEdge(end, nfaEdge{kind: edgeEpsilon, to: subStart})
+			end = subEnd
+		}
+		return start, end, nil
+	case OpRepeat:
+		return a.compileRepeat(start, node)
+	}
+
+	end, err := a.newState()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	switch node.Op {
+	case OpEmptyMatch:
+		a.addEdge(start, nfaEdge{kind: edgeEpsilon, to: end})
+	case OpLiteral:
+		a.addEdge(start, nfaEdge{kind: edgeChar, ranges: []rune{node.Rune, node.Rune}, to: end})
+	case OpCharClass:
+		a.addEdge(start, nfaEdge{kind: edgeChar, ranges: node.Ranges, to: end})
+	case OpBeginText:
+		a.addEdge(start, nfaEdge{kind: edgeBeginText, to: end})
+	case OpEndText:
+		a.addEdge(start, nfaEdge{kind: edgeEndText, to: end})
+	case OpAlternate:
+		for _, sub := range node.Sub {
+			subStart, subEnd, err := a.compile(sub)
+			if err != nil {
+				return 0, 0, err
+			}
+			a.addEdge(start, nfaEdge{kind: edgeEpsilon, to: subStart})
+			a.addEdge(subEnd, nfaEdge{kind: edgeEpsilon, to: end})
+		}
+	default:
+		return 0, 0, errUnsupported
+	}
+	return start, end, nil
+}
+
+// compileRepeat unrolls a quantifier into the required copies of its node followed by the optional ones, or by a loop if it is unbounded
+func (a *nfa) compileRepeat(start int, node *Node) (int, int, error) {
+	end := start
+	for i := 0; i < node.Min; i++ {
+		subStart, subEnd, err := a.compile(node.Sub[0])
+		if err != nil {
+			return 0, 0, err
+		}
+		a.addEdge(end, nfaEdge{kind: edgeEpsilon, to: subStart})
+		end = subEnd
+	}
+
+	if node.Max < 0 {
+		subStart, subEnd, err := a.compile(node.Sub[0])
+		if err != nil {
+			return 0, 0, err
+		}
+		a.addEdge(end, nfaEdge{kind: edgeEpsilon, to: subStart})
+		a.addEdge(subEnd, nfaEdge{kind: edgeEpsilon, to: end})
+		return start, end, nil
+	}
+
+	optionalEnd, err := a.newState()
+	if err != nil {
+		return 0, 0, err
+	}
+	for i := node.Min; i < node.Max; i++ {
+		a.addEdge(end, nfaEdge{kind: edgeEpsilon, to: optionalEnd})
+		subStart, subEnd, err := a.compile(node.Sub[0])
+		if err != nil {
+			return 0, 0, err
+		}
+		a.addEdge(end, nfaEdge{kind: edgeEpsilon, to: subStart})
+		end = subEnd
+	}
+	a.addEdge(end, nfaEdge{kind: edgeEpsilon, to: optionalEnd})
+	return start, optionalEnd, nil
+}
+
+// newAlphabet splits the characters into intervals that are either entirely in or entirely out of each character class of the automata
+// It returns the first character of each interval
+func newAlphabet(automata ...*nfa) []rune {
+	bounds := map[rune]bool{0: true}
+	for _, a := range automata {
+		for _, edges := range a.edges {
+			for _, edge := range edges {
+				for i := 0; i+1 < len(edge.ranges); i += 2 {
+					bounds[edge.ranges[i]] = true
+					if edge.ranges[i+1] < unicode.MaxRune {
+						bounds[edge.ranges[i+1]+1] = true
+					}
+				}
+			}
+		}
+	}
+
+	result := make([]rune, 0, len(bounds))
+	for bound := range bounds {
+		result = append(result, bound)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
+
+func rangesContain(ranges []rune, r rune) bool {
+	for i := 0; i+1 < len(ranges); i += 2 {
+		if r >= ranges[i] && r <= ranges[i+1] {
+			return true
+		}
+	}
+	return false
+}
+
+// dfa is a deterministic automaton over the intervals of an alphabet, where -1 is the dead state
+type dfa struct {
+	next   [][]int
+	accept []bool
+}
+
+// configuration is a state of the automaton that matches the pattern anywhere in a string:
+// a state of the pattern automaton, or the state before or after the match, along with the anchors that still hold
+type configuration struct {
+	state   int
+	atStart bool // no characters were consumed, so ^ holds
+	ended   bool // $ was matched, so no more characters may be consumed
+}
+
+// newDFA builds a deterministic automaton that accepts the strings in which the pattern matches
+func newDFA(a *nfa, alphabet []rune) (*dfa, error) {
+	before, after := len(a.edges), len(a.edges)+1
+
+	closure := func(configurations []configuration) []configuration {
+		seen := map[configuration]bool{}
+		stack := append([]configuration{}, configurations...)
+		for len(stack) > 0 {
+			c := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if seen[c] {
+				continue
+			}
+			seen[c] = true
+
+			switch c.state {
+			case before:
+				stack = append(stack, configuration{state: a.start, atStart: c.atStart, ended: c.ended})
+				continue
+			case after:
+				continue
+			case a.accept:
+				stack = append(stack, configuration{state: after, atStart: c.atStart, ended: c.ended})
+			}
+			for _, edge := range a.edges[c.state] {
+				switch edge.kind {
+				case edgeEpsilon:
+					stack = append(stack, configuration{state: edge.to, atStart: c.atStart, ended: c.ended})
+				case edgeBeginText:
+					if c.atStart {
+						stack = append(stack, configuration{state: edge.to, atStart: c.atStart, ended: c.ended})
+					}
+				case edgeEndText:
+					stack = append(stack, configuration{state: edge.to, atStart: c.atStart, ended: true})
+				}
+			}
+		}
+
+		result := make([]configuration, 0, len(seen))
+		for c := range seen {
+			result = append(result, c)
+		}
+		return result
+	}
+
+	step := func(configurations []configuration, r rune) []configuration {
+		result := []configuration{}
+		for _, c := range configurations {
+			if c.ended {
+				continue
+			}
+			switch c.state {
+			case before, after:
+				// the characters before and after the match
+				result = append(result, configuration{state: c.state})
+				continue
+			}
+			for _, edge := range a.edges[c.state] {
+				if edge.kind == edgeChar && rangesContain(edge.ranges, r) {
+					result = append(result, configuration{state: edge.to})
+				}
+			}
+		}
+		return closure(result)
+	}
+
+	key := func(configurations []configuration) string {
+		keys := make([]string, len(configurations))
+		for i, c := range configurations {
+			keys[i] = strconv.Itoa(c.state) + strconv.FormatBool(c.atStart) + strconv.FormatBool(c.ended)
+		}
+		sort.Strings(keys)
+		return strings.Join(keys, ",")
+	}
+
+	result := dfa{}
+	ids := map[string]int{}
+	queue := [][]configuration{}
+	add := func(configurations []configuration) (int, error) {
+		if len(configurations) == 0 {
+			return -1, nil
+		}
+		k := key(configurations)
+		if id, ok := ids[k]; ok {
+			return id, nil
+		}
+		if len(result.next) >= maxDFAStates {
+			return 0, errUnsupported
+		}
+		id := len(result.next)
+		ids[k] = id
+		accept := false
+		for _, c := range configurations {
+			accept = accept || c.state == after
+		}
+		result.next = append(result.next, make([]int, len(alphabet)))
+		result.accept = append(result.accept, accept)
+		queue = append(queue, configurations)
+		return id, nil
+	}
+
+	if _, err := add(closure([]configuration{{state: before, atStart: true}})); err != nil {
+		return nil, err
+	}
+	for id := 0; id < len(queue); id++ {
+		for symbol, r := range alphabet {
+			next, err := add(step(queue[id], r))
+			if err != nil {
+				return nil, err
+			}
+			result.next[id][symbol] = next
+		}
+	}
+	return &result, nil
+}
+
+// compareDFAs explores the product of two automata, and returns whether each of them accepts a string that the other doesn't
+func compareDFAs(dfa1, dfa2 *dfa, symbols int) (only1 bool, only2 bool, err error) {
+	accepts := func(a *dfa, state int) bool {
+		return state >= 0 && a.accept[state]
+	}
+
+	type pair struct{ state1, state2 int }
+	seen := map[pair]bool{{0, 0}: true}
+	queue := []pair{{0, 0}}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+
+		accept1, accept2 := accepts(dfa1, p.state1), accepts(dfa2, p.state2)
+		only1 = only1 || accept1 && !accept2
+		only2 = only2 || accept2 && !accept1
+		if only1 && only2 {
+			return only1, only2, nil
+		}
+
+		for symbol := 0; symbol < symbols; symbol++ {
+			next := pair{-1, -1}
+			if p.state1 >= 0 {
+				next.state1 = dfa1.next[p.state1][symbol]
+			}
+			if p.state2 >= 0 {
+				next.state2 = dfa2.next[p.state2][symbol]
+			}
+			if next.state1 < 0 && next.state2 < 0 || seen[next] {
+				continue
+			}
+			if len(seen) >= maxDFAStates {
+				return false, false, errUnsupported
+			}
+			seen[next] = true
+			queue = append(queue, next)
+		}
+	}
+	return only1, only2, nil
+}
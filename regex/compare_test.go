@@ -0,0 +1,44 @@
+package regex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/regex"
+)
+
+func TestCompare(t *testing.T) {
+	for _, test := range []struct {
+		pattern1 string
+		pattern2 string
+		expected regex.Relation
+	}{
+		{`^[0-9]+$`, `^[0-9]+$`, regex.RelationEqual},
+		{`^[0-9]+$`, `^\d+$`, regex.RelationEqual},
+		{`^(?:a|b)$`, `^[ab]$`, regex.RelationEqual},
+		{`^a{2,3}$`, `^aaa?$`, regex.RelationEqual},
+		{`[0-9]`, `\d+`, regex.RelationEqual},
+		{`^[0-9]+$`, `^[0-9]*$`, regex.RelationSuperset},
+		{`^[0-9]+$`, `^[0-9a-f]+$`, regex.RelationSuperset},
+		{`^[a-z]+$`, `.*`, regex.RelationSuperset},
+		{`^[a-z]+$`, ``, regex.RelationSuperset},
+		{`^abc$`, `^abc`, regex.RelationSuperset},
+		{`^abc$`, `abc`, regex.RelationSuperset},
+		{`^a{1,5}$`, `^a{1,10}$`, regex.RelationSuperset},
+		{`^[0-9a-f]+$`, `^[0-9]+$`, regex.RelationSubset},
+		{`.*`, `^.*$`, regex.RelationSubset},
+		{`^.*$`, `^[\s\S]*$`, regex.RelationSuperset},
+		{`^[a-z]+$`, `^[0-9]+$`, regex.RelationIncomparable},
+		{`^a{1,5}$`, `^a{3,10}$`, regex.RelationIncomparable},
+		{`^$`, `x^`, regex.RelationSubset},
+		{`^(?!admin$)[a-z]+$`, `^[a-z]+$`, regex.RelationUnknown},
+		{`^(\w+)-\1$`, `^\w+-\w+$`, regex.RelationUnknown},
+		{`\bword\b`, `word`, regex.RelationUnknown},
+		{`^(invalid`, `^[a-z]+$`, regex.RelationUnknown},
+		{`^[a-z]{1,100000}$`, `^[a-z]+$`, regex.RelationUnknown},
+	} {
+		t.Run(test.pattern1+" "+test.pattern2, func(t *testing.T) {
+			require.Equal(t, test.expected, regex.Compare(test.pattern1, test.pattern2))
+		})
+	}
+}
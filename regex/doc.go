@@ -0,0 +1,5 @@
+/*
+Package regex parses the regular expressions of the pattern keyword, which follow the ECMA-262 dialect: https://spec.openapis.org/oas/v3.0.3#properties
+Patterns are parsed like the RegExp constructor of web browsers, without flags and including the legacy syntax of Annex B: https://tc39.es/ecma262/#sec-regular-expressions-patterns
+*/
+package regex
@@ -0,0 +1,654 @@
+package regex
+
+import "unicode"
+
+// ErrorCode describes why a pattern is invalid
+type ErrorCode string
+
+const (
+	ErrMissingParen          ErrorCode = "missing closing )"
+	ErrUnexpectedParen       ErrorCode = "unexpected )"
+	ErrMissingBracket        ErrorCode = "missing closing ]"
+	ErrNothingToRepeat       ErrorCode = "nothing to repeat"
+	ErrInvalidRepeatSize     ErrorCode = "numbers out of order in {} quantifier"
+	ErrTrailingBackslash     ErrorCode = "trailing backslash at end of pattern"
+	ErrInvalidGroup          ErrorCode = "invalid group"
+	ErrInvalidGroupName      ErrorCode = "invalid capture group name"
+	ErrDuplicateGroupName    ErrorCode = "duplicate capture group name"
+	ErrInvalidNamedReference ErrorCode = "invalid named reference"
+	ErrInvalidEscape         ErrorCode = "invalid escape"
+	ErrInvalidCharRange      ErrorCode = "range out of order in character class"
+)
+
+// Error describes a pattern that isn't a valid ECMA-262 regular expression
+type Error struct {
+	Code ErrorCode
+	Expr string
+}
+
+func (e *Error) Error() string {
+	return "error parsing regexp: " + string(e.Code) + ": `" + e.Expr + "`"
+}
+
+// Validate returns an error if the pattern isn't a valid ECMA-262 regular expression
+func Validate(pattern string) error {
+	_, err := Parse(pattern)
+	return err
+}
+
+// Parse parses an ECMA-262 regular expression into a syntax tree
+func Parse(pattern string) (*Node, error) {
+	p := parser{
+		runes: []rune(pattern),
+	}
+	p.groupNames = p.scanGroupNames()
+
+	node, err := p.parseDisjunction()
+	if err != nil {
+		return nil, err
+	}
+	if !p.eof() {
+		// parseDisjunction stops only at the end of the pattern or at a closing parenthesis
+		return nil, p.error(ErrUnexpectedParen, p.pos)
+	}
+	return node, nil
+}
+
+type parser struct {
+	runes       []rune
+	pos         int
+	numCaptures int
+	groupCount  int            // the number of capture groups in the whole pattern
+	groupNames  map[string]int // the capture group names in the whole pattern, mapped to their numbers
+	seenNames   map[string]bool
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.runes)
+}
+
+func (p *parser) peek() rune {
+	if p.eof() {
+		return -1
+	}
+	return p.runes[p.pos]
+}
+
+func (p *parser) lookingAt(s string) bool {
+	i := p.pos
+	for _, c := range s {
+		if i >= len(p.runes) || p.runes[i] != c {
+			return false
+		}
+		i++
+	}
+	return true
+}
+
+func (p *parser) error(code ErrorCode, start int) *Error {
+	if start > len(p.runes) {
+		start = len(p.runes)
+	}
+	end := p.pos + 1
+	if end > len(p.runes) {
+		end = len(p.runes)
+	}
+	return &Error{Code: code, Expr: string(p.runes[start:end])}
+}
+
+// scanGroupNames counts the capture groups and collects their names before parsing, because backreferences may precede their groups
+func (p *parser) scanGroupNames() map[string]int {
+	result := map[string]int{}
+	inClass := false
+	for i := 0; i < len(p.runes); i++ {
+		switch c := p.runes[i]; {
+		case c == '\\':
+			i++
+		case inClass:
+			inClass = c != ']'
+		case c == '[':
+			inClass = true
+		case c == '(':
+			if i+1 >= len(p.runes) || p.runes[i+1] != '?' {
+				p.groupCount++
+				continue
+			}
+			if i+3 < len(p.runes) && p.runes[i+2] == '<' && p.runes[i+3] != '=' && p.runes[i+3] != '!' {
+				p.groupCount++
+				for end := i + 3; end < len(p.runes); end++ {
+					if p.runes[end] == '>' {
+						result[string(p.runes[i+3:end])] = p.groupCount
+						break
+					}
+				}
+			}
+		}
+	}
+	return result
+}
+
+// parseDisjunction parses alternatives separated by |
+func (p *parser) parseDisjunction() (*Node, error) {
+	alternatives := []*Node{}
+	for {
+		alternative, err := p.parseAlternative()
+		if err != nil {
+			return nil, err
+		}
+		alternatives = append(alternatives, alternative)
+		if p.peek() != '|' {
+			break
+		}
+		p.pos++
+	}
+	if len(alternatives) == 1 {
+		return alternatives[0], nil
+	}
+	return &Node{Op: OpAlternate, Sub: alternatives}, nil
+}
+
+// parseAlternative parses a sequence of terms
+func (p *parser) parseAlternative() (*Node, error) {
+	terms := []*Node{}
+	for !p.eof() && p.peek() != '|' && p.peek() != ')' {
+		term, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+	}
+	switch len(terms) {
+	case 0:
+		return &Node{Op: OpEmptyMatch}, nil
+	case 1:
+		return terms[0], nil
+	default:
+		return &Node{Op: OpConcat, Sub: terms}, nil
+	}
+}
+
+// parseTerm parses an assertion or an atom followed by an optional quantifier
+func (p *parser) parseTerm() (*Node, error) {
+	start := p.pos
+
+	if _, _, size := p.scanQuantifier(); size > 0 {
+		p.pos += size - 1
+		return nil, p.error(ErrNothingToRepeat, start)
+	}
+
+	var atom *Node
+	quantifiable := true
+	switch {
+	case p.peek() == '^':
+		p.pos++
+		atom, quantifiable = &Node{Op: OpBeginText}, false
+	case p.peek() == '$':
+		p.pos++
+		atom, quantifiable = &Node{Op: OpEndText}, false
+	case p.lookingAt(`\b`):
+		p.pos += 2
+		atom, quantifiable = &Node{Op: OpWordBoundary}, false
+	case p.lookingAt(`\B`):
+		p.pos += 2
+		atom, quantifiable = &Node{Op: OpNoWordBoundary}, false
+	case p.lookingAt("(?<=") || p.lookingAt("(?<!"):
+		// unlike lookaheads, lookbehinds can't be quantified even with the legacy syntax
+		op := OpLookbehind
+		if p.runes[p.pos+3] == '!' {
+			op = OpNegativeLookbehind
+		}
+		p.pos += 4
+		sub, err := p.parseGroupBody(start)
+		if err != nil {
+			return nil, err
+		}
+		atom, quantifiable = &Node{Op: op, Sub: []*Node{sub}}, false
+	default:
+		var err error
+		if atom, err = p.parseAtom(); err != nil {
+			return nil, err
+		}
+	}
+
+	min, max, size := p.scanQuantifier()
+	if size == 0 {
+		return atom, nil
+	}
+	if !quantifiable {
+		p.pos += size - 1
+		return nil, p.error(ErrNothingToRepeat, start)
+	}
+	p.pos += size
+	if max >= 0 && min > max {
+		return nil, p.error(ErrInvalidRepeatSize, start)
+	}
+	lazy := false
+	if p.peek() == '?' {
+		p.pos++
+		lazy = true
+	}
+	return &Node{Op: OpRepeat, Sub: []*Node{atom}, Min: min, Max: max, Lazy: lazy}, nil
+}
+
+// scanQuantifier returns the bounds and the length of the quantifier at the current position, or zero length if there is none
+// A brace that doesn't start a valid quantifier is a literal character in the legacy syntax
+func (p *parser) scanQuantifier() (min int, max int, size int) {
+	switch p.peek() {
+	case '*':
+		return 0, -1, 1
+	case '+':
+		return 1, -1, 1
+	case '?':
+		return 0, 1, 1
+	case '{':
+	default:
+		return 0, 0, 0
+	}
+
+	i := p.pos + 1
+	min, i, ok := p.scanNumber(i)
+	if !ok {
+		return 0, 0, 0
+	}
+	max = min
+	if i < len(p.runes) && p.runes[i] == ',' {
+		i++
+		max = -1
+		if i < len(p.runes) && p.runes[i] != '}' {
+			if max, i, ok = p.scanNumber(i); !ok {
+				return 0, 0, 0
+			}
+		}
+	}
+	if i >= len(p.runes) || p.runes[i] != '}' {
+		return 0, 0, 0
+	}
+	return min, max, i + 1 - p.pos
+}
+
+// maxRepeat caps the bounds of quantifiers, which can be arbitrarily large
+const maxRepeat = 1 << 20
+
+func (p *parser) scanNumber(i int) (int, int, bool) {
+	start := i
+	result := 0
+	for ; i < len(p.runes) && isDecimalDigit(p.runes[i]); i++ {
+		if result < maxRepeat {
+			result = result*10 + int(p.runes[i]-'0')
+		}
+	}
+	if result > maxRepeat {
+		result = maxRepeat
+	}
+	return result, i, i > start
+}
+
+// parseAtom parses a character, a character class, a group or an escape
+func (p *parser) parseAtom() (*Node, error) {
+	start := p.pos
+	c := p.peek()
+	switch c {
+	case '.':
+		p.pos++
+		return &Node{Op: OpCharClass, Ranges: anyCharNotNLRanges}, nil
+	case '[':
+		return p.parseClass()
+	case '\\':
+		return p.parseAtomEscape()
+	case '(':
+		return p.parseGroup()
+	case ')':
+		return nil, p.error(ErrUnexpectedParen, start)
+	}
+	p.pos++
+	return &Node{Op: OpLiteral, Rune: c}, nil
+}
+
+// parseGroup parses capture groups, non-capturing groups and lookaheads
+func (p *parser) parseGroup() (*Node, error) {
+	start := p.pos
+	p.pos++
+
+	if p.peek() != '?' {
+		p.numCaptures++
+		index := p.numCaptures
+		sub, err := p.parseGroupBody(start)
+		if err != nil {
+			return nil, err
+		}
+		return &Node{Op: OpCapture, Sub: []*Node{sub}, Index: index}, nil
+	}
+
+	p.pos++
+	switch p.peek() {
+	case ':':
+		p.pos++
+		return p.parseGroupBody(start)
+	case '=', '!':
+		op := OpLookahead
+		if p.peek() == '!' {
+			op = OpNegativeLookahead
+		}
+		p.pos++
+		sub, err := p.parseGroupBody(start)
+		if err != nil {
+			return nil, err
+		}
+		return &Node{Op: op, Sub: []*Node{sub}}, nil
+	case '<':
+		p.pos++
+		name, err := p.parseGroupName(start)
+		if err != nil {
+			return nil, err
+		}
+		if p.seenNames[name] {
+			return nil, p.error(ErrDuplicateGroupName, start)
+		}
+		if p.seenNames == nil {
+			p.seenNames = map[string]bool{}
+		}
+		p.seenNames[name] = true
+		p.numCaptures++
+		index := p.numCaptures
+		sub, err := p.parseGroupBody(start)
+		if err != nil {
+			return nil, err
+		}
+		return &Node{Op: OpCapture, Sub: []*Node{sub}, Index: index, Name: name}, nil
+	}
+	return nil, p.error(ErrInvalidGroup, start)
+}
+
+// parseGroupBody parses the disjunction inside a group and the closing parenthesis
+func (p *parser) parseGroupBody(start int) (*Node, error) {
+	sub, err := p.parseDisjunction()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek() != ')' {
+		return nil, p.error(ErrMissingParen, start)
+	}
+	p.pos++
+	return sub, nil
+}
+
+// parseGroupName parses a group name and the closing angle bracket, like name> in (?<name>...) or \k<name>
+func (p *parser) parseGroupName(start int) (string, error) {
+	nameStart := p.pos
+	for !p.eof() && p.peek() != '>' {
+		c := p.peek()
+		if !(c == '$' || c == '_' || unicode.IsLetter(c) || p.pos > nameStart && (unicode.IsDigit(c) || unicode.Is(unicode.Mn, c) || unicode.Is(unicode.Mc, c) || unicode.Is(unicode.Pc, c) || c == 0x200c || c == 0x200d)) {
+			return "", p.error(ErrInvalidGroupName, start)
+		}
+		p.pos++
+	}
+	if p.eof() || p.pos == nameStart {
+		return "", p.error(ErrInvalidGroupName, start)
+	}
+	name := string(p.runes[nameStart:p.pos])
+	p.pos++
+	return name, nil
+}
+
+// parseAtomEscape parses an escape outside of a character class
+func (p *parser) parseAtomEscape() (*Node, error) {
+	start := p.pos
+	p.pos++
+	if p.eof() {
+		return nil, p.error(ErrTrailingBackslash, start)
+	}
+
+	c := p.peek()
+	switch {
+	case c >= '1' && c <= '9':
+		// a decimal escape is a backreference only if the pattern has enough groups, otherwise it is a legacy octal escape
+		digitsStart := p.pos
+		number, end, _ := p.scanNumber(p.pos)
+		if number <= p.groupCount {
+			p.pos = end
+			return &Node{Op: OpBackreference, Index: number}, nil
+		}
+		p.pos = digitsStart
+	case c == 'k' && len(p.groupNames) > 0:
+		// \k is a named backreference only if the pattern has named groups, otherwise it is the letter k
+		p.pos++
+		if p.peek() != '<' {
+			return nil, p.error(ErrInvalidNamedReference, start)
+		}
+		p.pos++
+		name, err := p.parseGroupName(start)
+		if err != nil {
+			return nil, err
+		}
+		index, ok := p.groupNames[name]
+		if !ok {
+			return nil, p.error(ErrInvalidNamedReference, start)
+		}
+		return &Node{Op: OpBackreference, Index: index, Name: name}, nil
+	}
+
+	if ranges := classEscapeRanges(c); ranges != nil {
+		p.pos++
+		return &Node{Op: OpCharClass, Ranges: ranges}, nil
+	}
+
+	r, err := p.parseCharacterEscape(start, false)
+	if err != nil {
+		return nil, err
+	}
+	return &Node{Op: OpLiteral, Rune: r}, nil
+}
+
+// classEscapeRanges returns the characters of \d, \D, \s, \S, \w and \W, or nil for other escapes
+func classEscapeRanges(c rune) []rune {
+	switch c {
+	case 'd':
+		return digitRanges
+	case 'D':
+		return negateRanges(digitRanges)
+	case 's':
+		return spaceRanges
+	case 'S':
+		return negateRanges(spaceRanges)
+	case 'w':
+		return wordRanges
+	case 'W':
+		return negateRanges(wordRanges)
+	}
+	return nil
+}
+
+// parseCharacterEscape parses an escape that stands for a single character, after the backslash
+// An escape that isn't valid, like \x1 or \c1, stands for its letter, or for the backslash itself in the case of \c
+func (p *parser) parseCharacterEscape(start int, inClass bool) (rune, error) {
+	c := p.peek()
+	p.pos++
+
+	switch c {
+	case 'f':
+		return '\f', nil
+	case 'n':
+		return '\n', nil
+	case 'r':
+		return '\r', nil
+	case 't':
+		return '\t', nil
+	case 'v':
+		return '\v', nil
+	case 'c':
+		if letter := p.peek(); isASCIILetter(letter) || inClass && (isDecimalDigit(letter) || letter == '_') {
+			p.pos++
+			return letter % 32, nil
+		}
+		// the backslash is a literal and the c is parsed as the next character
+		p.pos--
+		return '\\', nil
+	case 'x':
+		if r, ok := p.scanHex(2); ok {
+			return r, nil
+		}
+		return c, nil
+	case 'u':
+		if r, ok := p.scanHex(4); ok {
+			return r, nil
+		}
+		return c, nil
+	case 'k':
+		if len(p.groupNames) > 0 {
+			return 0, p.error(ErrInvalidEscape, start)
+		}
+		return c, nil
+	}
+
+	if isOctalDigit(c) {
+		// \0 and legacy octal escapes, up to \377
+		value := c - '0'
+		maxDigits := 2
+		if c >= '4' {
+			maxDigits = 1
+		}
+		for i := 0; i < maxDigits && isOctalDigit(p.peek()); i++ {
+			value = value*8 + p.peek() - '0'
+			p.pos++
+		}
+		return value, nil
+	}
+
+	// identity escape
+	return c, nil
+}
+
+func (p *parser) scanHex(digits int) (rune, bool) {
+	if p.pos+digits > len(p.runes) {
+		return 0, false
+	}
+	value := rune(0)
+	for _, c := range p.runes[p.pos : p.pos+digits] {
+		digit := hexValue(c)
+		if digit < 0 {
+			return 0, false
+		}
+		value = value*16 + digit
+	}
+	p.pos += digits
+	return value, true
+}
+
+// parseClass parses a character class, like [a-z_] or [^\d]
+func (p *parser) parseClass() (*Node, error) {
+	start := p.pos
+	p.pos++
+
+	negated := false
+	if p.peek() == '^' {
+		p.pos++
+		negated = true
+	}
+
+	ranges := []rune{}
+	for p.peek() != ']' {
+		if p.eof() {
+			return nil, p.error(ErrMissingBracket, start)
+		}
+
+		lo, loRanges, err := p.parseClassAtom(start)
+		if err != nil {
+			return nil, err
+		}
+
+		if p.peek() != '-' || p.pos+1 >= len(p.runes) || p.runes[p.pos+1] == ']' {
+			ranges = append(ranges, atomRanges(lo, loRanges)...)
+			continue
+		}
+
+		// a range, unless one of its ends is a class escape like \d, in which case the dash is a literal in the legacy syntax
+		dashPos := p.pos
+		p.pos++
+		hi, hiRanges, err := p.parseClassAtom(start)
+		if err != nil {
+			return nil, err
+		}
+		if loRanges != nil || hiRanges != nil {
+			ranges = append(ranges, atomRanges(lo, loRanges)...)
+			ranges = append(ranges, '-', '-')
+			ranges = append(ranges, atomRanges(hi, hiRanges)...)
+			continue
+		}
+		if lo > hi {
+			p.pos = dashPos + 1
+			return nil, p.error(ErrInvalidCharRange, start)
+		}
+		ranges = append(ranges, lo, hi)
+	}
+	p.pos++
+
+	ranges = normalizeRanges(ranges)
+	if negated {
+		ranges = negateRanges(ranges)
+	}
+	return &Node{Op: OpCharClass, Ranges: ranges}, nil
+}
+
+// parseClassAtom parses a character or an escape in a character class
+// It returns either a single character or, for class escapes like \d, its ranges
+func (p *parser) parseClassAtom(start int) (rune, []rune, error) {
+	c := p.peek()
+	if c != '\\' {
+		p.pos++
+		return c, nil, nil
+	}
+
+	escapeStart := p.pos
+	p.pos++
+	if p.eof() {
+		return 0, nil, p.error(ErrMissingBracket, start)
+	}
+
+	c = p.peek()
+	if c == 'b' {
+		p.pos++
+		return '\b', nil, nil
+	}
+	if ranges := classEscapeRanges(c); ranges != nil {
+		p.pos++
+		return 0, ranges, nil
+	}
+	if c == '8' || c == '9' {
+		p.pos++
+		return c, nil, nil
+	}
+
+	r, err := p.parseCharacterEscape(escapeStart, true)
+	return r, nil, err
+}
+
+func atomRanges(r rune, ranges []rune) []rune {
+	if ranges != nil {
+		return ranges
+	}
+	return []rune{r, r}
+}
+
+func isDecimalDigit(c rune) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isOctalDigit(c rune) bool {
+	return c >= '0' && c <= '7'
+}
+
+func isASCIILetter(c rune) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func hexValue(c rune) rune {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0'
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10
+	}
+	return -1
+}
@@ -0,0 +1,171 @@
+package regex_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tufin/oasdiff/regex"
+)
+
+func TestParse_Valid(t *testing.T) {
+	for _, pattern := range []string{
+		``,
+		`^[a-z]+$`,
+		`^(?:([a-z]+-)*([a-z]+)?)$`,
+		`^(?=.*\d)(?=.*[a-z]).{8,}$`,
+		`^(?!admin$)[a-z]+$`,
+		`(?<=\$)\d+(?<!0)`,
+		`^(['"])[^'"]*\1$`,
+		`^(?<quote>['"]).*\k<quote>$`,
+		`\k<quote>(?<quote>a)`,
+		`^\p{L}+$`,
+		`^é\x41\cJ\0\t$`,
+		`a{`,
+		`a{1`,
+		`a{1,`,
+		`x{1,2}?`,
+		`]`,
+		`}`,
+		`[]`,
+		`[^]`,
+		`[\d-z]`,
+		`[a-]`,
+		`[-a]`,
+		`[\b\c1\-]`,
+		`\c`,
+		`\8\9`,
+		`\1`,
+		`(?=a)*`,
+		`\k`,
+		`[\w.+-]+@[\w-]+\.[\w.]+`,
+	} {
+		t.Run(pattern, func(t *testing.T) {
+			require.NoError(t, regex.Validate(pattern))
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for pattern, code := range map[string]regex.ErrorCode{
+		`^(0`:                      regex.ErrMissingParen,
+		`^(?`:                      regex.ErrInvalidGroup,
+		`^(?:([a-z]+-)*([a-z]+)?$`: regex.ErrMissingParen,
+		`a)`:                       regex.ErrUnexpectedParen,
+		`[a-z`:                     regex.ErrMissingBracket,
+		`*a`:                       regex.ErrNothingToRepeat,
+		`a**`:                      regex.ErrNothingToRepeat,
+		`{1}`:                      regex.ErrNothingToRepeat,
+		`^*`:                       regex.ErrNothingToRepeat,
+		`\b+`:                      regex.ErrNothingToRepeat,
+		`(?<=a)?`:                  regex.ErrNothingToRepeat,
+		`a{2,1}`:                   regex.ErrInvalidRepeatSize,
+		`a\`:                       regex.ErrTrailingBackslash,
+		`(?i)a`:                    regex.ErrInvalidGroup,
+		`(?P<name>a)`:              regex.ErrInvalidGroup,
+		`(?<1a>a)`:                 regex.ErrInvalidGroupName,
+		`(?<a>x)(?<a>y)`:           regex.ErrDuplicateGroupName,
+		`(?<a>x)\k<b>`:             regex.ErrInvalidNamedReference,
+		`(?<a>x)\k`:                regex.ErrInvalidNamedReference,
+		`[z-a]`:                    regex.ErrInvalidCharRange,
+	} {
+		t.Run(pattern, func(t *testing.T) {
+			err := regex.Validate(pattern)
+			require.Error(t, err)
+			require.IsType(t, &regex.Error{}, err)
+			require.Equal(t, code, err.(*regex.Error).Code)
+		})
+	}
+}
+
+func TestParse_Error(t *testing.T) {
+	require.EqualError(t, regex.Validate(`a{2,1}`), "error parsing regexp: numbers out of order in {} quantifier: `a{2,1}`")
+}
+
+// dump returns a compact representation of a syntax tree
+func dump(node *regex.Node) string {
+	var b strings.Builder
+	var walk func(node *regex.Node)
+	walk = func(node *regex.Node) {
+		switch node.Op {
+		case regex.OpEmptyMatch:
+			b.WriteString("empty")
+		case regex.OpLiteral:
+			fmt.Fprintf(&b, "lit{%c}", node.Rune)
+		case regex.OpCharClass:
+			b.WriteString("cc{")
+			for i := 0; i < len(node.Ranges); i += 2 {
+				if i > 0 {
+					b.WriteString(" ")
+				}
+				fmt.Fprintf(&b, "%#x-%#x", node.Ranges[i], node.Ranges[i+1])
+			}
+			b.WriteString("}")
+		case regex.OpBeginText:
+			b.WriteString("bot")
+		case regex.OpEndText:
+			b.WriteString("eot")
+		case regex.OpWordBoundary:
+			b.WriteString("wb")
+		case regex.OpNoWordBoundary:
+			b.WriteString("nwb")
+		case regex.OpBackreference:
+			fmt.Fprintf(&b, "ref{%d}", node.Index)
+		case regex.OpRepeat:
+			fmt.Fprintf(&b, "rep{%d,%d", node.Min, node.Max)
+			if node.Lazy {
+				b.WriteString(",lazy")
+			}
+			b.WriteString(" ")
+			walk(node.Sub[0])
+			b.WriteString("}")
+		default:
+			b.WriteString(map[regex.Op]string{
+				regex.OpLookahead:          "la",
+				regex.OpNegativeLookahead:  "nla",
+				regex.OpLookbehind:         "lb",
+				regex.OpNegativeLookbehind: "nlb",
+				regex.OpCapture:            "cap",
+				regex.OpConcat:             "cat",
+				regex.OpAlternate:          "alt",
+			}[node.Op])
+			b.WriteString("{")
+			if node.Name != "" {
+				b.WriteString(node.Name + ":")
+			}
+			for i, sub := range node.Sub {
+				if i > 0 {
+					b.WriteString(" ")
+				}
+				walk(sub)
+			}
+			b.WriteString("}")
+		}
+	}
+	walk(node)
+	return b.String()
+}
+
+func TestParse_Tree(t *testing.T) {
+	for pattern, expected := range map[string]string{
+		`^ab$`:          "cat{bot lit{a} lit{b} eot}",
+		`a|b|`:          "alt{lit{a} lit{b} empty}",
+		`(?:ab)+?`:      "rep{1,-1,lazy cat{lit{a} lit{b}}}",
+		`(?<x>a)\k<x>`:  "cat{cap{x:lit{a}} ref{1}}",
+		`(a)\1\2`:       "cat{cap{lit{a}} ref{1} lit{\x02}}",
+		`[a-cb-e_]`:     "cc{0x5f-0x5f 0x61-0x65}",
+		`[^\x00-\x7f]`:  "cc{0x80-0x10ffff}",
+		`[\d-]`:         "cc{0x2d-0x2d 0x30-0x39}",
+		`a{2,5}`:        "rep{2,5 lit{a}}",
+		`a{,5}`:         "cat{lit{a} lit{{} lit{,} lit{5} lit{}}}",
+		`\bx(?=y)(?!z)`: "cat{wb lit{x} la{lit{y}} nla{lit{z}}}",
+		`\cJ\c`:         "cat{lit{\n} lit{\\} lit{c}}",
+	} {
+		t.Run(pattern, func(t *testing.T) {
+			node, err := regex.Parse(pattern)
+			require.NoError(t, err)
+			require.Equal(t, expected, dump(node))
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package regex
+
+import (
+	"sort"
+	"unicode"
+)
+
+// Op is the operator of a syntax tree node
+type Op uint8
+
+const (
+	OpEmptyMatch         Op = iota + 1 // matches the empty string
+	OpLiteral                          // matches Rune
+	OpCharClass                        // matches a character in Ranges
+	OpBeginText                        // ^
+	OpEndText                          // $
+	OpWordBoundary                     // \b
+	OpNoWordBoundary                   // \B
+	OpLookahead                        // (?=Sub[0])
+	OpNegativeLookahead                // (?!Sub[0])
+	OpLookbehind                       // (?<=Sub[0])
+	OpNegativeLookbehind               // (?<!Sub[0])
+	OpCapture                          // (Sub[0]) or (?<Name>Sub[0])
+	OpBackreference                    // \Index or \k<Name>
+	OpRepeat                           // Sub[0]{Min,Max}
+	OpConcat                           // Sub[0]Sub[1]...
+	OpAlternate                        // Sub[0]|Sub[1]|...
+)
+
+// Node is a node of the syntax tree of a pattern
+type Node struct {
+	Op     Op
+	Sub    []*Node
+	Rune   rune   // OpLiteral
+	Ranges []rune // OpCharClass: sorted and non-overlapping pairs of inclusive bounds
+	Min    int    // OpRepeat
+	Max    int    // OpRepeat: -1 when unbounded
+	Lazy   bool   // OpRepeat
+	Index  int    // OpCapture, OpBackreference: the number of the capture group
+	Name   string // OpCapture, OpBackreference: the name of the capture group, if any
+}
+
+// IsAssertion returns true if the node matches a position rather than characters
+func (node *Node) IsAssertion() bool {
+	switch node.Op {
+	case OpBeginText, OpEndText, OpWordBoundary, OpNoWordBoundary, OpLookahead, OpNegativeLookahead, OpLookbehind, OpNegativeLookbehind:
+		return true
+	}
+	return false
+}
+
+// the predefined character classes, see: https://tc39.es/ecma262/#sec-compiletocharset
+var (
+	digitRanges = []rune{'0', '9'}
+	wordRanges  = []rune{'0', '9', 'A', 'Z', '_', '_', 'a', 'z'}
+	spaceRanges = []rune{
+		'\t', '\r',
+		' ', ' ',
+		0xa0, 0xa0,
+		0x1680, 0x1680,
+		0x2000, 0x200a,
+		0x2028, 0x2029,
+		0x202f, 0x202f,
+		0x205f, 0x205f,
+		0x3000, 0x3000,
+		0xfeff, 0xfeff,
+	}
+	// anyCharNotNLRanges are the characters matched by a dot, which excludes the line terminators
+	anyCharNotNLRanges = negateRanges([]rune{'\n', '\n', '\r', '\r', 0x2028, 0x2029})
+)
+
+// normalizeRanges sorts the ranges and merges the overlapping and adjacent ones
+func normalizeRanges(ranges []rune) []rune {
+	pairs := make([][2]rune, 0, len(ranges)/2)
+	for i := 0; i+1 < len(ranges); i += 2 {
+		pairs = append(pairs, [2]rune{ranges[i], ranges[i+1]})
+	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i][0] < pairs[j][0] })
+
+	result := make([]rune, 0, len(ranges))
+	for _, pair := range pairs {
+		if n := len(result); n > 0 && pair[0] <= result[n-1]+1 {
+			if pair[1] > result[n-1] {
+				result[n-1] = pair[1]
+			}
+			continue
+		}
+		result = append(result, pair[0], pair[1])
+	}
+	return result
+}
+
+// negateRanges returns the characters that aren't in the normalized ranges
+func negateRanges(ranges []rune) []rune {
+	result := make([]rune, 0, len(ranges)+2)
+	next := rune(0)
+	for i := 0; i+1 < len(ranges); i += 2 {
+		if ranges[i] > next {
+			result = append(result, next, ranges[i]-1)
+		}
+		next = ranges[i+1] + 1
+	}
+	if next <= unicode.MaxRune {
+		result = append(result, next, unicode.MaxRune)
+	}
+	return result
+}
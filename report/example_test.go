@@ -65,9 +65,19 @@ func ExampleGetTextReportAsString() {
 	//     - MaxLength changed from 29 to 30
 	// - Responses changed
 	//   - New response: default
-	//   - Deleted response: 200
-	//   - Deleted response: 201
-	//   - Deleted response: 400
+	//   - Modified response: 200
+	//     - Status changed from '200' to 'default'
+	//     - Description changed from 'OK' to 'Tufin'
+	//   - Modified response: 201
+	//     - Status changed from '201' to 'default'
+	//     - Description changed from 'none' to 'Tufin'
+	//     - Content changed
+	//       - Deleted media type: application/xml
+	//   - Modified response: 400
+	//     - Status changed from '400' to 'default'
+	//     - Description changed from 'bad request' to 'Tufin'
+	//     - Content changed
+	//       - Deleted media type: application/json
 	//
 	// GET /api/{domain}/{project}/install-command
 	// - Deleted header param: network-policies
@@ -185,9 +195,34 @@ func ExampleGetHTMLReportAsString() {
 	// <li>Responses changed
 	// <ul>
 	// <li>New response: default</li>
-	// <li>Deleted response: 200</li>
-	// <li>Deleted response: 201</li>
-	// <li>Deleted response: 400</li>
+	// <li>Modified response: 200
+	// <ul>
+	// <li>Status changed from '200' to 'default'</li>
+	// <li>Description changed from 'OK' to 'Tufin'</li>
+	// </ul>
+	// </li>
+	// <li>Modified response: 201
+	// <ul>
+	// <li>Status changed from '201' to 'default'</li>
+	// <li>Description changed from 'none' to 'Tufin'</li>
+	// <li>Content changed
+	// <ul>
+	// <li>Deleted media type: application/xml</li>
+	// </ul>
+	// </li>
+	// </ul>
+	// </li>
+	// <li>Modified response: 400
+	// <ul>
+	// <li>Status changed from '400' to 'default'</li>
+	// <li>Description changed from 'bad request' to 'Tufin'</li>
+	// <li>Content changed
+	// <ul>
+	// <li>Deleted media type: application/json</li>
+	// </ul>
+	// </li>
+	// </ul>
+	// </li>
 	// </ul>
 	// </li>
 	// </ul>
@@ -460,6 +460,7 @@ func (r *report) printResponse(d *diff.ResponseDiff) {
 		return
 	}
 
+	r.printValue(d.StatusDiff, "Status")
 	r.printValue(d.DescriptionDiff, "Description")
 
 	if !d.ContentDiff.Empty() {
@@ -512,6 +513,8 @@ func (r *report) printMediaType(d *diff.MediaTypeDiff) {
 		return
 	}
 
+	r.printValue(d.NameDiff, "Media type")
+
 	if !d.SchemaDiff.Empty() {
 		r.print("Schema changed")
 		r.indent().printSchema(d.SchemaDiff)
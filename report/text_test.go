@@ -52,7 +52,7 @@ func TestText1(t *testing.T) {
 }
 
 func TestText2(t *testing.T) {
-	require.Contains(t, report.GetTextReportAsString(d(t, &diff.Config{}, 5, 3)), "Deleted response: 201")
+	require.Contains(t, report.GetTextReportAsString(d(t, &diff.Config{}, 5, 3)), "Modified response: 201")
 }
 
 func TestText3(t *testing.T) {